@@ -3,16 +3,23 @@ package binance
 import (
 	"encoding/json"
 	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/delivery"
+	"github.com/jekaxv/go-binance/fix"
 	"github.com/jekaxv/go-binance/futures"
+	"github.com/jekaxv/go-binance/margin"
+	"github.com/jekaxv/go-binance/options"
+	"github.com/jekaxv/go-binance/portfolio"
 	"github.com/jekaxv/go-binance/spot"
-	"net/http"
+	"github.com/jekaxv/go-binance/subaccount"
+	"github.com/jekaxv/go-binance/wallet"
 )
 
 func NewClient(opt ...core.Options) *spot.Client {
+	o := core.NewOptions(opt...)
 	return &spot.Client{
 		Client: &core.Client{
-			Opt:        core.NewOptions(opt...),
-			HttpClient: http.DefaultClient,
+			Opt:        o,
+			HttpClient: core.NewHttpClient(o),
 		},
 	}
 }
@@ -20,7 +27,7 @@ func NewClient(opt ...core.Options) *spot.Client {
 func NewWsClient(opt ...core.Options) *spot.WsClient {
 	return &spot.WsClient{
 		WsClient: &core.WsClient{
-			Opt: core.NewOptions(opt...),
+			Opt: core.NewWsOptions(opt...),
 		},
 	}
 }
@@ -34,10 +41,11 @@ func NewWsApiClient(opt ...core.Options) *spot.WsClient {
 }
 
 func NewFuturesClient(opt ...core.Options) *futures.Client {
+	o := core.NewFuturesOptions(opt...)
 	return &futures.Client{
 		Client: &core.Client{
-			Opt:        core.NewFuturesOptions(opt...),
-			HttpClient: http.DefaultClient,
+			Opt:        o,
+			HttpClient: core.NewHttpClient(o),
 		},
 	}
 }
@@ -56,6 +64,102 @@ func NewFuturesWsClient(opt ...core.Options) *futures.WsClient {
 	}
 }
 
+func NewDeliveryClient(opt ...core.Options) *delivery.Client {
+	o := core.NewDeliveryOptions(opt...)
+	return &delivery.Client{
+		Client: &core.Client{
+			Opt:        o,
+			HttpClient: core.NewHttpClient(o),
+		},
+	}
+}
+func NewDeliveryWsClient(opt ...core.Options) *delivery.WsClient {
+	return &delivery.WsClient{
+		WsClient: &core.WsClient{
+			Opt: core.NewDeliveryWsOptions(opt...),
+		},
+	}
+}
+
+func NewOptionsClient(opt ...core.Options) *options.Client {
+	o := core.NewOptionsOptions(opt...)
+	return &options.Client{
+		Client: &core.Client{
+			Opt:        o,
+			HttpClient: core.NewHttpClient(o),
+		},
+	}
+}
+func NewOptionsWsClient(opt ...core.Options) *options.WsClient {
+	return &options.WsClient{
+		WsClient: &core.WsClient{
+			Opt: core.NewOptionsWsOptions(opt...),
+		},
+	}
+}
+
+func NewPortfolioClient(opt ...core.Options) *portfolio.Client {
+	o := core.NewPortfolioOptions(opt...)
+	return &portfolio.Client{
+		Client: &core.Client{
+			Opt:        o,
+			HttpClient: core.NewHttpClient(o),
+		},
+	}
+}
+func NewPortfolioWsClient(opt ...core.Options) *portfolio.WsClient {
+	return &portfolio.WsClient{
+		WsClient: &core.WsClient{
+			Opt: core.NewPortfolioWsOptions(opt...),
+		},
+	}
+}
+func NewMarginClient(opt ...core.Options) *margin.Client {
+	o := core.NewOptions(opt...)
+	return &margin.Client{
+		Client: &core.Client{
+			Opt:        o,
+			HttpClient: core.NewHttpClient(o),
+		},
+	}
+}
+func NewMarginWsClient(opt ...core.Options) *margin.WsClient {
+	return &margin.WsClient{
+		WsClient: &core.WsClient{
+			Opt: core.NewWsOptions(opt...),
+		},
+	}
+}
+func NewWalletClient(opt ...core.Options) *wallet.Client {
+	o := core.NewOptions(opt...)
+	return &wallet.Client{
+		Client: &core.Client{
+			Opt:        o,
+			HttpClient: core.NewHttpClient(o),
+		},
+	}
+}
+func NewSubAccountClient(opt ...core.Options) *subaccount.Client {
+	o := core.NewOptions(opt...)
+	return &subaccount.Client{
+		Client: &core.Client{
+			Opt:        o,
+			HttpClient: core.NewHttpClient(o),
+		},
+	}
+}
+
+// NewFixClient FIX order entry session, logon requires an Ed25519 API key.
+func NewFixClient(opt ...core.Options) *fix.Client {
+	return &fix.Client{Opt: core.NewFixOptions(opt...)}
+}
+func NewFixDropCopyClient(opt ...core.Options) *fix.Client {
+	return &fix.Client{Opt: core.NewFixDropCopyOptions(opt...), DropCopy: true}
+}
+func NewFixMarketDataClient(opt ...core.Options) *fix.Client {
+	return &fix.Client{Opt: core.NewFixMarketDataOptions(opt...)}
+}
+
 func PrettyPrint(i interface{}) string {
 	s, _ := json.MarshalIndent(i, "", "\t")
 	return string(s)
@@ -0,0 +1,246 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// Redacted Replaces the value of a secret in audit records.
+const Redacted = "[REDACTED]"
+
+// redactedParams The parameters and response fields never written to an audit record.
+var redactedParams = map[string]bool{"apiKey": true, "signature": true}
+
+// clientOrderIdParams The parameters carrying the client order id of a request, in order of precedence.
+var clientOrderIdParams = []string{"newClientOrderId", "origClientOrderId", "listClientOrderId", "clientOrderId"}
+
+// AuditRecord A signed REST or WebSocket API request, or a FIX order entry message, and its outcome,
+// with API keys and signatures redacted.
+type AuditRecord struct {
+	Time time.Time `json:"time"`
+	Kind CallKind  `json:"kind"`
+	// Endpoint The path of a REST request, the method of a WebSocket API request or the MsgType of a FIX message.
+	Endpoint   string `json:"endpoint"`
+	HttpMethod string `json:"httpMethod,omitempty"`
+	// RequestId The id of a WebSocket API request.
+	RequestId string `json:"requestId,omitempty"`
+	// ClientOrderId The newClientOrderId, origClientOrderId or listClientOrderId of the request,
+	// or the clientOrderId assigned by the server when the request had none. ClOrdID or ClListID for FIX.
+	ClientOrderId string `json:"clientOrderId,omitempty"`
+	// Params The request params, keyed by tag for FIX.
+	Params   map[string]string `json:"params"`
+	Status   int               `json:"status,omitempty"`
+	Code     int               `json:"code,omitempty"`
+	Response json.RawMessage   `json:"response,omitempty"`
+	Error    string            `json:"error,omitempty"`
+	Duration time.Duration     `json:"duration"`
+}
+
+// AuditSink Receives an AuditRecord for every signed request and FIX order entry message, set it with Options.Audit.
+// Audit is called after the response, or the error, of the request and must be safe for concurrent use.
+type AuditSink interface {
+	Audit(ctx context.Context, record *AuditRecord) error
+}
+
+// JsonlAuditSink Writes every record as a line of JSON.
+type JsonlAuditSink struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+	closer  io.Closer
+}
+
+// NewJsonlAuditSink Writes the records to w.
+func NewJsonlAuditSink(w io.Writer) *JsonlAuditSink {
+	return &JsonlAuditSink{encoder: json.NewEncoder(w)}
+}
+
+// OpenJsonlAuditFile Appends the records to the file name, which is created when it does not exist.
+func OpenJsonlAuditFile(name string) (*JsonlAuditSink, error) {
+	file, err := os.OpenFile(name, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	sink := NewJsonlAuditSink(file)
+	sink.closer = file
+	return sink, nil
+}
+
+func (s *JsonlAuditSink) Audit(ctx context.Context, record *AuditRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.encoder.Encode(record)
+}
+
+// Close Closes the file opened by OpenJsonlAuditFile.
+func (s *JsonlAuditSink) Close() error {
+	if s.closer == nil {
+		return nil
+	}
+	return s.closer.Close()
+}
+
+// SlogAuditSink Logs every record at info level, separately from the debug logging of the client.
+type SlogAuditSink struct {
+	logger *slog.Logger
+}
+
+// NewSlogAuditSink Logs the records to logger.
+func NewSlogAuditSink(logger *slog.Logger) *SlogAuditSink {
+	return &SlogAuditSink{logger: logger}
+}
+
+func (s *SlogAuditSink) Audit(ctx context.Context, record *AuditRecord) error {
+	s.logger.LogAttrs(ctx, slog.LevelInfo, "audit",
+		slog.String("kind", string(record.Kind)),
+		slog.String("endpoint", record.Endpoint),
+		slog.String("http_method", record.HttpMethod),
+		slog.String("request_id", record.RequestId),
+		slog.String("client_order_id", record.ClientOrderId),
+		slog.Any("params", record.Params),
+		slog.Int("status", record.Status),
+		slog.Int("code", record.Code),
+		slog.String("response", string(record.Response)),
+		slog.String("error", record.Error),
+		slog.Duration("duration", record.Duration),
+	)
+	return nil
+}
+
+// auditInterceptor Records every signed REST request in o.Audit.
+func auditInterceptor(o *Options) Interceptor {
+	return func(ctx context.Context, r *Request, next Invoker) (*Response, error) {
+		if r.authType != AuthSigned {
+			return next(ctx, r)
+		}
+		start := time.Now()
+		resp, err := next(ctx, r)
+		record := &AuditRecord{Time: start, Kind: CallRest, Endpoint: r.path, HttpMethod: r.method, Params: redact(r.intent().Params)}
+		if resp != nil {
+			record.Status = resp.Status
+			record.Code = errorCode(resp.Body)
+			record.Response = redactJson(resp.Body)
+		}
+		o.audit(ctx, record, start, err)
+		return resp, err
+	}
+}
+
+// wsAuditInterceptor Records every signed WebSocket API request in o.Audit.
+func wsAuditInterceptor(o *Options) WsInterceptor {
+	return func(ctx context.Context, r *WsRequest, next WsInvoker) ([]byte, error) {
+		if r.AuthType != AuthSigned {
+			return next(ctx, r)
+		}
+		start := time.Now()
+		message, err := next(ctx, r)
+		record := &AuditRecord{Time: start, Kind: CallWsApi, Endpoint: r.Method, RequestId: r.Id, Params: redact(r.intent().Params)}
+		if message != nil {
+			var resp struct {
+				Status int `json:"status"`
+			}
+			if json.Unmarshal(message, &resp) == nil {
+				record.Status = resp.Status
+			}
+			record.Code = errorCode(message)
+			record.Response = redactJson(message)
+		}
+		o.audit(ctx, record, start, err)
+		return message, err
+	}
+}
+
+func (o *Options) audit(ctx context.Context, record *AuditRecord, start time.Time, err error) {
+	record.Duration = time.Since(start)
+	if err != nil {
+		record.Error = err.Error()
+	}
+	record.ClientOrderId = clientOrderId(record.Params, record.Response)
+	if auditErr := o.Audit.Audit(ctx, record); auditErr != nil {
+		o.Logger.Error("audit record failed", "endpoint", record.Endpoint, "error", auditErr)
+	}
+}
+
+// clientOrderId The client order id of the request params, or the one the response carries.
+func clientOrderId(params map[string]string, response []byte) string {
+	for _, key := range clientOrderIdParams {
+		if id := params[key]; id != "" {
+			return id
+		}
+	}
+	var resp struct {
+		ClientOrderId string `json:"clientOrderId"`
+		Result        *struct {
+			ClientOrderId string `json:"clientOrderId"`
+		} `json:"result"`
+	}
+	if json.Unmarshal(response, &resp) != nil {
+		return ""
+	}
+	if resp.Result != nil {
+		return resp.Result.ClientOrderId
+	}
+	return resp.ClientOrderId
+}
+
+func redact(params map[string]string) map[string]string {
+	for key := range params {
+		if redactedParams[key] {
+			params[key] = Redacted
+		}
+	}
+	return params
+}
+
+// redactJson Redacts the secrets of a response, e.g. the apiKey returned by session.logon.
+func redactJson(message []byte) json.RawMessage {
+	if len(message) == 0 {
+		return nil
+	}
+	if !json.Valid(message) {
+		quoted, _ := json.Marshal(string(message))
+		return quoted
+	}
+	secret := false
+	for key := range redactedParams {
+		if bytes.Contains(message, []byte(fmt.Sprintf("%q", key))) {
+			secret = true
+		}
+	}
+	if !secret {
+		return append(json.RawMessage(nil), message...)
+	}
+	var value any
+	if err := json.Unmarshal(message, &value); err != nil {
+		return json.RawMessage(fmt.Sprintf("%q", Redacted))
+	}
+	redacted, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return json.RawMessage(fmt.Sprintf("%q", Redacted))
+	}
+	return redacted
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if redactedParams[key] {
+				v[key] = Redacted
+			} else {
+				v[key] = redactValue(field)
+			}
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
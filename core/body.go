@@ -47,6 +47,14 @@ type response struct {
 	rawHeader http.Header
 }
 
+// export The response as seen by interceptors, nil when no response was received.
+func (r *response) export() *Response {
+	if r == nil || r.status == 0 {
+		return nil
+	}
+	return &Response{Status: r.status, Header: r.rawHeader, Body: r.rawBody}
+}
+
 type WsRequest struct {
 	Id       string         `json:"id"`
 	Method   string         `json:"method"`
@@ -65,3 +73,24 @@ func (r *WsRequest) Get(key string) any {
 	}
 	return r.Params[key]
 }
+
+// Clone returns a copy of the request that can be modified and sent independently.
+func (r *Request) Clone() *Request {
+	clone := *r
+	if r.query != nil {
+		clone.query = make(url.Values, len(r.query))
+		for key, values := range r.query {
+			clone.query[key] = append([]string(nil), values...)
+		}
+	}
+	if r.form != nil {
+		clone.form = make(url.Values, len(r.form))
+		for key, values := range r.form {
+			clone.form[key] = append([]string(nil), values...)
+		}
+	}
+	if r.header != nil {
+		clone.header = r.header.Clone()
+	}
+	return &clone
+}
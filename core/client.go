@@ -3,12 +3,15 @@ package core
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/gorilla/websocket"
 	"io"
 	"math/rand"
 	"net/http"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -19,6 +22,12 @@ type Client struct {
 	resp       *response
 }
 
+// Clone returns a client sharing options and HTTP client with c but holding its own response state,
+// so that requests can run concurrently.
+func (c *Client) Clone() *Client {
+	return &Client{Opt: c.Opt, HttpClient: c.HttpClient}
+}
+
 func (c *Client) SetReq(path, method string, aType ...AuthType) *Request {
 	reqType := AuthNone
 	if len(aType) > 0 {
@@ -27,11 +36,11 @@ func (c *Client) SetReq(path, method string, aType ...AuthType) *Request {
 	return &Request{method: method, path: path, authType: reqType}
 }
 
-func (c *Client) parseRequest(r *Request) error {
+func (c *Client) parseRequest(r *Request, endpoint string) error {
 	if r.authType == AuthSigned {
 		r.Set("timestamp", time.Now().UnixMilli())
 	}
-	fullUrl := fmt.Sprintf("%s%s", c.Opt.Endpoint, r.path)
+	fullUrl := fmt.Sprintf("%s%s", endpoint, r.path)
 	query := r.query.Encode()
 	form := r.form.Encode()
 	header := http.Header{}
@@ -73,7 +82,6 @@ func (c *Client) parseRequest(r *Request) error {
 		"method", r.method,
 		"path", r.path,
 		"auth_type", r.authType,
-		"full_url", fullUrl,
 	)
 	c.fullUrl = fullUrl
 	r.header = header
@@ -93,7 +101,57 @@ func (c *Client) Invoke(r *Request, ctx context.Context) error {
 }
 
 func (c *Client) invoke(r *Request, ctx context.Context) error {
-	if err := c.parseRequest(r); err != nil {
+	resp, err := chain(c.Opt.interceptors(), c.roundTrip)(ctx, r)
+	c.resp = &response{err: err}
+	if resp != nil {
+		c.resp.status, c.resp.rawHeader, c.resp.rawBody = resp.Status, resp.Header, resp.Body
+	}
+	return err
+}
+
+// roundTrip Checks, signs and sends r, the end of the interceptor chain.
+func (c *Client) roundTrip(ctx context.Context, r *Request) (*Response, error) {
+	if c.Opt.Guard != nil && r.authType == AuthSigned {
+		intent := r.intent()
+		if err := c.Opt.Guard.Check(intent); err != nil {
+			c.Opt.Logger.Debug("request rejected by guard", "path", r.path, "error", err)
+			return nil, err
+		}
+		resp, err := c.send(ctx, r)
+		if err == nil {
+			accepted(c.Opt.Guard, intent)
+		}
+		return resp, err
+	}
+	return c.send(ctx, r)
+}
+
+// send Sends r to the endpoint, or to the failover endpoints in turn.
+func (c *Client) send(ctx context.Context, r *Request) (*Response, error) {
+	if c.Opt.Failover == nil {
+		err := c.do(r, ctx, c.Opt.Endpoint)
+		return c.resp.export(), err
+	}
+	var err error
+	for _, endpoint := range c.Opt.Failover.Endpoints() {
+		start := time.Now()
+		err = c.do(r, ctx, endpoint)
+		if ctx.Err() != nil {
+			break
+		}
+		c.Opt.Failover.report(endpoint, time.Since(start), err, c.resp.status)
+		if !retryable(r.method, err, c.resp.status) {
+			break
+		}
+		c.Opt.Logger.Debug("endpoint failed, trying the next one", "endpoint", endpoint, "error", err)
+	}
+	return c.resp.export(), err
+}
+
+// do Sends r to endpoint and keeps the response in c.resp.
+func (c *Client) do(r *Request, ctx context.Context, endpoint string) error {
+	if err := c.parseRequest(r, endpoint); err != nil {
+		c.resp = &response{err: err}
 		return err
 	}
 	req, err := http.NewRequest(r.method, c.fullUrl, r.body)
@@ -128,21 +186,38 @@ func (c *Client) invoke(r *Request, ctx context.Context) error {
 }
 
 type WsClient struct {
-	Opt  *Options
-	conn *websocket.Conn
+	Opt    *Options
+	conn   *websocket.Conn
+	cancel context.CancelFunc
+	served chan struct{}
+
+	mu          sync.Mutex
+	connections map[string]int
 }
 
 // connect initializes the WebSocket connection.
 func (c *WsClient) connect(ctx context.Context) error {
-	conn, resp, err := websocket.DefaultDialer.DialContext(ctx, c.Opt.Endpoint, nil)
+	conn, err := c.dial(ctx, c.Opt.Endpoint)
 	if err != nil {
-		c.Opt.Logger.Debug("websocket dial failed", "endpoint", c.Opt.Endpoint, "error", err)
 		return err
 	}
-	c.Opt.Logger.Debug("websocket connection established", "endpoint", c.Opt.Endpoint, "status", resp.Status)
 	c.conn = conn
 	return nil
 }
+
+// dial opens a new WebSocket connection to endpoint, independent of the connection held by c.
+func (c *WsClient) dial(ctx context.Context, endpoint string) (*websocket.Conn, error) {
+	conn, resp, err := c.Opt.Dialer.websocketDialer().DialContext(ctx, endpoint, c.Opt.Dialer.header())
+	if err != nil {
+		c.Opt.Logger.Debug("websocket dial failed", "endpoint", endpoint, "error", err)
+		return nil, err
+	}
+	if limit := c.Opt.Dialer.readLimit(); limit > 0 {
+		conn.SetReadLimit(limit)
+	}
+	c.Opt.Logger.Debug("websocket connection established", "endpoint", endpoint, "status", resp.Status)
+	return conn, nil
+}
 func (c *WsClient) SetReq(method string, aType ...AuthType) *WsRequest {
 	reqType := AuthNone
 	if len(aType) > 0 {
@@ -154,13 +229,23 @@ func (c *WsClient) Close() error {
 	return c.close()
 }
 
+// close Stops the read loop of the connection opened by wsApiServe, which sends a close frame and closes the connection,
+// and waits for the loop to exit.
 func (c *WsClient) close() error {
+	if c.served != nil {
+		c.cancel()
+		<-c.served
+		return nil
+	}
 	if c.conn != nil {
 		return c.conn.Close()
 	}
 	return nil
 }
 
+// Combined Appends the raw or combined stream path to Opt.Endpoint.
+//
+// Deprecated: it rewrites the shared endpoint, build the stream url with RawStreamUrl or CombinedStreamUrl and use Serve instead.
 func (c *WsClient) Combined(combine bool) {
 	c.combined(combine)
 }
@@ -173,33 +258,87 @@ func (c *WsClient) combined(combine bool) {
 	}
 }
 
-func (c *WsClient) keepAlive() {
+func (c *WsClient) keepAlive(ctx context.Context, conn *websocket.Conn) {
 	ticker := time.NewTicker(WebsocketStreamsTimeout)
+	defer ticker.Stop()
 
-	lastResponse := time.Now()
-	c.conn.SetPongHandler(func(msg string) error {
-		lastResponse = time.Now()
-		c.Opt.Logger.Debug("received pong", "time", lastResponse.Format(time.RFC3339))
+	var lastResponse atomic.Int64
+	lastResponse.Store(time.Now().UnixNano())
+	conn.SetPongHandler(func(msg string) error {
+		lastResponse.Store(time.Now().UnixNano())
+		c.Opt.Logger.Debug("received pong", "time", time.Now().Format(time.RFC3339))
 		return nil
 	})
 
+	c.Opt.Logger.Debug("websocket keepalive started", "timeout", WebsocketStreamsTimeout.String())
+	for {
+		deadline := time.Now().Add(10 * time.Second)
+		err := conn.WriteControl(websocket.PingMessage, []byte{}, deadline)
+		if err != nil {
+			c.Opt.Logger.Debug("failed to send ping", "error", err)
+			return
+		}
+		c.Opt.Logger.Debug("ping sent", "deadline", deadline.Format(time.RFC3339))
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if time.Since(time.Unix(0, lastResponse.Load())) > WebsocketStreamsTimeout {
+			return
+		}
+	}
+}
+
+// closeConn Sends a normal close frame and closes conn without waiting for the reply of the server.
+func (c *WsClient) closeConn(conn *websocket.Conn) {
+	message := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	if err := conn.WriteControl(websocket.CloseMessage, message, time.Now().Add(time.Second)); err != nil {
+		c.Opt.Logger.Debug("failed to send close frame", "error", err)
+	}
+	_ = conn.Close()
+}
+
+// read Reads conn until ctx is done or the connection fails.
+// Cancelling ctx closes conn at once, so a pending read returns without waiting for the next frame.
+// read returns after the keepalive and close goroutines of conn have exited, the connection error is sent on onError
+// unless ctx is done, and a message is never sent once ctx is done, so a consumer that has gone cannot block it.
+func (c *WsClient) read(ctx context.Context, conn *websocket.Conn, onMessage chan<- []byte, onError chan<- error) {
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+	wg.Add(2)
 	go func() {
-		defer ticker.Stop()
-		c.Opt.Logger.Debug("websocket keepalive started", "timeout", WebsocketStreamsTimeout.String())
-		for {
-			deadline := time.Now().Add(10 * time.Second)
-			err := c.conn.WriteControl(websocket.PingMessage, []byte{}, deadline)
-			if err != nil {
-				c.Opt.Logger.Debug("failed to send ping", "error", err)
-				return
-			}
-			c.Opt.Logger.Debug("ping sent", "deadline", deadline.Format(time.RFC3339))
-			<-ticker.C
-			if time.Since(lastResponse) > WebsocketStreamsTimeout {
+		defer wg.Done()
+		c.keepAlive(ctx, conn)
+	}()
+	go func() {
+		defer wg.Done()
+		<-ctx.Done()
+		c.closeConn(conn)
+	}()
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			if ctx.Err() != nil {
+				c.Opt.Logger.Debug("context done, websocket read stopped")
 				return
 			}
+			c.Opt.Logger.Debug("failed to read message from websocket", "error", err)
+			onError <- err
+			return
 		}
-	}()
+		c.Opt.Logger.Debug("websocket message received", "length", len(message))
+		select {
+		case onMessage <- message:
+		case <-ctx.Done():
+			c.Opt.Logger.Debug("context done, websocket read stopped")
+			return
+		}
+	}
 }
 
 func (c *WsClient) WsServe(ctx context.Context) (<-chan []byte, <-chan error) {
@@ -207,6 +346,17 @@ func (c *WsClient) WsServe(ctx context.Context) (<-chan []byte, <-chan error) {
 }
 
 func (c *WsClient) wsServe(ctx context.Context) (<-chan []byte, <-chan error) {
+	return c.serve(ctx, c.Opt.Endpoint)
+}
+
+// Serve Opens a new connection to endpoint and reads messages from it until ctx is done or the connection fails.
+// Every call owns its connection, so one client can serve any number of streams concurrently.
+// Both channels are closed once the connection is closed and all of its goroutines have exited.
+func (c *WsClient) Serve(ctx context.Context, endpoint string) (<-chan []byte, <-chan error) {
+	return c.serve(ctx, endpoint)
+}
+
+func (c *WsClient) serve(ctx context.Context, endpoint string) (<-chan []byte, <-chan error) {
 	onMessage := make(chan []byte, 8)
 	onError := make(chan error, 1)
 
@@ -217,33 +367,73 @@ func (c *WsClient) wsServe(ctx context.Context) (<-chan []byte, <-chan error) {
 			c.Opt.Logger.Debug("websocket serve goroutine exited")
 		}()
 
-		if err := c.connect(ctx); err != nil {
+		conn, err := c.dial(ctx, endpoint)
+		if err != nil {
 			c.Opt.Logger.Debug("websocket connect failed", "error", err)
 			onError <- err
 			return
 		}
-		defer c.conn.Close()
-		c.keepAlive()
-		for {
-			select {
-			case <-ctx.Done():
-				c.Opt.Logger.Debug("context done, websocket serve stopping")
-				return
-			default:
-				_, message, err := c.conn.ReadMessage()
-				if err != nil {
-					c.Opt.Logger.Debug("failed to read message from websocket", "error", err)
-					onError <- err
-					return
-				}
-				c.Opt.Logger.Debug("websocket message received", "length", len(message))
-				onMessage <- message
-			}
-		}
+		c.connected(endpoint)
+		c.read(ctx, conn, onMessage, onError)
 	}()
 	return onMessage, onError
 }
 
+// Call Sends r on a new connection and returns the first message received, through the WsInterceptors of c.
+func (c *WsClient) Call(ctx context.Context, r *WsRequest) ([]byte, error) {
+	return chainWs(c.Opt.wsInterceptors(), c.roundTrip)(ctx, r)
+}
+
+// roundTrip Opens the connection, signs and sends r and waits for the response, the end of the interceptor chain.
+func (c *WsClient) roundTrip(ctx context.Context, r *WsRequest) ([]byte, error) {
+	onMessage, onError := c.wsApiServe(ctx)
+	defer func() {
+		if err := c.close(); err != nil {
+			c.Opt.Logger.Debug("websocket close failed", "error", err)
+		}
+	}()
+	if err := c.send(r); err != nil {
+		return nil, err
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case message, ok := <-onMessage:
+		if !ok {
+			if err := <-onError; err != nil {
+				return nil, err
+			}
+			return nil, io.ErrUnexpectedEOF
+		}
+		if c.Opt.Guard != nil && r.AuthType == AuthSigned {
+			var resp struct {
+				Status int `json:"status"`
+			}
+			if json.Unmarshal(message, &resp) == nil && resp.Status == http.StatusOK {
+				accepted(c.Opt.Guard, r.intent())
+			}
+		}
+		return message, nil
+	case err := <-onError:
+		return nil, err
+	}
+}
+
+// connected Counts the connections to a stream endpoint and reports them to the Metrics of c.
+func (c *WsClient) connected(endpoint string) {
+	if c.Opt.Metrics == nil {
+		return
+	}
+	c.mu.Lock()
+	if c.connections == nil {
+		c.connections = make(map[string]int)
+	}
+	c.connections[endpoint]++
+	reconnect := c.connections[endpoint] > 1
+	c.mu.Unlock()
+	c.Opt.Metrics.StreamConnected(streamName(endpoint), reconnect)
+}
+
 func (c *WsClient) WsApiServe(ctx context.Context) (<-chan []byte, <-chan error) {
 	return c.wsApiServe(ctx)
 }
@@ -251,12 +441,18 @@ func (c *WsClient) WsApiServe(ctx context.Context) (<-chan []byte, <-chan error)
 func (c *WsClient) wsApiServe(ctx context.Context) (<-chan []byte, <-chan error) {
 	onMessage := make(chan []byte, 8)
 	onError := make(chan error, 1)
-	err := c.connect(ctx)
 	c.Opt.Logger.Debug("attempting websocket connection", "endpoint", c.Opt.Endpoint)
+	err := c.connect(ctx)
+	conn := c.conn
+	ctx, cancel := context.WithCancel(ctx)
+	served := make(chan struct{})
+	c.cancel, c.served = cancel, served
 	go func() {
 		defer func() {
+			cancel()
 			close(onMessage)
 			close(onError)
+			close(served)
 			c.Opt.Logger.Debug("wsApiServe goroutine exited")
 		}()
 		if err != nil {
@@ -264,24 +460,7 @@ func (c *WsClient) wsApiServe(ctx context.Context) (<-chan []byte, <-chan error)
 			onError <- err
 			return
 		}
-		defer c.conn.Close()
-		c.keepAlive()
-		for {
-			select {
-			case <-ctx.Done():
-				c.Opt.Logger.Debug("context done, exiting wsApiServe loop")
-				return
-			default:
-				_, message, err := c.conn.ReadMessage()
-				if err != nil {
-					c.Opt.Logger.Debug("error reading websocket message", "error", err)
-					onError <- err
-					return
-				}
-				c.Opt.Logger.Debug("received websocket message", "length", len(message))
-				onMessage <- message
-			}
-		}
+		c.read(ctx, conn, onMessage, onError)
 	}()
 	return onMessage, onError
 }
@@ -307,13 +486,25 @@ func (c *WsClient) send(r *WsRequest) error {
 		c.Opt.Logger.Debug("cannot send: connection is nil")
 		return errors.New("websocket connection is nil")
 	}
+	if c.Opt.Guard != nil && r.AuthType == AuthSigned {
+		if err := c.Opt.Guard.Check(r.intent()); err != nil {
+			c.Opt.Logger.Debug("request rejected by guard", "method", r.Method, "error", err)
+			return err
+		}
+	}
 	r.Id = uuid4()
 	c.Opt.Logger.Debug("generating request ID", "id", r.Id)
 	if r.AuthType == AuthSigned {
-		r.Params["timestamp"] = time.Now().UnixMilli()
+		r.Set("timestamp", time.Now().UnixMilli())
 	}
+	// The api key and signature go on a copy of the params, so that r can be sent again and is signed afresh.
+	signed := &WsRequest{Id: r.Id, Method: r.Method, Params: r.Params}
 	if r.AuthType == AuthApiKey || r.AuthType == AuthSigned {
-		r.Params["apiKey"] = c.Opt.ApiKey
+		signed.Params = make(map[string]any, len(r.Params)+2)
+		for key, value := range r.Params {
+			signed.Params[key] = value
+		}
+		signed.Params["apiKey"] = c.Opt.ApiKey
 	}
 
 	if r.AuthType == AuthSigned {
@@ -325,15 +516,14 @@ func (c *WsClient) send(r *WsRequest) error {
 		} else {
 			sf = HmacSign
 		}
-		sortedData := SortMap(r.Params)
-		c.Opt.Logger.Debug("sorted params for signature", "params", sortedData)
+		sortedData := SortMap(signed.Params)
 		sign, err := sf(c.Opt.ApiSecret, sortedData)
 		if err != nil {
 			c.Opt.Logger.Debug("signature generation failed", "error", err)
 			return err
 		}
-		r.Params["signature"] = sign
-		c.Opt.Logger.Debug("signature added to request", "signature", sign)
+		signed.Params["signature"] = sign
+		c.Opt.Logger.Debug("signature added to request", "id", r.Id)
 	}
-	return c.conn.WriteJSON(r)
+	return c.conn.WriteJSON(signed)
 }
@@ -0,0 +1,82 @@
+package core
+
+import (
+	"crypto/tls"
+	"github.com/gorilla/websocket"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// DialerConfig Configures how the websocket connections of streams and the WS API are opened,
+// its Proxy and TLSConfig also apply to the http client built by NewHttpClient.
+// Zero fields keep the defaults of websocket.DefaultDialer.
+type DialerConfig struct {
+	// Proxy Returns the proxy of a request, e.g. http.ProxyURL of an http://, https:// or socks5:// url.
+	// The proxy of the environment, see http.ProxyFromEnvironment, is used when nil.
+	Proxy func(*http.Request) (*url.URL, error)
+	// TLSConfig e.g. with RootCAs holding a custom CA.
+	TLSConfig *tls.Config
+	// HandshakeTimeout The time allowed for the websocket handshake, 45 seconds by default.
+	HandshakeTimeout time.Duration
+	// EnableCompression Negotiates permessage-deflate with the server.
+	EnableCompression bool
+	// ReadBufferSize, WriteBufferSize The I/O buffer sizes of a connection in bytes, 4096 by default.
+	ReadBufferSize  int
+	WriteBufferSize int
+	// ReadLimit The maximum size of a received message in bytes, unlimited by default.
+	ReadLimit int64
+	// Header Sent with every websocket handshake.
+	Header http.Header
+}
+
+// websocketDialer The dialer of d, websocket.DefaultDialer when d is nil.
+func (d *DialerConfig) websocketDialer() *websocket.Dialer {
+	dialer := *websocket.DefaultDialer
+	if d == nil {
+		return &dialer
+	}
+	if d.Proxy != nil {
+		dialer.Proxy = d.Proxy
+	}
+	if d.TLSConfig != nil {
+		dialer.TLSClientConfig = d.TLSConfig
+	}
+	if d.HandshakeTimeout > 0 {
+		dialer.HandshakeTimeout = d.HandshakeTimeout
+	}
+	dialer.EnableCompression = d.EnableCompression
+	dialer.ReadBufferSize = d.ReadBufferSize
+	dialer.WriteBufferSize = d.WriteBufferSize
+	return &dialer
+}
+
+func (d *DialerConfig) header() http.Header {
+	if d == nil {
+		return nil
+	}
+	return d.Header
+}
+
+func (d *DialerConfig) readLimit() int64 {
+	if d == nil {
+		return 0
+	}
+	return d.ReadLimit
+}
+
+// NewHttpClient The http client of the REST api for o: http.DefaultClient,
+// or a client whose transport uses the Proxy and TLSConfig of o.Dialer when either is set.
+func NewHttpClient(o *Options) *http.Client {
+	if o == nil || o.Dialer == nil || (o.Dialer.Proxy == nil && o.Dialer.TLSConfig == nil) {
+		return http.DefaultClient
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if o.Dialer.Proxy != nil {
+		transport.Proxy = o.Dialer.Proxy
+	}
+	if o.Dialer.TLSConfig != nil {
+		transport.TLSClientConfig = o.Dialer.TLSConfig
+	}
+	return &http.Client{Transport: transport}
+}
@@ -0,0 +1,163 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// StreamEvent A message of a combined connection tagged with its stream name.
+// Data holds the payload decoded by the stream's descriptor, e.g. *spot.AggTradeEvent,
+// or the undecoded json.RawMessage of a stream the dispatcher does not know.
+type StreamEvent struct {
+	Stream string
+	Data   any
+}
+
+// DecodeError A message of Stream that could not be decoded, the connection stays open.
+type DecodeError struct {
+	Stream string
+	Err    error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("stream %s: %v", e.Stream, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// Dispatcher Serves any mix of stream descriptors over one combined connection, e.g. btcusdt@depth, ethusdt@aggTrade,
+// !markPrice@arr and a listenKey, and routes every {"stream":..,"data":..} envelope to the decoder of its stream.
+// All descriptors must belong to the endpoint of the client, spot descriptors to a spot client and so on.
+type Dispatcher struct {
+	c             *WsClient
+	names         []string
+	streams       map[string]Descriptor
+	handlers      map[string][]func(any)
+	typeHandlers  []func(string, any)
+	onDecodeError func(*DecodeError)
+}
+
+// NewDispatcher A dispatcher of descriptors on a new combined connection of c.
+func NewDispatcher(c *WsClient, descriptors ...Descriptor) *Dispatcher {
+	d := &Dispatcher{c: c, streams: make(map[string]Descriptor), handlers: make(map[string][]func(any))}
+	for _, descriptor := range descriptors {
+		d.add(descriptor)
+	}
+	return d
+}
+
+func (d *Dispatcher) add(descriptor Descriptor) {
+	if _, ok := d.streams[descriptor.Name()]; ok {
+		return
+	}
+	d.names = append(d.names, descriptor.Name())
+	d.streams[descriptor.Name()] = descriptor
+}
+
+// Handle Adds descriptor to the subscription of d and calls handler with each of its decoded messages when d runs.
+func Handle[T any](d *Dispatcher, descriptor StreamDescriptor[T], handler func(T)) *Dispatcher {
+	d.add(descriptor)
+	d.handlers[descriptor.Name()] = append(d.handlers[descriptor.Name()], func(data any) {
+		handler(data.(T))
+	})
+	return d
+}
+
+// On Calls handler with every message decoded into a T, whichever stream it arrived on.
+// e.g. On(d, func(stream string, e *spot.AggTradeEvent) {...}) receives the aggregate trades of every subscribed symbol,
+// the streams themselves are subscribed with NewDispatcher or Handle.
+func On[T any](d *Dispatcher, handler func(stream string, data T)) *Dispatcher {
+	d.typeHandlers = append(d.typeHandlers, func(stream string, data any) {
+		if v, ok := data.(T); ok {
+			handler(stream, v)
+		}
+	})
+	return d
+}
+
+// OnDecodeError Calls handler with the messages Run could not decode, they are skipped otherwise.
+func (d *Dispatcher) OnDecodeError(handler func(*DecodeError)) *Dispatcher {
+	d.onDecodeError = handler
+	return d
+}
+
+// Streams The stream names of the subscription, in order.
+func (d *Dispatcher) Streams() []string {
+	return append([]string(nil), d.names...)
+}
+
+func (d *Dispatcher) decode(message []byte) (*StreamEvent, error) {
+	var envelope CombinedEvent[json.RawMessage]
+	if err := json.Unmarshal(message, &envelope); err != nil {
+		return nil, &DecodeError{Err: err}
+	}
+	event := &StreamEvent{Stream: envelope.Stream, Data: envelope.Data}
+	descriptor, ok := d.streams[envelope.Stream]
+	if !ok {
+		return event, nil
+	}
+	data, err := descriptor.DecodeAny(envelope.Data)
+	if err != nil {
+		return nil, &DecodeError{Stream: envelope.Stream, Err: err}
+	}
+	event.Data = data
+	return event, nil
+}
+
+// Do Delivers every message as a tagged StreamEvent, switch on its Data type or Stream name to handle it.
+// Messages that cannot be decoded are reported on the error channel as *DecodeError.
+func (d *Dispatcher) Do(ctx context.Context) (<-chan *StreamEvent, <-chan error) {
+	return d.stream().Do(ctx)
+}
+
+func (d *Dispatcher) stream() *Stream[*StreamEvent] {
+	return NewStream(d.c, CombinedStreamUrl(d.c.Opt.Endpoint, d.names...), d.decode)
+}
+
+// Run Calls the handlers registered with Handle and On for every message until ctx is done or the connection fails.
+// Messages without a handler are dropped. Run returns the connection error, or ctx.Err() once ctx is done,
+// after the connection is closed.
+func (d *Dispatcher) Run(ctx context.Context) error {
+	stream := d.stream()
+	events, errs := stream.Do(ctx)
+	defer func() {
+		_ = stream.Close()
+		_ = stream.Wait()
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-events:
+			if !ok {
+				return ctx.Err()
+			}
+			d.dispatch(event)
+		case err, ok := <-errs:
+			if !ok {
+				return ctx.Err()
+			}
+			var decodeErr *DecodeError
+			if errors.As(err, &decodeErr) {
+				if d.onDecodeError != nil {
+					d.onDecodeError(decodeErr)
+				}
+				continue
+			}
+			return err
+		}
+	}
+}
+
+func (d *Dispatcher) dispatch(event *StreamEvent) {
+	for _, handler := range d.handlers[event.Stream] {
+		handler(event.Data)
+	}
+	for _, handler := range d.typeHandlers {
+		handler(event.Stream, event.Data)
+	}
+}
@@ -58,13 +58,17 @@ const (
 type OrderTypeEnum string
 
 const (
-	OrderTypeLIMIT             OrderTypeEnum = "LIMIT"
-	OrderTypeMARKET                          = "MARKET"
-	OrderTypeSTOP_LOSS                       = "STOP_LOSS"
-	OrderTypeSTOP_LOSS_LIMIT                 = "STOP_LOSS_LIMIT"
-	OrderTypeTAKE_PROFIT                     = "TAKE_PROFIT"
-	OrderTypeTAKE_PROFIT_LIMIT               = "TAKE_PROFIT_LIMIT"
-	OrderTypeLIMIT_MAKER                     = "LIMIT_MAKER"
+	OrderTypeLIMIT                OrderTypeEnum = "LIMIT"
+	OrderTypeMARKET                             = "MARKET"
+	OrderTypeSTOP_LOSS                          = "STOP_LOSS"
+	OrderTypeSTOP_LOSS_LIMIT                    = "STOP_LOSS_LIMIT"
+	OrderTypeTAKE_PROFIT                        = "TAKE_PROFIT"
+	OrderTypeTAKE_PROFIT_LIMIT                  = "TAKE_PROFIT_LIMIT"
+	OrderTypeLIMIT_MAKER                        = "LIMIT_MAKER"
+	OrderTypeSTOP                               = "STOP"
+	OrderTypeSTOP_MARKET                        = "STOP_MARKET"
+	OrderTypeTAKE_PROFIT_MARKET                 = "TAKE_PROFIT_MARKET"
+	OrderTypeTRAILING_STOP_MARKET               = "TRAILING_STOP_MARKET"
 )
 
 type TimeInForceEnum string
@@ -183,3 +187,55 @@ const (
 	IncomeType_FEE_RETURN                             = "FEE_RETURN"
 	IncomeType_BFUSD_REWARD                           = "BFUSD_REWARD"
 )
+
+type SideEffectTypeEnum string
+
+const (
+	SideEffectTypeNO_SIDE_EFFECT    SideEffectTypeEnum = "NO_SIDE_EFFECT"
+	SideEffectTypeMARGIN_BUY                           = "MARGIN_BUY"
+	SideEffectTypeAUTO_REPAY                           = "AUTO_REPAY"
+	SideEffectTypeAUTO_BORROW_REPAY                    = "AUTO_BORROW_REPAY"
+)
+
+type BorrowRepayTypeEnum string
+
+const (
+	BorrowRepayTypeBORROW BorrowRepayTypeEnum = "BORROW"
+	BorrowRepayTypeREPAY                      = "REPAY"
+)
+
+type UniversalTransferTypeEnum string
+
+const (
+	UniversalTransferMAIN_UMFUTURE                 UniversalTransferTypeEnum = "MAIN_UMFUTURE"
+	UniversalTransferMAIN_CMFUTURE                                           = "MAIN_CMFUTURE"
+	UniversalTransferMAIN_MARGIN                                             = "MAIN_MARGIN"
+	UniversalTransferMAIN_FUNDING                                            = "MAIN_FUNDING"
+	UniversalTransferMAIN_OPTION                                             = "MAIN_OPTION"
+	UniversalTransferMAIN_PORTFOLIO_MARGIN                                   = "MAIN_PORTFOLIO_MARGIN"
+	UniversalTransferUMFUTURE_MAIN                                           = "UMFUTURE_MAIN"
+	UniversalTransferUMFUTURE_MARGIN                                         = "UMFUTURE_MARGIN"
+	UniversalTransferUMFUTURE_FUNDING                                        = "UMFUTURE_FUNDING"
+	UniversalTransferCMFUTURE_MAIN                                           = "CMFUTURE_MAIN"
+	UniversalTransferCMFUTURE_MARGIN                                         = "CMFUTURE_MARGIN"
+	UniversalTransferMARGIN_MAIN                                             = "MARGIN_MAIN"
+	UniversalTransferMARGIN_UMFUTURE                                         = "MARGIN_UMFUTURE"
+	UniversalTransferMARGIN_CMFUTURE                                         = "MARGIN_CMFUTURE"
+	UniversalTransferISOLATEDMARGIN_MARGIN                                   = "ISOLATEDMARGIN_MARGIN"
+	UniversalTransferMARGIN_ISOLATEDMARGIN                                   = "MARGIN_ISOLATEDMARGIN"
+	UniversalTransferISOLATEDMARGIN_ISOLATEDMARGIN                           = "ISOLATEDMARGIN_ISOLATEDMARGIN"
+	UniversalTransferFUNDING_MAIN                                            = "FUNDING_MAIN"
+	UniversalTransferFUNDING_UMFUTURE                                        = "FUNDING_UMFUTURE"
+	UniversalTransferOPTION_MAIN                                             = "OPTION_MAIN"
+	UniversalTransferPORTFOLIO_MARGIN_MAIN                                   = "PORTFOLIO_MARGIN_MAIN"
+)
+
+type AccountTypeEnum string
+
+const (
+	AccountTypeSPOT            AccountTypeEnum = "SPOT"
+	AccountTypeUSDT_FUTURE                     = "USDT_FUTURE"
+	AccountTypeCOIN_FUTURE                     = "COIN_FUTURE"
+	AccountTypeMARGIN                          = "MARGIN"
+	AccountTypeISOLATED_MARGIN                 = "ISOLATED_MARGIN"
+)
@@ -0,0 +1,89 @@
+package core
+
+// Environment The Binance deployment a client talks to. The zero value is production.
+type Environment int
+
+const (
+	EnvironmentProduction Environment = iota
+	EnvironmentTestnet
+	EnvironmentDemo
+)
+
+// Endpoints The base urls of an Environment. An empty url means the environment does not offer that api.
+type Endpoints struct {
+	Rest            string
+	WsApi           string
+	Stream          string
+	FuturesRest     string
+	FuturesWsApi    string
+	FuturesStream   string
+	DeliveryRest    string
+	DeliveryStream  string
+	OptionsRest     string
+	OptionsStream   string
+	PortfolioRest   string
+	PortfolioStream string
+	FixOrderEntry   string
+	FixDropCopy     string
+	FixMarketData   string
+}
+
+var environments = map[Environment]Endpoints{
+	EnvironmentProduction: {
+		Rest:            BaseURL,
+		WsApi:           ApiBaseURL,
+		Stream:          WsBaseURL,
+		FuturesRest:     FuturesUrl,
+		FuturesWsApi:    FuturesBaseURL,
+		FuturesStream:   FuturesStreamUrl,
+		DeliveryRest:    DeliveryUrl,
+		DeliveryStream:  DeliveryStreamUrl,
+		OptionsRest:     OptionsUrl,
+		OptionsStream:   OptionsStreamUrl,
+		PortfolioRest:   PortfolioUrl,
+		PortfolioStream: PortfolioStreamUrl,
+		FixOrderEntry:   FixOrderEntryUrl,
+		FixDropCopy:     FixDropCopyUrl,
+		FixMarketData:   FixMarketDataUrl,
+	},
+	EnvironmentTestnet: {
+		Rest:           TestnetURL,
+		WsApi:          ApiTestnetURL,
+		Stream:         WsTestnetURL,
+		FuturesRest:    FuturesTestnetUrl,
+		FuturesWsApi:   FuturesTestnetBaseURL,
+		FuturesStream:  FuturesStreamTestnetUrl,
+		DeliveryRest:   DeliveryTestnetUrl,
+		DeliveryStream: DeliveryStreamTestnetUrl,
+		FixOrderEntry:  FixOrderEntryTestnetUrl,
+		FixDropCopy:    FixDropCopyTestnetUrl,
+		FixMarketData:  FixMarketDataTestnetUrl,
+	},
+	EnvironmentDemo: {
+		Rest:          DemoURL,
+		WsApi:         ApiDemoURL,
+		Stream:        WsDemoURL,
+		FuturesRest:   FuturesDemoUrl,
+		FuturesWsApi:  FuturesTestnetBaseURL,
+		FuturesStream: FuturesStreamTestnetUrl,
+	},
+}
+
+// Endpoints The base urls of e, those of production for an unknown environment.
+func (e Environment) Endpoints() Endpoints {
+	if endpoints, ok := environments[e]; ok {
+		return endpoints
+	}
+	return environments[EnvironmentProduction]
+}
+
+func (e Environment) String() string {
+	switch e {
+	case EnvironmentTestnet:
+		return "testnet"
+	case EnvironmentDemo:
+		return "demo"
+	default:
+		return "production"
+	}
+}
@@ -0,0 +1,199 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// SpotEndpoints The spot REST hosts of production in order of preference: api.binance.com, then the alternates
+// api1-api4, which may perform better but are less stable, and api-gcp.
+var SpotEndpoints = []string{
+	BaseURL,
+	"https://api1.binance.com",
+	"https://api2.binance.com",
+	"https://api3.binance.com",
+	"https://api4.binance.com",
+	"https://api-gcp.binance.com",
+}
+
+// DefaultFailoverCooldown How long a failed endpoint is skipped before it is tried again.
+const DefaultFailoverCooldown = 30 * time.Second
+
+// FailoverSelection How a Failover orders its healthy endpoints.
+type FailoverSelection int
+
+const (
+	// SelectOrdered Prefer the endpoints in the order they were given.
+	SelectOrdered FailoverSelection = iota
+	// SelectLatency Prefer the endpoint with the lowest measured latency, endpoints without a measurement come last in order.
+	// Latencies are measured on every response and by Probe.
+	SelectLatency
+)
+
+// Failover Spreads the REST requests of a client over several hosts of the same api, e.g. SpotEndpoints.
+// An endpoint that fails with a connection error or a 5xx status is skipped for the cooldown and the request moves on
+// to the next endpoint. Only GET requests, and requests that never reached a host, are retried on the next endpoint:
+// an order whose response was lost may have been executed.
+// A Failover is safe for concurrent use and is meant to be shared by the clients of one api through Options.
+type Failover struct {
+	mu        sync.Mutex
+	endpoints []*endpointHealth
+	selection FailoverSelection
+	cooldown  time.Duration
+}
+
+type endpointHealth struct {
+	url       string
+	downUntil time.Time
+	latency   time.Duration
+}
+
+// NewFailover A failover over endpoints, ordered by preference.
+func NewFailover(endpoints ...string) *Failover {
+	f := &Failover{selection: SelectOrdered, cooldown: DefaultFailoverCooldown}
+	for _, endpoint := range endpoints {
+		f.endpoints = append(f.endpoints, &endpointHealth{url: endpoint})
+	}
+	return f
+}
+
+// NewSpotFailover A failover over SpotEndpoints.
+func NewSpotFailover() *Failover {
+	return NewFailover(SpotEndpoints...)
+}
+
+// Selection SelectOrdered by default.
+func (f *Failover) Selection(selection FailoverSelection) *Failover {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.selection = selection
+	return f
+}
+
+// Cooldown DefaultFailoverCooldown by default.
+func (f *Failover) Cooldown(cooldown time.Duration) *Failover {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.cooldown = cooldown
+	return f
+}
+
+// Endpoints The endpoints in the order the next request tries them: the healthy ones by the selection,
+// then the failed ones by the end of their cooldown.
+func (f *Failover) Endpoints() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	now := time.Now()
+	var healthy, down []*endpointHealth
+	for _, endpoint := range f.endpoints {
+		if now.Before(endpoint.downUntil) {
+			down = append(down, endpoint)
+		} else {
+			healthy = append(healthy, endpoint)
+		}
+	}
+	if f.selection == SelectLatency {
+		sort.SliceStable(healthy, func(i, j int) bool {
+			a, b := healthy[i].latency, healthy[j].latency
+			if a == 0 || b == 0 {
+				return b == 0 && a != 0
+			}
+			return a < b
+		})
+	}
+	sort.SliceStable(down, func(i, j int) bool {
+		return down[i].downUntil.Before(down[j].downUntil)
+	})
+	urls := make([]string, 0, len(f.endpoints))
+	for _, endpoint := range append(healthy, down...) {
+		urls = append(urls, endpoint.url)
+	}
+	return urls
+}
+
+// Latency The smoothed latency measured for endpoint, zero when it was not measured yet.
+func (f *Failover) Latency(endpoint string) time.Duration {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if e := f.find(endpoint); e != nil {
+		return e.latency
+	}
+	return 0
+}
+
+// Probe Measures every endpoint with a GET of path, e.g. /api/v3/ping, and marks the ones that fail.
+func (f *Failover) Probe(ctx context.Context, client *http.Client, path string) {
+	var wg sync.WaitGroup
+	for _, endpoint := range f.Endpoints() {
+		wg.Add(1)
+		go func(endpoint string) {
+			defer wg.Done()
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+path, nil)
+			if err != nil {
+				return
+			}
+			start := time.Now()
+			res, err := client.Do(req)
+			status := 0
+			if err == nil {
+				status = res.StatusCode
+				_ = res.Body.Close()
+			}
+			if ctx.Err() == nil {
+				f.report(endpoint, time.Since(start), err, status)
+			}
+		}(endpoint)
+	}
+	wg.Wait()
+}
+
+func (f *Failover) find(endpoint string) *endpointHealth {
+	for _, e := range f.endpoints {
+		if e.url == endpoint {
+			return e
+		}
+	}
+	return nil
+}
+
+// report Records the outcome of a request to endpoint.
+func (f *Failover) report(endpoint string, latency time.Duration, err error, status int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	e := f.find(endpoint)
+	if e == nil {
+		return
+	}
+	if unhealthy(err, status) {
+		e.downUntil = time.Now().Add(f.cooldown)
+		return
+	}
+	e.downUntil = time.Time{}
+	if e.latency == 0 {
+		e.latency = latency
+	} else {
+		e.latency = (4*e.latency + latency) / 5
+	}
+}
+
+// unhealthy A connection error or a server side failure of the host, rejections such as 4xx are the caller's.
+func unhealthy(err error, status int) bool {
+	return (err != nil && status == 0) || status >= http.StatusInternalServerError
+}
+
+// retryable Whether a request that failed on one endpoint may be sent to the next one.
+func retryable(method string, err error, status int) bool {
+	if !unhealthy(err, status) {
+		return false
+	}
+	if method == http.MethodGet {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
@@ -0,0 +1,52 @@
+package core
+
+import "fmt"
+
+// OrderIntent The logical view of a signed request, handed to an OrderGuard before it is signed and sent.
+// REST requests carry Path and HttpMethod, WebSocket API requests carry Method and FIX order entry messages carry MsgType,
+// with their fields named as the REST params, e.g. symbol, side, quantity and price.
+type OrderIntent struct {
+	Path       string
+	HttpMethod string
+	Method     string
+	MsgType    string
+	Params     map[string]string
+}
+
+// OrderGuard Pre-trade check run on every signed request. A non-nil error aborts the request before it leaves the client.
+type OrderGuard interface {
+	Check(intent *OrderIntent) error
+}
+
+// OrderTracker Optionally implemented by an OrderGuard that counts the orders it let through.
+// Accepted is called once a checked request got a successful response, failed and rejected sends are not reported
+// and a retried request is reported once.
+type OrderTracker interface {
+	Accepted(intent *OrderIntent)
+}
+
+// accepted Reports intent to guard if it tracks orders.
+func accepted(guard OrderGuard, intent *OrderIntent) {
+	if tracker, ok := guard.(OrderTracker); ok {
+		tracker.Accepted(intent)
+	}
+}
+
+func (r *Request) intent() *OrderIntent {
+	params := make(map[string]string, len(r.query)+len(r.form))
+	for key := range r.query {
+		params[key] = r.query.Get(key)
+	}
+	for key := range r.form {
+		params[key] = r.form.Get(key)
+	}
+	return &OrderIntent{Path: r.path, HttpMethod: r.method, Params: params}
+}
+
+func (r *WsRequest) intent() *OrderIntent {
+	params := make(map[string]string, len(r.Params))
+	for key, value := range r.Params {
+		params[key] = fmt.Sprintf("%v", value)
+	}
+	return &OrderIntent{Method: r.Method, Params: params}
+}
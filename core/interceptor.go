@@ -0,0 +1,128 @@
+package core
+
+import (
+	"context"
+	"net/http"
+)
+
+// Response The http response of a REST request as seen by interceptors.
+type Response struct {
+	Status int
+	Header http.Header
+	Body   []byte
+}
+
+// Invoker Sends a REST request, the last invoker of a chain calls the api.
+type Invoker func(ctx context.Context, r *Request) (*Response, error)
+
+// Interceptor Wraps the REST requests of a client, e.g. for audit logging, tagging, metrics or circuit breaking.
+// It may inspect or modify r before calling next, inspect or replace the response and error after it,
+// call next several times to retry, or return without calling next to short-circuit the request.
+// The OrderGuard and the signature are applied after the chain, to the request as the interceptors left it.
+type Interceptor func(ctx context.Context, r *Request, next Invoker) (*Response, error)
+
+// WsInvoker Sends a WebSocket API request and returns the raw response message, the last invoker of a chain calls the api.
+type WsInvoker func(ctx context.Context, r *WsRequest) ([]byte, error)
+
+// WsInterceptor Wraps the WebSocket API requests of a client, the counterpart of Interceptor.
+type WsInterceptor func(ctx context.Context, r *WsRequest, next WsInvoker) ([]byte, error)
+
+// ChainInterceptors Composes interceptors into one, the first is the outermost.
+func ChainInterceptors(interceptors ...Interceptor) Interceptor {
+	return func(ctx context.Context, r *Request, next Invoker) (*Response, error) {
+		return chain(interceptors, next)(ctx, r)
+	}
+}
+
+// ChainWsInterceptors Composes interceptors into one, the first is the outermost.
+func ChainWsInterceptors(interceptors ...WsInterceptor) WsInterceptor {
+	return func(ctx context.Context, r *WsRequest, next WsInvoker) ([]byte, error) {
+		return chainWs(interceptors, next)(ctx, r)
+	}
+}
+
+// interceptors The chain of o, ending with the Audit and Metrics of o so that every request sent is recorded.
+func (o *Options) interceptors() []Interceptor {
+	if o.Audit == nil && o.Metrics == nil {
+		return o.Interceptors
+	}
+	interceptors := append([]Interceptor(nil), o.Interceptors...)
+	if o.Audit != nil {
+		interceptors = append(interceptors, auditInterceptor(o))
+	}
+	if o.Metrics != nil {
+		interceptors = append(interceptors, metricsInterceptor(o.Metrics))
+	}
+	return interceptors
+}
+
+// wsInterceptors The chain of o, ending with the Audit and Metrics of o so that every request sent is recorded.
+func (o *Options) wsInterceptors() []WsInterceptor {
+	if o.Audit == nil && o.Metrics == nil {
+		return o.WsInterceptors
+	}
+	interceptors := append([]WsInterceptor(nil), o.WsInterceptors...)
+	if o.Audit != nil {
+		interceptors = append(interceptors, wsAuditInterceptor(o))
+	}
+	if o.Metrics != nil {
+		interceptors = append(interceptors, wsMetricsInterceptor(o.Metrics))
+	}
+	return interceptors
+}
+
+func chain(interceptors []Interceptor, invoker Invoker) Invoker {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoker
+		invoker = func(ctx context.Context, r *Request) (*Response, error) {
+			return interceptor(ctx, r, next)
+		}
+	}
+	return invoker
+}
+
+func chainWs(interceptors []WsInterceptor, invoker WsInvoker) WsInvoker {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoker
+		invoker = func(ctx context.Context, r *WsRequest) ([]byte, error) {
+			return interceptor(ctx, r, next)
+		}
+	}
+	return invoker
+}
+
+// Use Appends interceptors to the chain of c and of the clients sharing its Options.
+// It is meant for setup: call it before c or a client sharing its Options sends requests, it is not safe alongside them.
+// The chain is copied, so a slice passed in Options.Interceptors is never modified.
+func (c *Client) Use(interceptors ...Interceptor) *Client {
+	c.Opt.Interceptors = append(append([]Interceptor(nil), c.Opt.Interceptors...), interceptors...)
+	return c
+}
+
+// Use Appends interceptors to the chain of c and of the clients sharing its Options.
+// It is meant for setup: call it before c or a client sharing its Options sends requests, it is not safe alongside them.
+// The chain is copied, so a slice passed in Options.WsInterceptors is never modified.
+func (c *WsClient) Use(interceptors ...WsInterceptor) *WsClient {
+	c.Opt.WsInterceptors = append(append([]WsInterceptor(nil), c.Opt.WsInterceptors...), interceptors...)
+	return c
+}
+
+// Method The http method of r.
+func (r *Request) Method() string {
+	return r.method
+}
+
+// Path The path of r, e.g. /api/v3/order.
+func (r *Request) Path() string {
+	return r.path
+}
+
+// AuthType The authentication r requires.
+func (r *Request) AuthType() AuthType {
+	return r.authType
+}
+
+// Params The query and form parameters of r, before the timestamp and signature are added.
+func (r *Request) Params() map[string]string {
+	return r.intent().Params
+}
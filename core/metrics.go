@@ -0,0 +1,159 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Metrics Receives the instrumentation of a client, set it with Options.Metrics.
+// Bind it to Prometheus, OpenTelemetry or any other backend, the library depends on none of them.
+// Implementations must be safe for concurrent use and should not block.
+type Metrics interface {
+	// StartCall Starts the span or timer of a REST or WebSocket API request, end is called once with its outcome.
+	// The returned context is used for the request, e.g. to carry the span.
+	StartCall(ctx context.Context, call *Call) (context.Context, func(*CallResult))
+	// StreamConnected Records a connection of stream, reconnect is true when the client connected stream before.
+	StreamConnected(stream string, reconnect bool)
+	// StreamMessage Records a message of stream: lag is its local receive time minus its event time E,
+	// zero for messages without one, and queued the number of messages waiting for the consumer after it.
+	StreamMessage(stream string, lag time.Duration, queued int)
+}
+
+// CallKind The api a Call goes to.
+type CallKind string
+
+const (
+	CallRest  CallKind = "rest"
+	CallWsApi CallKind = "ws_api"
+	CallFix   CallKind = "fix"
+)
+
+// Call A REST or WebSocket API request as seen by Metrics.
+type Call struct {
+	Kind CallKind
+	// Endpoint The path of a REST request, e.g. /api/v3/order, or the method of a WebSocket API request, e.g. order.place.
+	Endpoint string
+	// HttpMethod The http method of a REST request.
+	HttpMethod string
+	AuthType   AuthType
+}
+
+// CallResult The outcome of a Call.
+type CallResult struct {
+	// Status The http status of a REST response or the status of a WebSocket API response, zero without a response.
+	Status int
+	// Code The Binance error code of a failed request, e.g. -1121, zero when there is none.
+	Code     int
+	Duration time.Duration
+	Err      error
+}
+
+// metricsInterceptor Reports every REST request to m.
+func metricsInterceptor(m Metrics) Interceptor {
+	return func(ctx context.Context, r *Request, next Invoker) (*Response, error) {
+		start := time.Now()
+		ctx, end := m.StartCall(ctx, &Call{Kind: CallRest, Endpoint: r.path, HttpMethod: r.method, AuthType: r.authType})
+		resp, err := next(ctx, r)
+		result := &CallResult{Duration: time.Since(start), Err: err}
+		if resp != nil {
+			result.Status = resp.Status
+			result.Code = errorCode(resp.Body)
+		}
+		end(result)
+		return resp, err
+	}
+}
+
+// wsMetricsInterceptor Reports every WebSocket API request to m.
+func wsMetricsInterceptor(m Metrics) WsInterceptor {
+	return func(ctx context.Context, r *WsRequest, next WsInvoker) ([]byte, error) {
+		start := time.Now()
+		ctx, end := m.StartCall(ctx, &Call{Kind: CallWsApi, Endpoint: r.Method, AuthType: r.AuthType})
+		message, err := next(ctx, r)
+		result := &CallResult{Duration: time.Since(start), Err: err}
+		if message != nil {
+			var resp struct {
+				Status int `json:"status"`
+			}
+			if json.Unmarshal(message, &resp) == nil {
+				result.Status = resp.Status
+			}
+			result.Code = errorCode(message)
+		}
+		end(result)
+		return message, err
+	}
+}
+
+// errorCode The code of a REST error {"code":-1121,"msg":..} or a WebSocket API error {"error":{"code":-1121,..}}.
+func errorCode(body []byte) int {
+	var resp struct {
+		Code  int `json:"code"`
+		Error *struct {
+			Code int `json:"code"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(body, &resp) != nil {
+		return 0
+	}
+	if resp.Error != nil {
+		return resp.Error.Code
+	}
+	return resp.Code
+}
+
+// streamName The stream names of a raw or combined stream url, e.g. btcusdt@aggTrade or btcusdt@depth/ethusdt@depth.
+func streamName(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return endpoint
+	}
+	if streams := u.Query().Get("streams"); streams != "" {
+		return streams
+	}
+	if i := strings.LastIndex(u.Path, "/ws/"); i >= 0 {
+		return u.Path[i+len("/ws/"):]
+	}
+	return u.Path
+}
+
+// eventTimeField The event time of a stream message. The event type e is named too,
+// or it would be matched to E by the case-insensitive decoder.
+type eventTimeField struct {
+	Type json.RawMessage `json:"e"`
+	E    int64           `json:"E"`
+}
+
+// eventLag The local receive time minus the event time E of message, of the payload of a combined message
+// or of the first event of an array, zero when it has none.
+func eventLag(message []byte, received time.Time) time.Duration {
+	message = bytes.TrimSpace(message)
+	if len(message) == 0 {
+		return 0
+	}
+	if message[0] == '[' {
+		var events []eventTimeField
+		if json.Unmarshal(message, &events) != nil || len(events) == 0 || events[0].E == 0 {
+			return 0
+		}
+		return received.Sub(time.UnixMilli(events[0].E))
+	}
+	var event struct {
+		eventTimeField
+		Data json.RawMessage `json:"data"`
+	}
+	if json.Unmarshal(message, &event) != nil {
+		return 0
+	}
+	if event.E == 0 {
+		if len(event.Data) > 0 {
+			return eventLag(event.Data, received)
+		}
+		return 0
+	}
+	return received.Sub(time.UnixMilli(event.E))
+}
@@ -18,10 +18,36 @@ const (
 	ApiBaseURL    = "wss://ws-api.binance.com:443/ws-api/v3"
 	ApiTestnetURL = "wss://ws-api.testnet.binance.vision/ws-api/v3"
 
+	DemoURL    = "https://demo-api.binance.com"
+	WsDemoURL  = "wss://demo-stream.binance.com:9443"
+	ApiDemoURL = "wss://demo-ws-api.binance.com/ws-api/v3"
+
 	FuturesBaseURL        = "wss://ws-fapi.binance.com/ws-fapi/v1"
 	FuturesTestnetBaseURL = "wss://testnet.binancefuture.com/ws-fapi/v1"
 
-	FuturesStreamUrl = "wss://fstream.binance.com"
+	FuturesStreamUrl        = "wss://fstream.binance.com"
+	FuturesStreamTestnetUrl = "wss://fstream.binancefuture.com"
+
+	FuturesDemoUrl = "https://demo-fapi.binance.com"
+
+	DeliveryUrl        = "https://dapi.binance.com"
+	DeliveryTestnetUrl = "https://testnet.binancefuture.com"
+
+	DeliveryStreamUrl        = "wss://dstream.binance.com"
+	DeliveryStreamTestnetUrl = "wss://dstream.binancefuture.com"
+
+	OptionsUrl       = "https://eapi.binance.com"
+	OptionsStreamUrl = "wss://nbstream.binance.com/eoptions"
+
+	PortfolioUrl       = "https://papi.binance.com"
+	PortfolioStreamUrl = "wss://fstream.binance.com/pm"
+
+	FixOrderEntryUrl        = "tcp+tls://fix-oe.binance.com:9000"
+	FixOrderEntryTestnetUrl = "tcp+tls://fix-oe.testnet.binance.vision:9000"
+	FixDropCopyUrl          = "tcp+tls://fix-dc.binance.com:9000"
+	FixDropCopyTestnetUrl   = "tcp+tls://fix-dc.testnet.binance.vision:9000"
+	FixMarketDataUrl        = "tcp+tls://fix-md.binance.com:9000"
+	FixMarketDataTestnetUrl = "tcp+tls://fix-md.testnet.binance.vision:9000"
 )
 
 var WebsocketStreamsTimeout = time.Second * 60
@@ -33,11 +59,27 @@ type Options struct {
 	SignType  SignType
 
 	Logger *slog.Logger
+	// Guard is consulted before every signed request, see OrderGuard.
+	Guard OrderGuard
+	// Dialer configures the websocket connections and the proxy of the default http client, see DialerConfig.
+	Dialer *DialerConfig
+	// Environment selects the default Endpoint of every client, production by default. See Environment.Endpoints.
+	Environment Environment
+	// Failover spreads REST requests over several hosts and replaces Endpoint when set, see NewFailover.
+	Failover *Failover
+	// Interceptors wrap every REST request, the first is the outermost, see Interceptor.
+	Interceptors []Interceptor
+	// WsInterceptors wrap every WebSocket API request, the first is the outermost, see WsInterceptor.
+	WsInterceptors []WsInterceptor
+	// Metrics receives the spans and timers of requests and the statistics of streams, see Metrics.
+	Metrics Metrics
+	// Audit receives a redacted record of every signed request and its response, see AuditSink.
+	Audit AuditSink
 }
 
 func (o *Options) init() {
 	if o.Endpoint == "" {
-		o.Endpoint = BaseURL
+		o.Endpoint = o.Environment.Endpoints().Rest
 	}
 	if o.Logger == nil {
 		o.Logger = slog.Default()
@@ -46,7 +88,7 @@ func (o *Options) init() {
 
 func (o *Options) initFutures() {
 	if o.Endpoint == "" {
-		o.Endpoint = FuturesUrl
+		o.Endpoint = o.Environment.Endpoints().FuturesRest
 	}
 	if o.Logger == nil {
 		o.Logger = slog.Default()
@@ -71,7 +113,7 @@ func NewFuturesOptions(opt ...Options) *Options {
 
 func (o *Options) wsInit() {
 	if o.Endpoint == "" {
-		o.Endpoint = WsBaseURL
+		o.Endpoint = o.Environment.Endpoints().Stream
 	}
 	if o.Logger == nil {
 		o.Logger = slog.Default()
@@ -79,7 +121,7 @@ func (o *Options) wsInit() {
 }
 func (o *Options) initApi() {
 	if o.Endpoint == "" {
-		o.Endpoint = ApiBaseURL
+		o.Endpoint = o.Environment.Endpoints().WsApi
 	}
 	if o.Logger == nil {
 		o.Logger = slog.Default()
@@ -88,7 +130,7 @@ func (o *Options) initApi() {
 
 func (o *Options) initFuturesApi() {
 	if o.Endpoint == "" {
-		o.Endpoint = FuturesBaseURL
+		o.Endpoint = o.Environment.Endpoints().FuturesWsApi
 	}
 	if o.Logger == nil {
 		o.Logger = slog.Default()
@@ -96,7 +138,7 @@ func (o *Options) initFuturesApi() {
 }
 func (o *Options) initFutureStream() {
 	if o.Endpoint == "" {
-		o.Endpoint = FuturesStreamUrl
+		o.Endpoint = o.Environment.Endpoints().FuturesStream
 	}
 	if o.Logger == nil {
 		o.Logger = slog.Default()
@@ -134,3 +176,136 @@ func NewFuturesWsOptions(opt ...Options) *Options {
 	opt[0].initFutureStream()
 	return &opt[0]
 }
+
+func (o *Options) initDelivery() {
+	if o.Logger == nil {
+		o.Logger = slog.Default()
+	}
+	o.defaultEndpoint("delivery", func(e Endpoints) string { return e.DeliveryRest })
+}
+
+func (o *Options) initDeliveryStream() {
+	if o.Logger == nil {
+		o.Logger = slog.Default()
+	}
+	o.defaultEndpoint("delivery stream", func(e Endpoints) string { return e.DeliveryStream })
+}
+
+func NewDeliveryOptions(opt ...Options) *Options {
+	if len(opt) == 0 {
+		opt = append(opt, Options{})
+	}
+	opt[0].initDelivery()
+	return &opt[0]
+}
+
+func NewDeliveryWsOptions(opt ...Options) *Options {
+	if len(opt) == 0 {
+		opt = append(opt, Options{})
+	}
+	opt[0].initDeliveryStream()
+	return &opt[0]
+}
+
+func (o *Options) initOptions() {
+	if o.Logger == nil {
+		o.Logger = slog.Default()
+	}
+	o.defaultEndpoint("options", func(e Endpoints) string { return e.OptionsRest })
+}
+
+func (o *Options) initOptionsStream() {
+	if o.Logger == nil {
+		o.Logger = slog.Default()
+	}
+	o.defaultEndpoint("options stream", func(e Endpoints) string { return e.OptionsStream })
+}
+
+func NewOptionsOptions(opt ...Options) *Options {
+	if len(opt) == 0 {
+		opt = append(opt, Options{})
+	}
+	opt[0].initOptions()
+	return &opt[0]
+}
+
+func NewOptionsWsOptions(opt ...Options) *Options {
+	if len(opt) == 0 {
+		opt = append(opt, Options{})
+	}
+	opt[0].initOptionsStream()
+	return &opt[0]
+}
+
+func (o *Options) initPortfolio() {
+	if o.Logger == nil {
+		o.Logger = slog.Default()
+	}
+	o.defaultEndpoint("portfolio margin", func(e Endpoints) string { return e.PortfolioRest })
+}
+
+func (o *Options) initPortfolioStream() {
+	if o.Logger == nil {
+		o.Logger = slog.Default()
+	}
+	o.defaultEndpoint("portfolio margin stream", func(e Endpoints) string { return e.PortfolioStream })
+}
+
+func NewPortfolioOptions(opt ...Options) *Options {
+	if len(opt) == 0 {
+		opt = append(opt, Options{})
+	}
+	opt[0].initPortfolio()
+	return &opt[0]
+}
+
+func NewPortfolioWsOptions(opt ...Options) *Options {
+	if len(opt) == 0 {
+		opt = append(opt, Options{})
+	}
+	opt[0].initPortfolioStream()
+	return &opt[0]
+}
+
+func (o *Options) initFix(name string, pick func(Endpoints) string) {
+	if o.Logger == nil {
+		o.Logger = slog.Default()
+	}
+	o.defaultEndpoint(name, pick)
+}
+
+// defaultEndpoint Set Endpoint to the url of the environment unless it is set already.
+// An environment without that api leaves Endpoint empty and logs an error, it never falls back to production.
+func (o *Options) defaultEndpoint(api string, pick func(Endpoints) string) {
+	if o.Endpoint != "" {
+		return
+	}
+	o.Endpoint = pick(o.Environment.Endpoints())
+	if o.Endpoint == "" {
+		o.Logger.Error("no default endpoint, set Options.Endpoint", "api", api, "environment", o.Environment.String())
+	}
+}
+
+func NewFixOptions(opt ...Options) *Options {
+	if len(opt) == 0 {
+		opt = append(opt, Options{})
+	}
+	opt[0].initFix("fix order entry", func(e Endpoints) string { return e.FixOrderEntry })
+	return &opt[0]
+}
+
+func NewFixDropCopyOptions(opt ...Options) *Options {
+	if len(opt) == 0 {
+		opt = append(opt, Options{})
+	}
+	opt[0].initFix("fix drop copy", func(e Endpoints) string { return e.FixDropCopy })
+	return &opt[0]
+}
+
+func NewFixMarketDataOptions(opt ...Options) *Options {
+	if len(opt) == 0 {
+		opt = append(opt, Options{})
+	}
+	opt[0].initFix("fix market data", func(e Endpoints) string { return e.FixMarketData })
+	return &opt[0]
+}
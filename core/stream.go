@@ -0,0 +1,397 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultStreamBuffer The number of decoded messages a stream holds for its consumer.
+const DefaultStreamBuffer = 8
+
+// ErrStreamStarted Start was called on a stream that is already running.
+var ErrStreamStarted = errors.New("stream already started")
+
+// OverflowPolicy What a stream does with a decoded message when the buffer of its consumer is full.
+// Every policy but OverflowBlock keeps reading the connection, so a slow consumer cannot stall the socket
+// and get it disconnected by the server.
+type OverflowPolicy int
+
+const (
+	// OverflowBlock Wait for the consumer, the connection is not read meanwhile.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropNewest Drop the message that does not fit and keep reading.
+	OverflowDropNewest
+	// OverflowDropOldest Drop the oldest buffered message to make room for the new one.
+	OverflowDropOldest
+	// OverflowConflate Keep only the latest message per key while the consumer lags, see Stream.Conflate.
+	OverflowConflate
+)
+
+// Stream A typed websocket stream: one connection, one decoder and one consumer.
+// Messages are consumed either from Messages and Errors, or by the callbacks set with OnMessage and OnError.
+// Configure the stream before it starts, the setters are not safe to call on a running stream.
+type Stream[T any] struct {
+	c         *WsClient
+	endpoint  string
+	decode    func([]byte) (T, error)
+	buffer    int
+	overflow  OverflowPolicy
+	key       func(T) string
+	onMessage func(T)
+	onError   func(error)
+	dropped   atomic.Uint64
+	conflated atomic.Uint64
+
+	mu       sync.Mutex
+	started  bool
+	messages chan T
+	errors   chan error
+	cancel   context.CancelFunc
+	done     chan struct{}
+	err      error
+}
+
+// NewStream A stream of the messages on endpoint, decoded by decode or as JSON into T when decode is nil.
+func NewStream[T any](c *WsClient, endpoint string, decode func([]byte) (T, error)) *Stream[T] {
+	if decode == nil {
+		decode = decodeJson[T]
+	}
+	return &Stream[T]{c: c, endpoint: endpoint, decode: decode, buffer: DefaultStreamBuffer}
+}
+
+// NewRawStream A stream of the raw stream connection of d.
+func NewRawStream[T any](c *WsClient, d StreamDescriptor[T]) *Stream[T] {
+	return NewStream(c, RawStreamUrl(c.Opt.Endpoint, d.Name()), d.Decode)
+}
+
+// NewCombinedStream A stream of the combined connection of ds, every {"stream":..,"data":..} envelope is decoded as JSON into T.
+func NewCombinedStream[T any](c *WsClient, ds ...Descriptor) *Stream[T] {
+	return NewStream[T](c, CombinedStreamUrl(c.Opt.Endpoint, StreamNames(ds...)...), nil)
+}
+
+func decodeJson[T any](message []byte) (T, error) {
+	var event T
+	err := json.Unmarshal(message, &event)
+	return event, err
+}
+
+// Buffer The capacity of the Messages channel, DefaultStreamBuffer by default.
+func (s *Stream[T]) Buffer(size int) *Stream[T] {
+	s.buffer = size
+	return s
+}
+
+// Overflow What to do when the consumer falls behind, OverflowBlock by default.
+func (s *Stream[T]) Overflow(policy OverflowPolicy) *Stream[T] {
+	s.overflow = policy
+	return s
+}
+
+// Conflate Sets OverflowConflate: once the buffer is full, messages wait in a set holding the latest message of each key,
+// e.g. the symbol of a book ticker or mark price, and a newer message of the same key replaces the waiting one.
+func (s *Stream[T]) Conflate(key func(T) string) *Stream[T] {
+	s.overflow = OverflowConflate
+	s.key = key
+	return s
+}
+
+// Dropped The number of messages dropped by OverflowDropNewest or OverflowDropOldest.
+func (s *Stream[T]) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Conflated The number of messages replaced by a newer message of the same key under OverflowConflate.
+func (s *Stream[T]) Conflated() uint64 {
+	return s.conflated.Load()
+}
+
+// Decoder Replaces the decoder of the stream.
+func (s *Stream[T]) Decoder(decode func([]byte) (T, error)) *Stream[T] {
+	s.decode = decode
+	return s
+}
+
+// OnMessage Calls handler with every decoded message instead of sending it on Messages.
+// handler runs on its own goroutine fed through the buffer, the overflow policy applies to it as to a channel consumer.
+// Buffered messages are discarded once the stream ends.
+func (s *Stream[T]) OnMessage(handler func(T)) *Stream[T] {
+	s.onMessage = handler
+	return s
+}
+
+// OnError Calls handler with every error instead of sending it on Errors.
+// A stream consumed by OnMessage alone drops its errors instead, the connection error is still kept in Err.
+func (s *Stream[T]) OnError(handler func(error)) *Stream[T] {
+	s.onError = handler
+	return s
+}
+
+// Start Connects and reads the stream in the background until ctx is done, Close is called or the connection fails.
+func (s *Stream[T]) Start(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.started {
+		return ErrStreamStarted
+	}
+	s.started = true
+	s.messages = make(chan T, s.buffer)
+	s.errors = make(chan error)
+	s.done = make(chan struct{})
+	runCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	go s.run(ctx, runCtx)
+	return nil
+}
+
+// Do Starts the stream and returns its channels. Both are closed once the stream ends.
+func (s *Stream[T]) Do(ctx context.Context) (<-chan T, <-chan error) {
+	_ = s.Start(ctx)
+	return s.Messages(), s.Errors()
+}
+
+// Messages The decoded messages, nil before Start.
+func (s *Stream[T]) Messages() <-chan T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.messages
+}
+
+// Errors The decode errors, which leave the stream running, and the connection error that ends it. nil before Start.
+func (s *Stream[T]) Errors() <-chan error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.errors
+}
+
+// Close Stops the stream: the connection is closed at once with a close frame. Err stays nil for a stream ended by Close.
+// Close does not wait, use Wait or Done for that.
+func (s *Stream[T]) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cancel != nil {
+		s.cancel()
+	}
+	return nil
+}
+
+// Done Closed once the stream has ended, its connection is closed and its channels are closed.
+func (s *Stream[T]) Done() <-chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.done
+}
+
+// Wait Blocks until the stream has ended, its connection is closed and all of its goroutines have exited, then returns Err.
+// Wait returns nil at once for a stream that was never started.
+func (s *Stream[T]) Wait() error {
+	done := s.Done()
+	if done == nil {
+		return nil
+	}
+	<-done
+	return s.Err()
+}
+
+// Err The reason the stream ended: the connection error, the error of the context passed to Start,
+// or nil when it was closed or is still running.
+func (s *Stream[T]) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+func (s *Stream[T]) run(parent, ctx context.Context) {
+	var err error
+	var consumers sync.WaitGroup
+	var conflator *conflator[T]
+	if s.overflow == OverflowConflate {
+		conflator = newConflator[T](&s.conflated)
+		consumers.Add(1)
+		go func() {
+			defer consumers.Done()
+			conflator.forward(ctx, s.messages)
+		}()
+	}
+	var handlers sync.WaitGroup
+	if s.onMessage != nil {
+		handlers.Add(1)
+		go func() {
+			defer handlers.Done()
+			for event := range s.messages {
+				if ctx.Err() == nil {
+					s.onMessage(event)
+				}
+			}
+		}()
+	}
+	source, sourceErrors := s.c.serve(ctx, s.endpoint)
+	defer func() {
+		if err == nil {
+			err = parent.Err()
+		}
+		s.mu.Lock()
+		s.err = err
+		s.mu.Unlock()
+		s.cancel()
+		for range source {
+		}
+		for range sourceErrors {
+		}
+		consumers.Wait()
+		close(s.messages)
+		close(s.errors)
+		handlers.Wait()
+		close(s.done)
+	}()
+	onMessage, onError := source, sourceErrors
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case message, ok := <-onMessage:
+			if !ok {
+				onMessage = nil
+				continue
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			received := time.Now()
+			event, decodeErr := s.decode(message)
+			if decodeErr != nil {
+				s.fail(ctx, decodeErr)
+				continue
+			}
+			if conflator != nil {
+				conflator.put(s.keyOf(event), event)
+			} else {
+				s.deliver(ctx, event)
+			}
+			if metrics := s.c.Opt.Metrics; metrics != nil {
+				metrics.StreamMessage(streamName(s.endpoint), eventLag(message, received), len(s.messages))
+			}
+		case connErr, ok := <-onError:
+			if ok && connErr != nil && ctx.Err() == nil {
+				err = connErr
+				s.fail(ctx, connErr)
+			}
+			return
+		}
+	}
+}
+
+func (s *Stream[T]) keyOf(event T) string {
+	if s.key == nil {
+		return ""
+	}
+	return s.key(event)
+}
+
+func (s *Stream[T]) deliver(ctx context.Context, event T) {
+	switch s.overflow {
+	case OverflowDropNewest:
+		select {
+		case s.messages <- event:
+		default:
+			s.dropped.Add(1)
+		}
+	case OverflowDropOldest:
+		for {
+			select {
+			case s.messages <- event:
+				return
+			default:
+			}
+			select {
+			case <-s.messages:
+				s.dropped.Add(1)
+			default:
+			}
+		}
+	default:
+		select {
+		case s.messages <- event:
+		case <-ctx.Done():
+		}
+	}
+}
+
+func (s *Stream[T]) fail(ctx context.Context, err error) {
+	if s.onError != nil {
+		s.onError(err)
+		return
+	}
+	if s.onMessage != nil {
+		return
+	}
+	select {
+	case s.errors <- err:
+	case <-ctx.Done():
+	}
+}
+
+// conflator Holds the latest message of each key until the consumer has room, in order of first arrival.
+type conflator[T any] struct {
+	mu        sync.Mutex
+	keys      []string
+	pending   map[string]T
+	notify    chan struct{}
+	conflated *atomic.Uint64
+}
+
+func newConflator[T any](conflated *atomic.Uint64) *conflator[T] {
+	return &conflator[T]{pending: make(map[string]T), notify: make(chan struct{}, 1), conflated: conflated}
+}
+
+func (c *conflator[T]) put(key string, event T) {
+	c.mu.Lock()
+	if _, ok := c.pending[key]; ok {
+		c.conflated.Add(1)
+	} else {
+		c.keys = append(c.keys, key)
+	}
+	c.pending[key] = event
+	c.mu.Unlock()
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (c *conflator[T]) pop() (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var event T
+	if len(c.keys) == 0 {
+		return event, false
+	}
+	key := c.keys[0]
+	c.keys = c.keys[1:]
+	event = c.pending[key]
+	delete(c.pending, key)
+	return event, true
+}
+
+// forward Sends the waiting messages to out until ctx is done.
+func (c *conflator[T]) forward(ctx context.Context, out chan<- T) {
+	for {
+		event, ok := c.pop()
+		if !ok {
+			select {
+			case <-c.notify:
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+		select {
+		case out <- event:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
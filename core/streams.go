@@ -0,0 +1,131 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Descriptor A stream as it is named on the wire, e.g. btcusdt@aggTrade, and the decoder of its payload.
+// Descriptors of any payload type can be mixed on one connection with a Dispatcher.
+type Descriptor interface {
+	Name() string
+	DecodeAny(message []byte) (any, error)
+}
+
+// StreamDescriptor An immutable description of a websocket stream: its name and the payload type T its messages decode into.
+// Descriptors carry no connection state, the same value can be subscribed any number of times, alone or combined with others.
+type StreamDescriptor[T any] struct {
+	name   string
+	decode func([]byte) (T, error)
+}
+
+// CombinedEvent A message received on a combined stream connection, tagged with the stream it belongs to.
+type CombinedEvent[T any] struct {
+	Stream string `json:"stream"`
+	Data   T      `json:"data"`
+}
+
+// NewStreamDescriptor Describes the stream name whose messages are JSON objects of type T.
+func NewStreamDescriptor[T any](name string) StreamDescriptor[T] {
+	return StreamDescriptor[T]{name: name}
+}
+
+// NewStreamDescriptorFunc Describes the stream name whose messages are decoded by decode.
+func NewStreamDescriptorFunc[T any](name string, decode func([]byte) (T, error)) StreamDescriptor[T] {
+	return StreamDescriptor[T]{name: name, decode: decode}
+}
+
+func (d StreamDescriptor[T]) Name() string {
+	return d.name
+}
+
+func (d StreamDescriptor[T]) String() string {
+	return d.name
+}
+
+// Decode Decodes one raw message of the stream.
+func (d StreamDescriptor[T]) Decode(message []byte) (T, error) {
+	if d.decode != nil {
+		return d.decode(message)
+	}
+	return decodeJson[T](message)
+}
+
+// DecodeAny Decodes one raw message of the stream into a T held in an any.
+func (d StreamDescriptor[T]) DecodeAny(message []byte) (any, error) {
+	return d.Decode(message)
+}
+
+// RawStreamUrl The url of the raw stream name on the websocket base endpoint, messages are sent as is.
+func RawStreamUrl(endpoint, name string) string {
+	return endpoint + "/ws/" + name
+}
+
+// CombinedStreamUrl The url of the combined stream of names on the websocket base endpoint,
+// messages are wrapped as {"stream":"<name>","data":<payload>}.
+func CombinedStreamUrl(endpoint string, names ...string) string {
+	return endpoint + "/stream?streams=" + strings.Join(names, "/")
+}
+
+// UpdateSpeed The "@<interval>" suffix of a stream name, e.g. @100ms or @1s.
+// Empty when no interval is given or it is the stream's default, which the server expects to be left out.
+func UpdateSpeed(defaultInterval time.Duration, interval ...time.Duration) string {
+	if len(interval) == 0 || interval[0] <= 0 || interval[0] == defaultInterval {
+		return ""
+	}
+	if interval[0]%time.Second == 0 {
+		return fmt.Sprintf("@%ds", interval[0]/time.Second)
+	}
+	return fmt.Sprintf("@%dms", interval[0]/time.Millisecond)
+}
+
+// ParseUpdateSpeed Parses the update speed given as a string, e.g. 100ms, as used by the Subscribe* methods.
+func ParseUpdateSpeed(interval ...string) []time.Duration {
+	var speeds []time.Duration
+	for _, v := range interval {
+		if d, err := time.ParseDuration(v); err == nil {
+			speeds = append(speeds, d)
+		}
+	}
+	return speeds
+}
+
+// StreamNames The names of descriptors, in order.
+func StreamNames(descriptors ...Descriptor) []string {
+	names := make([]string, 0, len(descriptors))
+	for _, descriptor := range descriptors {
+		names = append(names, descriptor.Name())
+	}
+	return names
+}
+
+// Subscribe Opens a new raw stream connection for d on c and decodes every message into T.
+// Each call owns its connection, c is not modified.
+func Subscribe[T any](ctx context.Context, c *WsClient, d StreamDescriptor[T]) (<-chan T, <-chan error) {
+	return NewRawStream(c, d).Do(ctx)
+}
+
+// SubscribeCombined Opens a new combined stream connection for all of ds on c.
+// Every message is decoded with the descriptor of the stream it arrived on.
+func SubscribeCombined[T any](ctx context.Context, c *WsClient, ds ...StreamDescriptor[T]) (<-chan *CombinedEvent[T], <-chan error) {
+	byName := make(map[string]StreamDescriptor[T], len(ds))
+	names := make([]string, 0, len(ds))
+	for _, d := range ds {
+		byName[d.Name()] = d
+		names = append(names, d.Name())
+	}
+	decode := func(message []byte) (*CombinedEvent[T], error) {
+		var raw CombinedEvent[json.RawMessage]
+		if err := json.Unmarshal(message, &raw); err != nil {
+			return nil, err
+		}
+		event := &CombinedEvent[T]{Stream: raw.Stream}
+		var err error
+		event.Data, err = byName[raw.Stream].Decode(raw.Data)
+		return event, err
+	}
+	return NewStream(c, CombinedStreamUrl(c.Opt.Endpoint, names...), decode).Do(ctx)
+}
@@ -0,0 +1,233 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/futures"
+	"github.com/shopspring/decimal"
+)
+
+// QueryBalance Query account balance info
+type QueryBalance struct {
+	c *Client
+	r *core.Request
+}
+
+type QueryBalanceResponse struct {
+	AccountAlias       string          `json:"accountAlias"`
+	Asset              string          `json:"asset"`
+	Balance            decimal.Decimal `json:"balance"`
+	WithdrawAvailable  decimal.Decimal `json:"withdrawAvailable"`
+	CrossWalletBalance decimal.Decimal `json:"crossWalletBalance"`
+	CrossUnPnl         decimal.Decimal `json:"crossUnPnl"`
+	AvailableBalance   decimal.Decimal `json:"availableBalance"`
+	UpdateTime         int64           `json:"updateTime"`
+}
+
+func (s *QueryBalance) RecvWindow(recvWindow int64) *QueryBalance {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *QueryBalance) Do(ctx context.Context) ([]*QueryBalanceResponse, error) {
+	resp := make([]*QueryBalanceResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// AccountInfo Get current account information. Every margin asset is a separate wallet.
+type AccountInfo struct {
+	c *Client
+	r *core.Request
+}
+
+type AccountAsset struct {
+	Asset                  string          `json:"asset"`
+	WalletBalance          decimal.Decimal `json:"walletBalance"`
+	UnrealizedProfit       decimal.Decimal `json:"unrealizedProfit"`
+	MarginBalance          decimal.Decimal `json:"marginBalance"`
+	MaintMargin            decimal.Decimal `json:"maintMargin"`
+	InitialMargin          decimal.Decimal `json:"initialMargin"`
+	PositionInitialMargin  decimal.Decimal `json:"positionInitialMargin"`
+	OpenOrderInitialMargin decimal.Decimal `json:"openOrderInitialMargin"`
+	MaxWithdrawAmount      decimal.Decimal `json:"maxWithdrawAmount"`
+	CrossWalletBalance     decimal.Decimal `json:"crossWalletBalance"`
+	CrossUnPnl             decimal.Decimal `json:"crossUnPnl"`
+	AvailableBalance       decimal.Decimal `json:"availableBalance"`
+	UpdateTime             int64           `json:"updateTime"`
+}
+
+type AccountPosition struct {
+	Symbol                 string          `json:"symbol"`
+	PositionAmt            decimal.Decimal `json:"positionAmt"` // contracts
+	InitialMargin          decimal.Decimal `json:"initialMargin"`
+	MaintMargin            decimal.Decimal `json:"maintMargin"`
+	UnrealizedProfit       decimal.Decimal `json:"unrealizedProfit"`
+	PositionInitialMargin  decimal.Decimal `json:"positionInitialMargin"`
+	OpenOrderInitialMargin decimal.Decimal `json:"openOrderInitialMargin"`
+	Leverage               decimal.Decimal `json:"leverage"`
+	Isolated               bool            `json:"isolated"`
+	PositionSide           string          `json:"positionSide"`
+	EntryPrice             decimal.Decimal `json:"entryPrice"`
+	BreakEvenPrice         decimal.Decimal `json:"breakEvenPrice"`
+	MaxQty                 decimal.Decimal `json:"maxQty"`
+	UpdateTime             int64           `json:"updateTime"`
+}
+
+type AccountInfoResponse struct {
+	Assets      []*AccountAsset    `json:"assets"`
+	Positions   []*AccountPosition `json:"positions"`
+	CanDeposit  bool               `json:"canDeposit"`
+	CanTrade    bool               `json:"canTrade"`
+	CanWithdraw bool               `json:"canWithdraw"`
+	FeeTier     int                `json:"feeTier"`
+	UpdateTime  int64              `json:"updateTime"`
+}
+
+func (s *AccountInfo) RecvWindow(recvWindow int64) *AccountInfo {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *AccountInfo) Do(ctx context.Context) (*AccountInfoResponse, error) {
+	resp := new(AccountInfoResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// CommissionRate Query user commission rate
+type CommissionRate struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *CommissionRate) Symbol(symbol string) *CommissionRate {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *CommissionRate) RecvWindow(recvWindow int64) *CommissionRate {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CommissionRate) Do(ctx context.Context) (*futures.CommissionRateResponse, error) {
+	resp := new(futures.CommissionRateResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// LeverageBracket Get the symbol's notional bracket list. COIN-M brackets are bounded in contract quantity.
+type LeverageBracket struct {
+	c *Client
+	r *core.Request
+}
+
+type Bracket struct {
+	Bracket          int             `json:"bracket"`
+	InitialLeverage  int             `json:"initialLeverage"`
+	QtyCap           decimal.Decimal `json:"qtyCap"`
+	QtyFloor         decimal.Decimal `json:"qtyFloor"`
+	MaintMarginRatio decimal.Decimal `json:"maintMarginRatio"`
+	Cum              decimal.Decimal `json:"cum"`
+}
+
+type LeverageBracketResponse struct {
+	Symbol       string     `json:"symbol"`
+	NotionalCoef float64    `json:"notionalCoef"`
+	Brackets     []*Bracket `json:"brackets"`
+}
+
+func (s *LeverageBracket) Symbol(symbol string) *LeverageBracket {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *LeverageBracket) RecvWindow(recvWindow int64) *LeverageBracket {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *LeverageBracket) Do(ctx context.Context) ([]*LeverageBracketResponse, error) {
+	resp := make([]*LeverageBracketResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// GetPositionSide Get user's position mode (Hedge Mode or One-way Mode) on EVERY symbol
+type GetPositionSide struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *GetPositionSide) RecvWindow(recvWindow int64) *GetPositionSide {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *GetPositionSide) Do(ctx context.Context) (*futures.GetPositionSideResponse, error) {
+	resp := new(futures.GetPositionSideResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// QueryIncome Query income history
+type QueryIncome struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *QueryIncome) Symbol(symbol string) *QueryIncome {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *QueryIncome) IncomeType(incomeType core.IncomeType) *QueryIncome {
+	s.r.Set("incomeType", incomeType)
+	return s
+}
+
+func (s *QueryIncome) StartTime(startTime int64) *QueryIncome {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *QueryIncome) EndTime(endTime int64) *QueryIncome {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+func (s *QueryIncome) Page(page int) *QueryIncome {
+	s.r.Set("page", page)
+	return s
+}
+
+// Limit Default 100; max 1000
+func (s *QueryIncome) Limit(limit int) *QueryIncome {
+	s.r.Set("limit", limit)
+	return s
+}
+
+func (s *QueryIncome) RecvWindow(recvWindow int64) *QueryIncome {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *QueryIncome) Do(ctx context.Context) ([]*futures.QueryIncomeResponse, error) {
+	resp := make([]*futures.QueryIncomeResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
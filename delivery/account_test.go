@@ -0,0 +1,81 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type accountTestSuite struct {
+	baseHttpTestSuite
+}
+
+func TestAccount(t *testing.T) {
+	suite.Run(t, new(accountTestSuite))
+}
+
+func (s *accountTestSuite) TestLeverageBracket() {
+	msg := []byte(`[
+	  {
+		"symbol": "BTCUSD_PERP",
+		"notionalCoef": 1.50,
+		"brackets": [
+		  {
+			"bracket": 1,
+			"initialLeverage": 125,
+			"qtyCap": 50,
+			"qtyFloor": 0,
+			"maintMarginRatio": 0.004,
+			"cum": 0.0
+		  }
+		]
+	  }
+	]`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewLeverageBracket().Symbol("BTCUSD_PERP").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp []*LeverageBracketResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Len(resp, 1)
+	r.Equal(testResp[0].Symbol, resp[0].Symbol, "Symbol")
+	r.Equal(testResp[0].NotionalCoef, resp[0].NotionalCoef, "NotionalCoef")
+	r.Len(resp[0].Brackets, 1)
+	r.Equal(*testResp[0].Brackets[0], *resp[0].Brackets[0])
+}
+
+func (s *accountTestSuite) TestQueryBalance() {
+	msg := []byte(`[
+	  {
+		"accountAlias": "SgsR",
+		"asset": "BTC",
+		"balance": "0.00250000",
+		"withdrawAvailable": "0.00250000",
+		"crossWalletBalance": "0.00241969",
+		"crossUnPnl": "0.00000000",
+		"availableBalance": "0.00241969",
+		"updateTime": 1592468353979
+	  }
+	]`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewQueryBalance().Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp []*QueryBalanceResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Len(resp, 1)
+	r.Equal(*testResp[0], *resp[0])
+}
+
+func (s *accountTestSuite) TestGetListenKey() {
+	msg := []byte(`{"listenKey": "pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1"}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewGetListenKey().Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Equal("pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1", resp.ListenKey)
+}
@@ -0,0 +1,229 @@
+package delivery
+
+import (
+	"context"
+	"github.com/jekaxv/go-binance/core"
+	"net/http"
+)
+
+type Client struct {
+	*core.Client
+}
+
+func (c *Client) invoke(r *core.Request, ctx context.Context) error {
+	return c.Invoke(r, ctx)
+}
+
+func (c *Client) rawBody() []byte {
+	return c.RawBody()
+}
+
+// NewPing Test connectivity
+func (c *Client) NewPing() *Ping {
+	return &Ping{c: c, r: c.SetReq("/dapi/v1/ping", http.MethodGet)}
+}
+
+// NewServerTime Check server time
+func (c *Client) NewServerTime() *ServerTime {
+	return &ServerTime{c: c, r: c.SetReq("/dapi/v1/time", http.MethodGet)}
+}
+
+// NewExchangeInfo Exchange information
+func (c *Client) NewExchangeInfo() *ExchangeInfo {
+	return &ExchangeInfo{c: c, r: c.SetReq("/dapi/v1/exchangeInfo", http.MethodGet)}
+}
+
+// NewDepth order book
+func (c *Client) NewDepth() *Depth {
+	return &Depth{c: c, r: c.SetReq("/dapi/v1/depth", http.MethodGet)}
+}
+
+// NewTrades Recent trades list
+func (c *Client) NewTrades() *Trades {
+	return &Trades{c: c, r: c.SetReq("/dapi/v1/trades", http.MethodGet)}
+}
+
+// NewHistoricalTrades Old trade lookup
+func (c *Client) NewHistoricalTrades() *HistoricalTrades {
+	return &HistoricalTrades{c: c, r: c.SetReq("/dapi/v1/historicalTrades", http.MethodGet, core.AuthApiKey)}
+}
+
+// NewAggTrades Compressed/Aggregate trades list
+func (c *Client) NewAggTrades() *AggTrades {
+	return &AggTrades{c: c, r: c.SetReq("/dapi/v1/aggTrades", http.MethodGet)}
+}
+
+// NewMarkPrice Index Price and Mark Price
+func (c *Client) NewMarkPrice() *MarkPrice {
+	return &MarkPrice{c: c, r: c.SetReq("/dapi/v1/premiumIndex", http.MethodGet)}
+}
+
+// NewFundingRate Get Funding Rate History of Perpetual Futures
+func (c *Client) NewFundingRate() *FundingRate {
+	return &FundingRate{c: c, r: c.SetReq("/dapi/v1/fundingRate", http.MethodGet)}
+}
+
+// NewKline Kline/Candlestick data
+func (c *Client) NewKline() *KlineData {
+	return &KlineData{c: c, r: c.SetReq("/dapi/v1/klines", http.MethodGet)}
+}
+
+// NewContractKline Continuous Contract Kline/Candlestick Data
+func (c *Client) NewContractKline() *ContractKline {
+	return &ContractKline{c: c, r: c.SetReq("/dapi/v1/continuousKlines", http.MethodGet)}
+}
+
+// NewIndexKline Index Price Kline/Candlestick Data
+func (c *Client) NewIndexKline() *IndexKline {
+	return &IndexKline{c: c, r: c.SetReq("/dapi/v1/indexPriceKlines", http.MethodGet)}
+}
+
+// NewMarkKline Mark Price Kline/Candlestick Data
+func (c *Client) NewMarkKline() *MarkKline {
+	return &MarkKline{c: c, r: c.SetReq("/dapi/v1/markPriceKlines", http.MethodGet)}
+}
+
+// NewTicker24hr 24hr Ticker Price Change Statistics
+func (c *Client) NewTicker24hr() *Ticker24hr {
+	return &Ticker24hr{c: c, r: c.SetReq("/dapi/v1/ticker/24hr", http.MethodGet)}
+}
+
+// NewTickerPrice Symbol Price Ticker
+func (c *Client) NewTickerPrice() *TickerPrice {
+	return &TickerPrice{c: c, r: c.SetReq("/dapi/v1/ticker/price", http.MethodGet)}
+}
+
+// NewBookTicker Symbol Order Book Ticker
+func (c *Client) NewBookTicker() *BookTicker {
+	return &BookTicker{c: c, r: c.SetReq("/dapi/v1/ticker/bookTicker", http.MethodGet)}
+}
+
+// NewOpenInterest Open Interest
+func (c *Client) NewOpenInterest() *OpenInterest {
+	return &OpenInterest{c: c, r: c.SetReq("/dapi/v1/openInterest", http.MethodGet)}
+}
+
+// NewCreateOrder New Order (TRADE)
+func (c *Client) NewCreateOrder() *CreateOrder {
+	return &CreateOrder{c: c, r: c.SetReq("/dapi/v1/order", http.MethodPost, core.AuthSigned)}
+}
+
+// NewPlaceBatchOrder Place Multiple Orders (TRADE)
+func (c *Client) NewPlaceBatchOrder() *PlaceBatchOrder {
+	return &PlaceBatchOrder{c: c, r: c.SetReq("/dapi/v1/batchOrders", http.MethodPost, core.AuthSigned)}
+}
+
+// NewModifyOrder Modify Order (TRADE)
+func (c *Client) NewModifyOrder() *ModifyOrder {
+	return &ModifyOrder{c: c, r: c.SetReq("/dapi/v1/order", http.MethodPut, core.AuthSigned)}
+}
+
+// NewCancelOrder Cancel Order (TRADE)
+func (c *Client) NewCancelOrder() *CancelOrder {
+	return &CancelOrder{c: c, r: c.SetReq("/dapi/v1/order", http.MethodDelete, core.AuthSigned)}
+}
+
+// NewCancelMultipleOrder Cancel Multiple Orders (TRADE)
+func (c *Client) NewCancelMultipleOrder() *CancelMultipleOrder {
+	return &CancelMultipleOrder{c: c, r: c.SetReq("/dapi/v1/batchOrders", http.MethodDelete, core.AuthSigned)}
+}
+
+// NewCancelOpenOrder Cancel All Open Orders (TRADE)
+func (c *Client) NewCancelOpenOrder() *CancelOpenOrder {
+	return &CancelOpenOrder{c: c, r: c.SetReq("/dapi/v1/allOpenOrders", http.MethodDelete, core.AuthSigned)}
+}
+
+// NewCountdownCancelAll Auto-Cancel All Open Orders (TRADE)
+func (c *Client) NewCountdownCancelAll() *CountdownCancelAll {
+	return &CountdownCancelAll{c: c, r: c.SetReq("/dapi/v1/countdownCancelAll", http.MethodPost, core.AuthSigned)}
+}
+
+// NewQueryOrder Query Order (USER_DATA)
+func (c *Client) NewQueryOrder() *QueryOrder {
+	return &QueryOrder{c: c, r: c.SetReq("/dapi/v1/order", http.MethodGet, core.AuthSigned)}
+}
+
+// NewQueryAllOrder All Orders (USER_DATA)
+func (c *Client) NewQueryAllOrder() *QueryAllOrder {
+	return &QueryAllOrder{c: c, r: c.SetReq("/dapi/v1/allOrders", http.MethodGet, core.AuthSigned)}
+}
+
+// NewAllOpenOrder Current All Open Orders (USER_DATA)
+func (c *Client) NewAllOpenOrder() *AllOpenOrder {
+	return &AllOpenOrder{c: c, r: c.SetReq("/dapi/v1/openOrders", http.MethodGet, core.AuthSigned)}
+}
+
+// NewUserTrades Account Trade List (USER_DATA)
+func (c *Client) NewUserTrades() *UserTrades {
+	return &UserTrades{c: c, r: c.SetReq("/dapi/v1/userTrades", http.MethodGet, core.AuthSigned)}
+}
+
+// NewPositionRisk Position Information (USER_DATA)
+func (c *Client) NewPositionRisk() *PositionRisk {
+	return &PositionRisk{c: c, r: c.SetReq("/dapi/v1/positionRisk", http.MethodGet, core.AuthSigned)}
+}
+
+// NewChangeMarginType Change Margin Type (TRADE)
+func (c *Client) NewChangeMarginType() *ChangeMarginType {
+	return &ChangeMarginType{c: c, r: c.SetReq("/dapi/v1/marginType", http.MethodPost, core.AuthSigned)}
+}
+
+// NewChangePositionSide Change Position Mode (TRADE)
+func (c *Client) NewChangePositionSide() *ChangePositionSide {
+	return &ChangePositionSide{c: c, r: c.SetReq("/dapi/v1/positionSide/dual", http.MethodPost, core.AuthSigned)}
+}
+
+// NewChangeLeverage Change Initial Leverage (TRADE)
+func (c *Client) NewChangeLeverage() *ChangeLeverage {
+	return &ChangeLeverage{c: c, r: c.SetReq("/dapi/v1/leverage", http.MethodPost, core.AuthSigned)}
+}
+
+// NewChangePositionMargin Modify Isolated Position Margin (TRADE)
+func (c *Client) NewChangePositionMargin() *ChangePositionMargin {
+	return &ChangePositionMargin{c: c, r: c.SetReq("/dapi/v1/positionMargin", http.MethodPost, core.AuthSigned)}
+}
+
+// NewQueryBalance Futures Account Balance (USER_DATA)
+func (c *Client) NewQueryBalance() *QueryBalance {
+	return &QueryBalance{c: c, r: c.SetReq("/dapi/v1/balance", http.MethodGet, core.AuthSigned)}
+}
+
+// NewAccountInfo Account Information (USER_DATA)
+func (c *Client) NewAccountInfo() *AccountInfo {
+	return &AccountInfo{c: c, r: c.SetReq("/dapi/v1/account", http.MethodGet, core.AuthSigned)}
+}
+
+// NewCommissionRate User Commission Rate (USER_DATA)
+func (c *Client) NewCommissionRate() *CommissionRate {
+	return &CommissionRate{c: c, r: c.SetReq("/dapi/v1/commissionRate", http.MethodGet, core.AuthSigned)}
+}
+
+// NewLeverageBracket Notional Bracket for Symbol (USER_DATA)
+func (c *Client) NewLeverageBracket() *LeverageBracket {
+	return &LeverageBracket{c: c, r: c.SetReq("/dapi/v2/leverageBracket", http.MethodGet, core.AuthSigned)}
+}
+
+// NewGetPositionSide Get Current Position Mode (USER_DATA)
+func (c *Client) NewGetPositionSide() *GetPositionSide {
+	return &GetPositionSide{c: c, r: c.SetReq("/dapi/v1/positionSide/dual", http.MethodGet, core.AuthSigned)}
+}
+
+// NewQueryIncome Get Income History (USER_DATA)
+func (c *Client) NewQueryIncome() *QueryIncome {
+	return &QueryIncome{c: c, r: c.SetReq("/dapi/v1/income", http.MethodGet, core.AuthSigned)}
+}
+
+// NewGetListenKey Start User Data Stream (USER_STREAM)
+func (c *Client) NewGetListenKey() *GetListenKey {
+	return &GetListenKey{c: c, r: c.SetReq("/dapi/v1/listenKey", http.MethodPost, core.AuthApiKey)}
+}
+
+// NewKeepaliveListenKey Keepalive User Data Stream (USER_STREAM)
+func (c *Client) NewKeepaliveListenKey() *KeepaliveListenKey {
+	return &KeepaliveListenKey{c: c, r: c.SetReq("/dapi/v1/listenKey", http.MethodPut, core.AuthApiKey)}
+}
+
+// NewCloseListenKey Close User Data Stream (USER_STREAM)
+func (c *Client) NewCloseListenKey() *CloseListenKey {
+	return &CloseListenKey{c: c, r: c.SetReq("/dapi/v1/listenKey", http.MethodDelete, core.AuthApiKey)}
+}
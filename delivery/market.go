@@ -0,0 +1,663 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/futures"
+	"github.com/shopspring/decimal"
+)
+
+// Ping Test connectivity to the Rest API.
+type Ping struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *Ping) Do(ctx context.Context) error {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+type ServerTime struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *ServerTime) Do(ctx context.Context) (*futures.ServerTimeResponse, error) {
+	resp := new(futures.ServerTimeResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// ExchangeInfo Current exchange trading rules and symbol information
+type ExchangeInfo struct {
+	c *Client
+	r *core.Request
+}
+
+// SymbolInfo Quantities of COIN-M contracts are a number of contracts worth ContractSize quote asset (USD) each.
+type SymbolInfo struct {
+	Symbol                string                  `json:"symbol"`
+	Pair                  string                  `json:"pair"`
+	ContractType          string                  `json:"contractType"`
+	DeliveryDate          int64                   `json:"deliveryDate"`
+	OnboardDate           int64                   `json:"onboardDate"`
+	ContractStatus        string                  `json:"contractStatus"`
+	ContractSize          decimal.Decimal         `json:"contractSize"`
+	MarginAsset           string                  `json:"marginAsset"`
+	MaintMarginPercent    decimal.Decimal         `json:"maintMarginPercent"`
+	RequiredMarginPercent decimal.Decimal         `json:"requiredMarginPercent"`
+	BaseAsset             string                  `json:"baseAsset"`
+	QuoteAsset            string                  `json:"quoteAsset"`
+	PricePrecision        int                     `json:"pricePrecision"`
+	QuantityPrecision     int                     `json:"quantityPrecision"`
+	BaseAssetPrecision    int                     `json:"baseAssetPrecision"`
+	QuotePrecision        int                     `json:"quotePrecision"`
+	EqualQtyPrecision     int                     `json:"equalQtyPrecision"`
+	MaxMoveOrderLimit     int                     `json:"maxMoveOrderLimit"`
+	TriggerProtect        decimal.Decimal         `json:"triggerProtect"`
+	UnderlyingType        string                  `json:"underlyingType"`
+	UnderlyingSubType     []string                `json:"underlyingSubType"`
+	Filters               []*futures.SymbolFilter `json:"filters"`
+	OrderTypes            []string                `json:"orderTypes"`
+	TimeInForce           []string                `json:"timeInForce"`
+	LiquidationFee        decimal.Decimal         `json:"liquidationFee"`
+	MarketTakeBound       decimal.Decimal         `json:"marketTakeBound"`
+}
+
+// BaseQuantity Margin asset value of contracts at price, e.g. BTC for BTCUSD_PERP.
+func (s *SymbolInfo) BaseQuantity(contracts, price decimal.Decimal) decimal.Decimal {
+	if price.IsZero() {
+		return decimal.Zero
+	}
+	return contracts.Mul(s.ContractSize).Div(price)
+}
+
+// Contracts Whole number of contracts worth at most baseQuantity of the margin asset at price.
+func (s *SymbolInfo) Contracts(baseQuantity, price decimal.Decimal) decimal.Decimal {
+	if s.ContractSize.IsZero() {
+		return decimal.Zero
+	}
+	return baseQuantity.Mul(price).Div(s.ContractSize).Floor()
+}
+
+type ExchangeInfoResponse struct {
+	Timezone        string                    `json:"timezone"`
+	ServerTime      int64                     `json:"serverTime"`
+	RateLimits      []*futures.RateLimit      `json:"rateLimits"`
+	ExchangeFilters []*futures.ExchangeFilter `json:"exchangeFilters"`
+	Symbols         []*SymbolInfo             `json:"symbols"`
+}
+
+// Symbol Info of symbol, nil when it is not listed.
+func (e *ExchangeInfoResponse) Symbol(symbol string) *SymbolInfo {
+	for _, info := range e.Symbols {
+		if info.Symbol == symbol {
+			return info
+		}
+	}
+	return nil
+}
+
+func (s *ExchangeInfo) Do(ctx context.Context) (*ExchangeInfoResponse, error) {
+	resp := new(ExchangeInfoResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// Depth Get depth of a market
+type Depth struct {
+	c *Client
+	r *core.Request
+}
+
+type DepthResponse struct {
+	futures.DepthResponse
+	Symbol string `json:"symbol"`
+	Pair   string `json:"pair"`
+}
+
+func (s *Depth) Symbol(symbol string) *Depth {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// Limit Default 500; Valid limits:[5, 10, 20, 50, 100, 500, 1000]
+func (s *Depth) Limit(limit int) *Depth {
+	s.r.Set("limit", limit)
+	return s
+}
+
+func (s *Depth) Do(ctx context.Context) (*DepthResponse, error) {
+	resp := new(DepthResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// Trades Get recent market trades.
+type Trades struct {
+	c *Client
+	r *core.Request
+}
+
+type TradesResponse struct {
+	Id           int64           `json:"id"`
+	Price        decimal.Decimal `json:"price"`
+	Qty          decimal.Decimal `json:"qty"` // contracts
+	BaseQty      decimal.Decimal `json:"baseQty"`
+	Time         int64           `json:"time"`
+	IsBuyerMaker bool            `json:"isBuyerMaker"`
+}
+
+func (s *Trades) Symbol(symbol string) *Trades {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// Limit Default 500; max 1000.
+func (s *Trades) Limit(limit int) *Trades {
+	s.r.Set("limit", limit)
+	return s
+}
+
+func (s *Trades) Do(ctx context.Context) ([]*TradesResponse, error) {
+	resp := make([]*TradesResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// HistoricalTrades Get older market historical trades.
+type HistoricalTrades struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *HistoricalTrades) Symbol(symbol string) *HistoricalTrades {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// Limit Default 500; max 1000.
+func (s *HistoricalTrades) Limit(limit int) *HistoricalTrades {
+	s.r.Set("limit", limit)
+	return s
+}
+
+// FromId Trade id to fetch from. Default gets most recent trades.
+func (s *HistoricalTrades) FromId(fromId int64) *HistoricalTrades {
+	s.r.Set("fromId", fromId)
+	return s
+}
+
+func (s *HistoricalTrades) Do(ctx context.Context) ([]*TradesResponse, error) {
+	resp := make([]*TradesResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// AggTrades Get compressed, aggregate trades.
+type AggTrades struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *AggTrades) Symbol(symbol string) *AggTrades {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// FromId ID to get aggregate trades from INCLUSIVE.
+func (s *AggTrades) FromId(fromId int64) *AggTrades {
+	s.r.Set("fromId", fromId)
+	return s
+}
+
+// StartTime Timestamp in ms to get aggregate trades from INCLUSIVE.
+func (s *AggTrades) StartTime(startTime int64) *AggTrades {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+// EndTime Timestamp in ms to get aggregate trades until INCLUSIVE.
+func (s *AggTrades) EndTime(endTime int64) *AggTrades {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Limit Default 500; max 1000.
+func (s *AggTrades) Limit(limit int) *AggTrades {
+	s.r.Set("limit", limit)
+	return s
+}
+
+func (s *AggTrades) Do(ctx context.Context) ([]*futures.AggTradesResponse, error) {
+	resp := make([]*futures.AggTradesResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// MarkPrice Query index price and mark price of a symbol or of every symbol of a pair.
+type MarkPrice struct {
+	c *Client
+	r *core.Request
+}
+
+type MarkPriceResponse struct {
+	Symbol               string          `json:"symbol"`
+	Pair                 string          `json:"pair"`
+	MarkPrice            decimal.Decimal `json:"markPrice"`
+	IndexPrice           decimal.Decimal `json:"indexPrice"`
+	EstimatedSettlePrice decimal.Decimal `json:"estimatedSettlePrice"`
+	LastFundingRate      string          `json:"lastFundingRate"` // empty for delivery contracts
+	InterestRate         string          `json:"interestRate"`
+	NextFundingTime      int64           `json:"nextFundingTime"`
+	Time                 int64           `json:"time"`
+}
+
+func (s *MarkPrice) Symbol(symbol string) *MarkPrice {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *MarkPrice) Pair(pair string) *MarkPrice {
+	s.r.Set("pair", pair)
+	return s
+}
+
+func (s *MarkPrice) Do(ctx context.Context) ([]*MarkPriceResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := make([]*MarkPriceResponse, 0)
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// FundingRate Get Funding Rate History of Perpetual Futures
+type FundingRate struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *FundingRate) Symbol(symbol string) *FundingRate {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *FundingRate) StartTime(startTime int64) *FundingRate {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *FundingRate) EndTime(endTime int64) *FundingRate {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Limit Default 100; max 1000.
+func (s *FundingRate) Limit(limit int) *FundingRate {
+	s.r.Set("limit", limit)
+	return s
+}
+
+func (s *FundingRate) Do(ctx context.Context) ([]*futures.FundingRateResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := make([]*futures.FundingRateResponse, 0)
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// KlineData Kline/candlestick bars for a symbol. Klines are uniquely identified by their open time.
+type KlineData struct {
+	c *Client
+	r *core.Request
+}
+
+// KlineDataResponse Volume is counted in contracts, BaseAssetVolume in the margin asset.
+type KlineDataResponse struct {
+	OpenTime                int64           `json:"openTime"`
+	OpenPrice               decimal.Decimal `json:"openPrice"`
+	HighPrice               decimal.Decimal `json:"highPrice"`
+	LowPrice                decimal.Decimal `json:"lowPrice"`
+	ClosePrice              decimal.Decimal `json:"closePrice"`
+	Volume                  decimal.Decimal `json:"volume"`
+	CloseTime               int64           `json:"closeTime"`
+	BaseAssetVolume         decimal.Decimal `json:"baseAssetVolume"`
+	NumberOfTrades          int             `json:"numberOfTrades"`
+	TakerBuyVolume          decimal.Decimal `json:"takerBuyVolume"`
+	TakerBuyBaseAssetVolume decimal.Decimal `json:"takerBuyBaseAssetVolume"`
+}
+
+func (s *KlineData) Symbol(symbol string) *KlineData {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *KlineData) Interval(interval core.IntervalEnum) *KlineData {
+	s.r.Set("interval", interval)
+	return s
+}
+
+func (s *KlineData) StartTime(startTime int64) *KlineData {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *KlineData) EndTime(endTime int64) *KlineData {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Limit Default 500; max 1500.
+func (s *KlineData) Limit(limit int) *KlineData {
+	s.r.Set("limit", limit)
+	return s
+}
+
+func (s *KlineData) Do(ctx context.Context) ([]*KlineDataResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	return parseKlineData(s.c.rawBody())
+}
+
+func parseKlineData(rawBody []byte) ([]*KlineDataResponse, error) {
+	resp := make([]*KlineDataResponse, 0)
+	res := make([][]any, 0)
+	if err := json.Unmarshal(rawBody, &res); err != nil {
+		return resp, err
+	}
+	for _, v := range res {
+		openPrice, _ := decimal.NewFromString(v[1].(string))
+		highPrice, _ := decimal.NewFromString(v[2].(string))
+		lowPrice, _ := decimal.NewFromString(v[3].(string))
+		closePrice, _ := decimal.NewFromString(v[4].(string))
+		volume, _ := decimal.NewFromString(v[5].(string))
+		baseAssetVolume, _ := decimal.NewFromString(v[7].(string))
+		takerBuyVolume, _ := decimal.NewFromString(v[9].(string))
+		takerBuyBaseAssetVolume, _ := decimal.NewFromString(v[10].(string))
+		resp = append(resp, &KlineDataResponse{
+			OpenTime:                int64(v[0].(float64)),
+			OpenPrice:               openPrice,
+			HighPrice:               highPrice,
+			LowPrice:                lowPrice,
+			ClosePrice:              closePrice,
+			Volume:                  volume,
+			CloseTime:               int64(v[6].(float64)),
+			BaseAssetVolume:         baseAssetVolume,
+			NumberOfTrades:          int(v[8].(float64)),
+			TakerBuyVolume:          takerBuyVolume,
+			TakerBuyBaseAssetVolume: takerBuyBaseAssetVolume,
+		})
+	}
+	return resp, nil
+}
+
+// ContractKline Kline/candlestick bars for a specific contract type.
+type ContractKline struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *ContractKline) Pair(pair string) *ContractKline {
+	s.r.Set("pair", pair)
+	return s
+}
+
+func (s *ContractKline) ContractType(contractType core.ContractType) *ContractKline {
+	s.r.Set("contractType", contractType)
+	return s
+}
+
+func (s *ContractKline) Interval(interval core.IntervalEnum) *ContractKline {
+	s.r.Set("interval", interval)
+	return s
+}
+
+func (s *ContractKline) StartTime(startTime int64) *ContractKline {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *ContractKline) EndTime(endTime int64) *ContractKline {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Limit Default 500; max 1500.
+func (s *ContractKline) Limit(limit int) *ContractKline {
+	s.r.Set("limit", limit)
+	return s
+}
+
+func (s *ContractKline) Do(ctx context.Context) ([]*KlineDataResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	return parseKlineData(s.c.rawBody())
+}
+
+// IndexKline Kline/candlestick bars for the index price of a pair.
+type IndexKline struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *IndexKline) Pair(pair string) *IndexKline {
+	s.r.Set("pair", pair)
+	return s
+}
+
+func (s *IndexKline) Interval(interval core.IntervalEnum) *IndexKline {
+	s.r.Set("interval", interval)
+	return s
+}
+
+func (s *IndexKline) StartTime(startTime int64) *IndexKline {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *IndexKline) EndTime(endTime int64) *IndexKline {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Limit Default 500; max 1500.
+func (s *IndexKline) Limit(limit int) *IndexKline {
+	s.r.Set("limit", limit)
+	return s
+}
+
+func (s *IndexKline) Do(ctx context.Context) ([]*KlineDataResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	return parseKlineData(s.c.rawBody())
+}
+
+// MarkKline Kline/candlestick bars for the mark price of a symbol.
+type MarkKline struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *MarkKline) Symbol(symbol string) *MarkKline {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *MarkKline) Interval(interval core.IntervalEnum) *MarkKline {
+	s.r.Set("interval", interval)
+	return s
+}
+
+func (s *MarkKline) StartTime(startTime int64) *MarkKline {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *MarkKline) EndTime(endTime int64) *MarkKline {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Limit Default 500; max 1500.
+func (s *MarkKline) Limit(limit int) *MarkKline {
+	s.r.Set("limit", limit)
+	return s
+}
+
+func (s *MarkKline) Do(ctx context.Context) ([]*KlineDataResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	return parseKlineData(s.c.rawBody())
+}
+
+// Ticker24hr 24 hour rolling window price change statistics.
+// Symbol and pair cannot be sent together; without either every symbol is returned.
+type Ticker24hr struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *Ticker24hr) Symbol(symbol string) *Ticker24hr {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *Ticker24hr) Pair(pair string) *Ticker24hr {
+	s.r.Set("pair", pair)
+	return s
+}
+
+type TickerStatisticsResponse struct {
+	Symbol             string          `json:"symbol"`
+	Pair               string          `json:"pair"`
+	PriceChange        decimal.Decimal `json:"priceChange"`
+	PriceChangePercent decimal.Decimal `json:"priceChangePercent"`
+	WeightedAvgPrice   decimal.Decimal `json:"weightedAvgPrice"`
+	LastPrice          decimal.Decimal `json:"lastPrice"`
+	LastQty            decimal.Decimal `json:"lastQty"`
+	OpenPrice          decimal.Decimal `json:"openPrice"`
+	HighPrice          decimal.Decimal `json:"highPrice"`
+	LowPrice           decimal.Decimal `json:"lowPrice"`
+	Volume             decimal.Decimal `json:"volume"`
+	BaseVolume         decimal.Decimal `json:"baseVolume"`
+	OpenTime           int64           `json:"openTime"`
+	CloseTime          int64           `json:"closeTime"`
+	FirstId            int64           `json:"firstId"`
+	LastId             int64           `json:"lastId"`
+	Count              int             `json:"count"`
+}
+
+func (s *Ticker24hr) Do(ctx context.Context) ([]*TickerStatisticsResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := make([]*TickerStatisticsResponse, 0)
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// TickerPrice Latest price for a symbol, the symbols of a pair or every symbol.
+type TickerPrice struct {
+	c *Client
+	r *core.Request
+}
+
+type TickerPriceResponse struct {
+	Symbol string          `json:"symbol"`
+	Pair   string          `json:"ps"`
+	Price  decimal.Decimal `json:"price"`
+	Time   int64           `json:"time"`
+}
+
+func (s *TickerPrice) Symbol(symbol string) *TickerPrice {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *TickerPrice) Pair(pair string) *TickerPrice {
+	s.r.Set("pair", pair)
+	return s
+}
+
+func (s *TickerPrice) Do(ctx context.Context) ([]*TickerPriceResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := make([]*TickerPriceResponse, 0)
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// BookTicker Best price/qty on the order book for a symbol, the symbols of a pair or every symbol.
+type BookTicker struct {
+	c *Client
+	r *core.Request
+}
+
+type BookTickerResponse struct {
+	futures.BookTickerResponse
+	Pair string `json:"pair"`
+}
+
+func (s *BookTicker) Symbol(symbol string) *BookTicker {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *BookTicker) Pair(pair string) *BookTicker {
+	s.r.Set("pair", pair)
+	return s
+}
+
+func (s *BookTicker) Do(ctx context.Context) ([]*BookTickerResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := make([]*BookTickerResponse, 0)
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// OpenInterest Get present open interest of a specific symbol.
+type OpenInterest struct {
+	c *Client
+	r *core.Request
+}
+
+type OpenInterestResponse struct {
+	Symbol       string          `json:"symbol"`
+	Pair         string          `json:"pair"`
+	OpenInterest decimal.Decimal `json:"openInterest"` // contracts
+	ContractType string          `json:"contractType"`
+	Time         int64           `json:"time"`
+}
+
+func (s *OpenInterest) Symbol(symbol string) *OpenInterest {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *OpenInterest) Do(ctx context.Context) (*OpenInterestResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := new(OpenInterestResponse)
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
@@ -0,0 +1,140 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type marketTestSuite struct {
+	baseHttpTestSuite
+}
+
+func TestMarket(t *testing.T) {
+	suite.Run(t, new(marketTestSuite))
+}
+
+func (s *marketTestSuite) TestExchangeInfo() {
+	msg := []byte(`{
+	  "exchangeFilters": [],
+	  "rateLimits": [
+		{"interval": "MINUTE", "intervalNum": 1, "limit": 6000, "rateLimitType": "REQUEST_WEIGHT"}
+	  ],
+	  "serverTime": 1565613908500,
+	  "symbols": [
+		{
+		  "filters": [
+			{"filterType": "PRICE_FILTER", "maxPrice": "100000", "minPrice": "0.1", "tickSize": "0.1"},
+			{"filterType": "LOT_SIZE", "maxQty": "100000", "minQty": "1", "stepSize": "1"}
+		  ],
+		  "OrderType": ["LIMIT", "MARKET"],
+		  "timeInForce": ["GTC", "IOC", "FOK", "GTX"],
+		  "liquidationFee": "0.010000",
+		  "marketTakeBound": "0.30",
+		  "symbol": "BTCUSD_200925",
+		  "pair": "BTCUSD",
+		  "contractType": "CURRENT_QUARTER",
+		  "deliveryDate": 1601020800000,
+		  "onboardDate": 1590739200000,
+		  "contractStatus": "TRADING",
+		  "contractSize": 100,
+		  "quoteAsset": "USD",
+		  "baseAsset": "BTC",
+		  "marginAsset": "BTC",
+		  "pricePrecision": 1,
+		  "quantityPrecision": 0,
+		  "baseAssetPrecision": 8,
+		  "quotePrecision": 8,
+		  "equalQtyPrecision": 4,
+		  "triggerProtect": "0.0500",
+		  "maintMarginPercent": "2.5000",
+		  "requiredMarginPercent": "5.0000",
+		  "underlyingType": "COIN",
+		  "underlyingSubType": []
+		}
+	  ],
+	  "timezone": "UTC"
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewExchangeInfo().Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *ExchangeInfoResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(testResp.ServerTime, resp.ServerTime, "ServerTime")
+	r.Len(resp.Symbols, 1)
+	r.Nil(resp.Symbol("ETHUSD_PERP"))
+	info := resp.Symbol("BTCUSD_200925")
+	r.NotNil(info)
+	r.Equal("BTCUSD", info.Pair, "Pair")
+	r.Equal("CURRENT_QUARTER", info.ContractType, "ContractType")
+	r.Equal("100", info.ContractSize.String(), "ContractSize")
+	r.Equal(testResp.Symbols[0].Filters[1].StepSize, info.Filters[1].StepSize, "StepSize")
+	r.Equal("0.01", info.BaseQuantity(decimal.NewFromInt(10), decimal.NewFromInt(100000)).String())
+	r.Equal("15", info.Contracts(decimal.RequireFromString("0.0155"), decimal.NewFromInt(100000)).String())
+}
+
+func (s *marketTestSuite) TestKline() {
+	msg := []byte(`[
+	  [
+		1591258320000,
+		"9640.7",
+		"9642.4",
+		"9640.6",
+		"9642.0",
+		"206",
+		1591258379999,
+		"2.13660389",
+		48,
+		"119",
+		"1.23424865",
+		"0"
+	  ]
+	]`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewKline().Symbol("BTCUSD_200626").Interval(core.Interval1m).Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Len(resp, 1)
+	r.Equal(int64(1591258320000), resp[0].OpenTime, "OpenTime")
+	r.Equal("9642", resp[0].ClosePrice.String(), "ClosePrice")
+	r.Equal("206", resp[0].Volume.String(), "Volume")
+	r.Equal(int64(1591258379999), resp[0].CloseTime, "CloseTime")
+	r.Equal("2.13660389", resp[0].BaseAssetVolume.String(), "BaseAssetVolume")
+	r.Equal(48, resp[0].NumberOfTrades, "NumberOfTrades")
+	r.Equal("119", resp[0].TakerBuyVolume.String(), "TakerBuyVolume")
+	r.Equal("1.23424865", resp[0].TakerBuyBaseAssetVolume.String(), "TakerBuyBaseAssetVolume")
+}
+
+func (s *marketTestSuite) TestMarkPrice() {
+	msg := []byte(`[
+	  {
+		"symbol": "BTCUSD_PERP",
+		"pair": "BTCUSD",
+		"markPrice": "11029.69574559",
+		"indexPrice": "10979.14437500",
+		"estimatedSettlePrice": "10981.74168236",
+		"lastFundingRate": "0.00071003",
+		"interestRate": "0.00010000",
+		"nextFundingTime": 1596096000000,
+		"time": 1596094042000
+	  }
+	]`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewMarkPrice().Symbol("BTCUSD_PERP").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp []*MarkPriceResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Len(resp, 1)
+	r.Equal(testResp[0].Symbol, resp[0].Symbol, "Symbol")
+	r.Equal(testResp[0].Pair, resp[0].Pair, "Pair")
+	r.Equal(testResp[0].MarkPrice, resp[0].MarkPrice, "MarkPrice")
+	r.Equal(testResp[0].IndexPrice, resp[0].IndexPrice, "IndexPrice")
+}
@@ -0,0 +1,70 @@
+package delivery
+
+import (
+	"fmt"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/futures"
+	"strings"
+	"time"
+)
+
+// StreamDescriptors Builds descriptors of the COIN-M futures market and user data streams, use it through Streams.
+type StreamDescriptors struct{}
+
+// Streams Describes COIN-M futures streams, e.g. delivery.Streams.MarkPrice("BTCUSD_PERP", time.Second).
+// Subscribe a descriptor with core.Subscribe or mix several into one connection with core.SubscribeCombined.
+var Streams StreamDescriptors
+
+// AggTrade Stream Name: <symbol>@aggTrade
+func (StreamDescriptors) AggTrade(symbol string) core.StreamDescriptor[*futures.AggTradeEvent] {
+	return core.NewStreamDescriptor[*futures.AggTradeEvent](strings.ToLower(symbol) + "@aggTrade")
+}
+
+// IndexPrice Stream Name: <pair>@indexPrice OR <pair>@indexPrice@1s
+// Update Speed: 3000ms (the default) or 1000ms.
+func (StreamDescriptors) IndexPrice(pair string, interval ...time.Duration) core.StreamDescriptor[*IndexPriceEvent] {
+	return core.NewStreamDescriptor[*IndexPriceEvent](fmt.Sprintf("%s@indexPrice%s", strings.ToLower(pair), core.UpdateSpeed(3*time.Second, interval...)))
+}
+
+// MarkPrice Stream Name: <symbol>@markPrice OR <symbol>@markPrice@1s
+// Update Speed: 3000ms (the default) or 1000ms.
+func (StreamDescriptors) MarkPrice(symbol string, interval ...time.Duration) core.StreamDescriptor[*futures.MarkPriceEvent] {
+	return core.NewStreamDescriptor[*futures.MarkPriceEvent](fmt.Sprintf("%s@markPrice%s", strings.ToLower(symbol), core.UpdateSpeed(3*time.Second, interval...)))
+}
+
+// PairMarkPrice Stream Name: <pair>@markPrice OR <pair>@markPrice@1s
+// Update Speed: 3000ms (the default) or 1000ms.
+func (StreamDescriptors) PairMarkPrice(pair string, interval ...time.Duration) core.StreamDescriptor[[]*futures.MarkPriceEvent] {
+	return core.NewStreamDescriptor[[]*futures.MarkPriceEvent](fmt.Sprintf("%s@markPrice%s", strings.ToLower(pair), core.UpdateSpeed(3*time.Second, interval...)))
+}
+
+// Kline Stream Name: <symbol>@kline_<interval>
+func (StreamDescriptors) Kline(symbol string, interval core.IntervalEnum) core.StreamDescriptor[*futures.KlineEvent] {
+	return core.NewStreamDescriptor[*futures.KlineEvent](fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval))
+}
+
+// ContractKline Stream Name: <pair>_<contractType>@continuousKline_<interval>
+func (StreamDescriptors) ContractKline(pair string, contractType core.ContractType, interval core.IntervalEnum) core.StreamDescriptor[*futures.ContractKlineEvent] {
+	return core.NewStreamDescriptor[*futures.ContractKlineEvent](fmt.Sprintf("%s_%s@continuousKline_%s", strings.ToLower(pair), strings.ToLower(string(contractType)), interval))
+}
+
+// IndexKline Stream Name: <pair>@indexPriceKline_<interval>
+func (StreamDescriptors) IndexKline(pair string, interval core.IntervalEnum) core.StreamDescriptor[*IndexKlineEvent] {
+	return core.NewStreamDescriptor[*IndexKlineEvent](fmt.Sprintf("%s@indexPriceKline_%s", strings.ToLower(pair), interval))
+}
+
+// BookTicker Stream Name: <symbol>@bookTicker
+func (StreamDescriptors) BookTicker(symbol string) core.StreamDescriptor[*BookTickerEvent] {
+	return core.NewStreamDescriptor[*BookTickerEvent](strings.ToLower(symbol) + "@bookTicker")
+}
+
+// Depth Stream Name: <symbol>@depth OR <symbol>@depth@500ms OR <symbol>@depth@100ms
+// Update Speed: 250ms (the default), 500ms or 100ms.
+func (StreamDescriptors) Depth(symbol string, interval ...time.Duration) core.StreamDescriptor[*DepthEvent] {
+	return core.NewStreamDescriptor[*DepthEvent](fmt.Sprintf("%s@depth%s", strings.ToLower(symbol), core.UpdateSpeed(250*time.Millisecond, interval...)))
+}
+
+// UserData Stream Name: <listenKey>
+func (StreamDescriptors) UserData(listenKey string) core.StreamDescriptor[*UserDataEvent] {
+	return core.NewStreamDescriptorFunc(listenKey, parseUserEvent)
+}
@@ -0,0 +1,126 @@
+package delivery
+
+import (
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/futures"
+	"github.com/shopspring/decimal"
+)
+
+// WebsocketStreams Market streams of dstream.binance.com. Event schemas shared with USDⓈ-M futures are reused from the futures package.
+type WebsocketStreams struct {
+	c *WsClient
+}
+
+// AggTradeService The Aggregate Trade Streams push market trade information that is aggregated for fills with same price and taking side every 100 milliseconds.
+type AggTradeService struct {
+	*core.Stream[*futures.AggTradeEvent]
+}
+
+// SubscribeAggTrade Stream Name: <symbol>@aggTrade
+func (s *WebsocketStreams) SubscribeAggTrade(symbol string) *AggTradeService {
+	return &AggTradeService{core.NewRawStream(s.c.WsClient, Streams.AggTrade(symbol))}
+}
+
+// IndexPriceService Index price of a pair pushed every 3 seconds or every second.
+type IndexPriceService struct {
+	*core.Stream[*IndexPriceEvent]
+}
+
+type IndexPriceEvent struct {
+	EventType  string          `json:"e"`
+	EventTime  int64           `json:"E"`
+	Pair       string          `json:"i"`
+	IndexPrice decimal.Decimal `json:"p"`
+}
+
+// SubscribeIndexPrice Stream Name: <pair>@indexPrice OR <pair>@indexPrice@1s
+func (s *WebsocketStreams) SubscribeIndexPrice(pair string, interval ...string) *IndexPriceService {
+	return &IndexPriceService{core.NewRawStream(s.c.WsClient, Streams.IndexPrice(pair, core.ParseUpdateSpeed(interval...)...))}
+}
+
+// MarkPriceService Mark price of a symbol pushed every 3 seconds or every second. Funding fields are empty for delivery contracts.
+type MarkPriceService struct {
+	*core.Stream[*futures.MarkPriceEvent]
+}
+
+// SubscribeMarkPrice Stream Name: <symbol>@markPrice OR <symbol>@markPrice@1s
+func (s *WebsocketStreams) SubscribeMarkPrice(symbol string, interval ...string) *MarkPriceService {
+	return &MarkPriceService{core.NewRawStream(s.c.WsClient, Streams.MarkPrice(symbol, core.ParseUpdateSpeed(interval...)...))}
+}
+
+// PairMarkPriceService Mark price of every symbol of a pair.
+type PairMarkPriceService struct {
+	*core.Stream[[]*futures.MarkPriceEvent]
+}
+
+// SubscribePairMarkPrice Stream Name: <pair>@markPrice OR <pair>@markPrice@1s
+func (s *WebsocketStreams) SubscribePairMarkPrice(pair string, interval ...string) *PairMarkPriceService {
+	return &PairMarkPriceService{core.NewRawStream(s.c.WsClient, Streams.PairMarkPrice(pair, core.ParseUpdateSpeed(interval...)...))}
+}
+
+// KlineService The Kline/Candlestick Stream push updates to the current klines/candlestick every 250 milliseconds. Volumes are counted in contracts.
+type KlineService struct {
+	*core.Stream[*futures.KlineEvent]
+}
+
+// SubscribeKline Stream Name: <symbol>@kline_<interval>
+func (s *WebsocketStreams) SubscribeKline(symbol string, interval core.IntervalEnum) *KlineService {
+	return &KlineService{core.NewRawStream(s.c.WsClient, Streams.Kline(symbol, interval))}
+}
+
+// ContractKlineService Kline updates of the contract of a pair.
+type ContractKlineService struct {
+	*core.Stream[*futures.ContractKlineEvent]
+}
+
+// SubscribeContractKline Stream Name: <pair>_<contractType>@continuousKline_<interval>
+func (s *WebsocketStreams) SubscribeContractKline(pair string, contractType core.ContractType, interval core.IntervalEnum) *ContractKlineService {
+	return &ContractKlineService{core.NewRawStream(s.c.WsClient, Streams.ContractKline(pair, contractType, interval))}
+}
+
+// IndexKlineService Kline updates of the index price of a pair.
+type IndexKlineService struct {
+	*core.Stream[*IndexKlineEvent]
+}
+
+type IndexKlineEvent struct {
+	Event string               `json:"e"`
+	Time  int64                `json:"E"`
+	Pair  string               `json:"ps"`
+	Kline *futures.KlineResult `json:"k"`
+}
+
+// SubscribeIndexKline Stream Name: <pair>@indexPriceKline_<interval>
+func (s *WebsocketStreams) SubscribeIndexKline(pair string, interval core.IntervalEnum) *IndexKlineService {
+	return &IndexKlineService{core.NewRawStream(s.c.WsClient, Streams.IndexKline(pair, interval))}
+}
+
+// BookTickerService Pushes any update to the best bid or ask's price or quantity in real-time for a specified symbol.
+type BookTickerService struct {
+	*core.Stream[*BookTickerEvent]
+}
+
+type BookTickerEvent struct {
+	futures.BookTickerEvent
+	Pair string `json:"ps"`
+}
+
+// SubscribeBookTicker Stream Name: <symbol>@bookTicker
+func (s *WebsocketStreams) SubscribeBookTicker(symbol string) *BookTickerService {
+	return &BookTickerService{core.NewRawStream(s.c.WsClient, Streams.BookTicker(symbol))}
+}
+
+// DepthService Bids and asks, pushed every 250 milliseconds, 500 milliseconds, or 100 milliseconds
+type DepthService struct {
+	*core.Stream[*DepthEvent]
+}
+
+type DepthEvent struct {
+	futures.DepthEvent
+	Pair string `json:"ps"`
+}
+
+// SubscribeDepth Stream Names: <symbol>@depth OR <symbol>@depth@500ms OR <symbol>@depth@100ms
+func (s *WebsocketStreams) SubscribeDepth(symbol string, interval ...string) *DepthService {
+	return &DepthService{core.NewRawStream(s.c.WsClient, Streams.Depth(symbol, core.ParseUpdateSpeed(interval...)...))}
+}
@@ -0,0 +1,79 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type websocketStreamsTestSuite struct {
+	baseWsTestSuite
+}
+
+func TestWebsocketStreams(t *testing.T) {
+	suite.Run(t, new(websocketStreamsTestSuite))
+}
+
+func (s *websocketStreamsTestSuite) TestWebSocketSubscribeIndexPrice() {
+	msg := []byte(`{"e":"indexPriceUpdate","E":1591261236000,"i":"BTCUSD","p":"9636.57860000"}`)
+	server := s.setup(msg)
+	defer server.Close()
+	onMessage, onError := s.client.NewWebsocketStreams().SubscribeIndexPrice("BTCUSD", "1s").Do(context.Background())
+	r := s.r()
+	var testResp *IndexPriceEvent
+	r.Empty(json.Unmarshal(msg, &testResp))
+	for {
+		select {
+		case event := <-onMessage:
+			r.Equal(*testResp, *event)
+			return
+		case err := <-onError:
+			s.Error(err)
+			return
+		}
+	}
+}
+
+func (s *websocketStreamsTestSuite) TestWebSocketSubscribeBookTicker() {
+	msg := []byte(`{"e":"bookTicker","u":17242169,"s":"BTCUSD_200626","ps":"BTCUSD","b":"9548.1","B":"52","a":"9548.5","A":"11","T":1591268628155,"E":1591268628166}`)
+	server := s.setup(msg)
+	defer server.Close()
+	onMessage, onError := s.client.NewWebsocketStreams().SubscribeBookTicker("BTCUSD_200626").Do(context.Background())
+	r := s.r()
+	var testResp *BookTickerEvent
+	r.Empty(json.Unmarshal(msg, &testResp))
+	for {
+		select {
+		case event := <-onMessage:
+			r.Equal("BTCUSD", event.Pair, "Pair")
+			r.Equal(*testResp, *event)
+			return
+		case err := <-onError:
+			s.Error(err)
+			return
+		}
+	}
+}
+
+func (s *websocketStreamsTestSuite) TestWebSocketSubscribeUserData() {
+	msg := []byte(`{"e":"ACCOUNT_UPDATE","E":1564745798939,"T":1564745798938,"i":"SfsR","a":{"m":"ORDER","B":[{"a":"BTC","wb":"122624.12345678","cw":"100.12345678","bc":"50.12345678"}],"P":[{"s":"BTCUSD_200925","pa":"0","ep":"0.0","cr":"200","up":"0","mt":"isolated","iw":"0.00000000","ps":"BOTH"}]}}`)
+	server := s.setup(msg)
+	defer server.Close()
+	onMessage, onError := s.client.NewWebsocketStreams().SubscribeUserData("listenKey").Do(context.Background())
+	r := s.r()
+	for {
+		select {
+		case event := <-onMessage:
+			r.Equal(ACCOUNT_UPDATE, string(event.Event), "Event")
+			r.Equal(int64(1564745798939), event.Time, "Time")
+			r.Equal("ORDER", event.AccountUpdate.UpdateData.ReasonType, "ReasonType")
+			r.Equal("BTC", event.AccountUpdate.UpdateData.Balances[0].Asset, "Asset")
+			r.Equal("BTCUSD_200925", event.AccountUpdate.UpdateData.UpdatePosition[0].Symbol, "Symbol")
+			return
+		case err := <-onError:
+			s.Error(err)
+			return
+		}
+	}
+}
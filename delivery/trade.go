@@ -0,0 +1,768 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/futures"
+	"github.com/shopspring/decimal"
+	"strconv"
+	"strings"
+)
+
+const (
+	maxBatchOrders = 5
+	maxBatchCancel = 10
+)
+
+// CreateOrder Send in a new order. Quantity is a number of contracts.
+// https://developers.binance.com/docs/derivatives/coin-margined-futures/trade
+type CreateOrder struct {
+	c *Client
+	r *core.Request
+}
+
+type OrderResponse struct {
+	ClientOrderId           string          `json:"clientOrderId"`
+	CumQty                  decimal.Decimal `json:"cumQty"`
+	CumBase                 decimal.Decimal `json:"cumBase"`
+	ExecutedQty             decimal.Decimal `json:"executedQty"`
+	OrderId                 int64           `json:"orderId"`
+	AvgPrice                decimal.Decimal `json:"avgPrice"`
+	OrigQty                 decimal.Decimal `json:"origQty"`
+	Price                   decimal.Decimal `json:"price"`
+	ReduceOnly              bool            `json:"reduceOnly"`
+	Side                    string          `json:"side"`
+	PositionSide            string          `json:"positionSide"`
+	Status                  string          `json:"status"`
+	StopPrice               decimal.Decimal `json:"stopPrice"`
+	ClosePosition           bool            `json:"closePosition"`
+	Symbol                  string          `json:"symbol"`
+	Pair                    string          `json:"pair"`
+	TimeInForce             string          `json:"timeInForce"`
+	Type                    string          `json:"type"`
+	OrigType                string          `json:"origType"`
+	ActivatePrice           decimal.Decimal `json:"activatePrice"`
+	PriceRate               decimal.Decimal `json:"priceRate"`
+	UpdateTime              int64           `json:"updateTime"`
+	WorkingType             string          `json:"workingType"`
+	PriceProtect            bool            `json:"priceProtect"`
+	PriceMatch              string          `json:"priceMatch"`
+	SelfTradePreventionMode string          `json:"selfTradePreventionMode"`
+}
+
+func (s *CreateOrder) Symbol(symbol string) *CreateOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// Side BUY or SELL
+func (s *CreateOrder) Side(side core.OrderSideEnum) *CreateOrder {
+	s.r.Set("side", side)
+	return s
+}
+
+func (s *CreateOrder) PositionSide(positionSide core.PositionSideEnum) *CreateOrder {
+	s.r.Set("positionSide", positionSide)
+	return s
+}
+
+func (s *CreateOrder) Type(orderType core.OrderTypeEnum) *CreateOrder {
+	s.r.Set("type", orderType)
+	return s
+}
+
+func (s *CreateOrder) TimeInForce(timeInForce core.TimeInForceEnum) *CreateOrder {
+	s.r.Set("timeInForce", timeInForce)
+	return s
+}
+
+// Quantity Number of contracts, see SymbolInfo.Contracts.
+func (s *CreateOrder) Quantity(quantity string) *CreateOrder {
+	s.r.Set("quantity", quantity)
+	return s
+}
+
+// ReduceOnly "true" or "false". default "false". Cannot be sent in Hedge Mode; cannot be sent with closePosition=true
+func (s *CreateOrder) ReduceOnly(reduceOnly string) *CreateOrder {
+	s.r.Set("reduceOnly", reduceOnly)
+	return s
+}
+
+func (s *CreateOrder) Price(price string) *CreateOrder {
+	s.r.Set("price", price)
+	return s
+}
+
+// NewClientOrderId A unique id among open orders. Automatically generated if not sent.
+func (s *CreateOrder) NewClientOrderId(newClientOrderId string) *CreateOrder {
+	s.r.Set("newClientOrderId", newClientOrderId)
+	return s
+}
+
+// StopPrice Used with STOP/STOP_MARKET or TAKE_PROFIT/TAKE_PROFIT_MARKET orders.
+func (s *CreateOrder) StopPrice(stopPrice string) *CreateOrder {
+	s.r.Set("stopPrice", stopPrice)
+	return s
+}
+
+// ClosePosition true, false；Close-All，used with STOP_MARKET or TAKE_PROFIT_MARKET.
+func (s *CreateOrder) ClosePosition(closePosition string) *CreateOrder {
+	s.r.Set("closePosition", closePosition)
+	return s
+}
+
+func (s *CreateOrder) ActivationPrice(activationPrice float64) *CreateOrder {
+	s.r.Set("activationPrice", activationPrice)
+	return s
+}
+
+func (s *CreateOrder) CallbackRate(callbackRate float64) *CreateOrder {
+	s.r.Set("callbackRate", callbackRate)
+	return s
+}
+
+func (s *CreateOrder) WorkingType(workingType core.WorkingType) *CreateOrder {
+	s.r.Set("workingType", workingType)
+	return s
+}
+
+// PriceProtect "TRUE" or "FALSE", default "FALSE". Used with STOP/STOP_MARKET or TAKE_PROFIT/TAKE_PROFIT_MARKET orders.
+func (s *CreateOrder) PriceProtect(priceProtect string) *CreateOrder {
+	s.r.Set("priceProtect", priceProtect)
+	return s
+}
+
+// NewOrderRespType "ACK", "RESULT", default "ACK"
+func (s *CreateOrder) NewOrderRespType(newOrderRespType core.OrderResponseTypeEnum) *CreateOrder {
+	s.r.Set("newOrderRespType", newOrderRespType)
+	return s
+}
+
+func (s *CreateOrder) PriceMatch(priceMatch string) *CreateOrder {
+	s.r.Set("priceMatch", priceMatch)
+	return s
+}
+
+func (s *CreateOrder) SelfTradePreventionMode(selfTradePreventionMode core.STPModeEnum) *CreateOrder {
+	s.r.Set("selfTradePreventionMode", selfTradePreventionMode)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *CreateOrder) RecvWindow(recvWindow int64) *CreateOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CreateOrder) Do(ctx context.Context) (*OrderResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := new(OrderResponse)
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// PlaceBatchOrder Place Multiple Orders, at most 5 per request. The order parameters are those of USDⓈ-M futures.
+type PlaceBatchOrder struct {
+	c      *Client
+	r      *core.Request
+	orders []futures.OrderReq
+}
+
+type PlaceBatchOrderResponse struct {
+	OrderResponse
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+func (s *PlaceBatchOrder) BatchOrders(batchOrders []futures.OrderReq) *PlaceBatchOrder {
+	s.orders = batchOrders
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *PlaceBatchOrder) RecvWindow(recvWindow int64) *PlaceBatchOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+// Do Results are aligned index-by-index with the orders, rejected orders carry Code and Msg.
+func (s *PlaceBatchOrder) Do(ctx context.Context) ([]*PlaceBatchOrderResponse, error) {
+	if len(s.orders) == 0 || len(s.orders) > maxBatchOrders {
+		return nil, fmt.Errorf("batchOrders must hold 1 to %d orders", maxBatchOrders)
+	}
+	orderJson, err := json.Marshal(s.orders)
+	if err != nil {
+		return nil, err
+	}
+	s.r.Set("batchOrders", string(orderJson))
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := make([]*PlaceBatchOrderResponse, 0)
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// ModifyOrder Order modify function, currently only LIMIT order modification is supported.
+type ModifyOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *ModifyOrder) OrderId(orderId int64) *ModifyOrder {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *ModifyOrder) OrigClientOrderId(origClientOrderId string) *ModifyOrder {
+	s.r.Set("origClientOrderId", origClientOrderId)
+	return s
+}
+
+func (s *ModifyOrder) Symbol(symbol string) *ModifyOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// Side BUY or SELL
+func (s *ModifyOrder) Side(side core.OrderSideEnum) *ModifyOrder {
+	s.r.Set("side", side)
+	return s
+}
+
+func (s *ModifyOrder) Quantity(quantity string) *ModifyOrder {
+	s.r.Set("quantity", quantity)
+	return s
+}
+
+func (s *ModifyOrder) Price(price string) *ModifyOrder {
+	s.r.Set("price", price)
+	return s
+}
+
+func (s *ModifyOrder) PriceMatch(priceMatch string) *ModifyOrder {
+	s.r.Set("priceMatch", priceMatch)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *ModifyOrder) RecvWindow(recvWindow int64) *ModifyOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *ModifyOrder) Do(ctx context.Context) (*OrderResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := new(OrderResponse)
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// CancelOrder Cancel an active order.
+type CancelOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *CancelOrder) Symbol(symbol string) *CancelOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *CancelOrder) OrderId(orderId int64) *CancelOrder {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *CancelOrder) OrigClientOrderId(origClientOrderId string) *CancelOrder {
+	s.r.Set("origClientOrderId", origClientOrderId)
+	return s
+}
+
+func (s *CancelOrder) RecvWindow(recvWindow int64) *CancelOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CancelOrder) Do(ctx context.Context) (*OrderResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := new(OrderResponse)
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// CancelMultipleOrder Cancel Multiple Orders, at most 10 ids per request.
+type CancelMultipleOrder struct {
+	c    *Client
+	r    *core.Request
+	size int
+}
+
+type CancelMultipleOrderResponse struct {
+	OrderResponse
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+func (s *CancelMultipleOrder) Symbol(symbol string) *CancelMultipleOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *CancelMultipleOrder) OrderIdList(orderIdList []int64) *CancelMultipleOrder {
+	orderList := make([]string, 0, len(orderIdList))
+	for _, orderId := range orderIdList {
+		orderList = append(orderList, strconv.FormatInt(orderId, 10))
+	}
+	s.r.Set("orderIdList", "["+strings.Join(orderList, ",")+"]")
+	s.size = len(orderIdList)
+	return s
+}
+
+func (s *CancelMultipleOrder) OrigClientOrderIdList(origClientOrderIdList []string) *CancelMultipleOrder {
+	s.r.Set("origClientOrderIdList", origClientOrderIdList)
+	s.size = len(origClientOrderIdList)
+	return s
+}
+
+func (s *CancelMultipleOrder) RecvWindow(recvWindow int64) *CancelMultipleOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CancelMultipleOrder) Do(ctx context.Context) ([]*CancelMultipleOrderResponse, error) {
+	if s.r.GetQuery("orderIdList") == "" && s.r.GetQuery("origClientOrderIdList") == "" {
+		return nil, errors.New("orderIdList or origClientOrderIdList is required")
+	}
+	if s.size > maxBatchCancel {
+		return nil, fmt.Errorf("at most %d orders can be canceled in one batch", maxBatchCancel)
+	}
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := make([]*CancelMultipleOrderResponse, 0)
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// CancelOpenOrder Cancel All Open Orders
+type CancelOpenOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *CancelOpenOrder) Symbol(symbol string) *CancelOpenOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *CancelOpenOrder) RecvWindow(recvWindow int64) *CancelOpenOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CancelOpenOrder) Do(ctx context.Context) (*futures.CancelOpenOrderResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := new(futures.CancelOpenOrderResponse)
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// CountdownCancelAll Cancel all open orders of the specified symbol at the end of the specified countdown.
+type CountdownCancelAll struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *CountdownCancelAll) Symbol(symbol string) *CountdownCancelAll {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *CountdownCancelAll) CountdownTime(countdownTime int64) *CountdownCancelAll {
+	s.r.Set("countdownTime", countdownTime)
+	return s
+}
+
+func (s *CountdownCancelAll) RecvWindow(recvWindow int64) *CountdownCancelAll {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CountdownCancelAll) Do(ctx context.Context) (*futures.CountdownCancelAllResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := new(futures.CountdownCancelAllResponse)
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// QueryOrder Check an order's status.
+type QueryOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *QueryOrder) Symbol(symbol string) *QueryOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *QueryOrder) OrderId(orderId int64) *QueryOrder {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *QueryOrder) OrigClientOrderId(origClientOrderId string) *QueryOrder {
+	s.r.Set("origClientOrderId", origClientOrderId)
+	return s
+}
+
+func (s *QueryOrder) RecvWindow(recvWindow int64) *QueryOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *QueryOrder) Do(ctx context.Context) (*OrderResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := new(OrderResponse)
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// QueryAllOrder Get all account orders of a symbol or of every symbol of a pair; active, canceled, or filled.
+type QueryAllOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *QueryAllOrder) Symbol(symbol string) *QueryAllOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *QueryAllOrder) Pair(pair string) *QueryAllOrder {
+	s.r.Set("pair", pair)
+	return s
+}
+
+func (s *QueryAllOrder) OrderId(orderId int64) *QueryAllOrder {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *QueryAllOrder) StartTime(startTime int64) *QueryAllOrder {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *QueryAllOrder) EndTime(endTime int64) *QueryAllOrder {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Limit Default 50; max 100.
+func (s *QueryAllOrder) Limit(limit int64) *QueryAllOrder {
+	s.r.Set("limit", limit)
+	return s
+}
+
+func (s *QueryAllOrder) RecvWindow(recvWindow int64) *QueryAllOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *QueryAllOrder) Do(ctx context.Context) ([]*OrderResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := make([]*OrderResponse, 0)
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// AllOpenOrder Get all open orders of a symbol, of every symbol of a pair, or of every symbol.
+type AllOpenOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *AllOpenOrder) Symbol(symbol string) *AllOpenOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *AllOpenOrder) Pair(pair string) *AllOpenOrder {
+	s.r.Set("pair", pair)
+	return s
+}
+
+func (s *AllOpenOrder) RecvWindow(recvWindow int64) *AllOpenOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *AllOpenOrder) Do(ctx context.Context) ([]*OrderResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := make([]*OrderResponse, 0)
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// UserTrades Get trades of a symbol or of every symbol of a pair.
+type UserTrades struct {
+	c *Client
+	r *core.Request
+}
+
+type UserTradesResponse struct {
+	Symbol          string          `json:"symbol"`
+	Pair            string          `json:"pair"`
+	Id              int64           `json:"id"`
+	OrderId         int64           `json:"orderId"`
+	Side            string          `json:"side"`
+	PositionSide    string          `json:"positionSide"`
+	Price           decimal.Decimal `json:"price"`
+	Qty             decimal.Decimal `json:"qty"` // contracts
+	BaseQty         decimal.Decimal `json:"baseQty"`
+	RealizedPnl     decimal.Decimal `json:"realizedPnl"`
+	MarginAsset     string          `json:"marginAsset"`
+	Commission      decimal.Decimal `json:"commission"`
+	CommissionAsset string          `json:"commissionAsset"`
+	Buyer           bool            `json:"buyer"`
+	Maker           bool            `json:"maker"`
+	Time            int64           `json:"time"`
+}
+
+func (s *UserTrades) Symbol(symbol string) *UserTrades {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *UserTrades) Pair(pair string) *UserTrades {
+	s.r.Set("pair", pair)
+	return s
+}
+
+func (s *UserTrades) OrderId(orderId int64) *UserTrades {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *UserTrades) StartTime(startTime int64) *UserTrades {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *UserTrades) EndTime(endTime int64) *UserTrades {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+func (s *UserTrades) FromId(fromId int64) *UserTrades {
+	s.r.Set("fromId", fromId)
+	return s
+}
+
+// Limit Default 50; max 1000
+func (s *UserTrades) Limit(limit int64) *UserTrades {
+	s.r.Set("limit", limit)
+	return s
+}
+
+func (s *UserTrades) RecvWindow(recvWindow int64) *UserTrades {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *UserTrades) Do(ctx context.Context) ([]*UserTradesResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := make([]*UserTradesResponse, 0)
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// PositionRisk Get current position information of a margin asset or pair.
+type PositionRisk struct {
+	c *Client
+	r *core.Request
+}
+
+type PositionRiskResponse struct {
+	Symbol           string          `json:"symbol"`
+	PositionAmt      decimal.Decimal `json:"positionAmt"` // contracts
+	EntryPrice       decimal.Decimal `json:"entryPrice"`
+	BreakEvenPrice   decimal.Decimal `json:"breakEvenPrice"`
+	MarkPrice        decimal.Decimal `json:"markPrice"`
+	UnRealizedProfit decimal.Decimal `json:"unRealizedProfit"`
+	LiquidationPrice decimal.Decimal `json:"liquidationPrice"`
+	Leverage         decimal.Decimal `json:"leverage"`
+	MaxQty           decimal.Decimal `json:"maxQty"`
+	MarginType       string          `json:"marginType"`
+	IsolatedMargin   decimal.Decimal `json:"isolatedMargin"`
+	IsAutoAddMargin  string          `json:"isAutoAddMargin"`
+	PositionSide     string          `json:"positionSide"`
+	NotionalValue    decimal.Decimal `json:"notionalValue"`
+	IsolatedWallet   decimal.Decimal `json:"isolatedWallet"`
+	UpdateTime       int64           `json:"updateTime"`
+}
+
+func (s *PositionRisk) MarginAsset(marginAsset string) *PositionRisk {
+	s.r.Set("marginAsset", marginAsset)
+	return s
+}
+
+func (s *PositionRisk) Pair(pair string) *PositionRisk {
+	s.r.Set("pair", pair)
+	return s
+}
+
+func (s *PositionRisk) RecvWindow(recvWindow int64) *PositionRisk {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *PositionRisk) Do(ctx context.Context) ([]*PositionRiskResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := make([]*PositionRiskResponse, 0)
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// ChangeMarginType Change symbol level margin type
+type ChangeMarginType struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *ChangeMarginType) Symbol(symbol string) *ChangeMarginType {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *ChangeMarginType) MarginType(marginType core.MarginType) *ChangeMarginType {
+	s.r.Set("marginType", marginType)
+	return s
+}
+
+func (s *ChangeMarginType) RecvWindow(recvWindow int64) *ChangeMarginType {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *ChangeMarginType) Do(ctx context.Context) (*futures.ChangeMarginTypeResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := new(futures.ChangeMarginTypeResponse)
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// ChangePositionSide Change user's position mode (Hedge Mode or One-way Mode) on EVERY symbol
+type ChangePositionSide struct {
+	c *Client
+	r *core.Request
+}
+
+// DualSidePosition "true": Hedge Mode; "false": One-way Mode
+func (s *ChangePositionSide) DualSidePosition(dualSidePosition string) *ChangePositionSide {
+	s.r.Set("dualSidePosition", dualSidePosition)
+	return s
+}
+
+func (s *ChangePositionSide) RecvWindow(recvWindow int64) *ChangePositionSide {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *ChangePositionSide) Do(ctx context.Context) (*futures.ChangeMarginTypeResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := new(futures.ChangeMarginTypeResponse)
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// ChangeLeverage Change user's initial leverage in the specific symbol market.
+type ChangeLeverage struct {
+	c *Client
+	r *core.Request
+}
+
+type ChangeLeverageResponse struct {
+	Leverage int             `json:"leverage"`
+	MaxQty   decimal.Decimal `json:"maxQty"` // contracts
+	Symbol   string          `json:"symbol"`
+}
+
+func (s *ChangeLeverage) Symbol(symbol string) *ChangeLeverage {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// Leverage target initial leverage: int from 1 to 125
+func (s *ChangeLeverage) Leverage(leverage int) *ChangeLeverage {
+	s.r.Set("leverage", leverage)
+	return s
+}
+
+func (s *ChangeLeverage) RecvWindow(recvWindow int64) *ChangeLeverage {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *ChangeLeverage) Do(ctx context.Context) (*ChangeLeverageResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := new(ChangeLeverageResponse)
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// ChangePositionMargin Modify Isolated Position Margin
+type ChangePositionMargin struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *ChangePositionMargin) Symbol(symbol string) *ChangePositionMargin {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *ChangePositionMargin) PositionSide(positionSide core.PositionSideEnum) *ChangePositionMargin {
+	s.r.Set("positionSide", positionSide)
+	return s
+}
+
+func (s *ChangePositionMargin) Amount(amount string) *ChangePositionMargin {
+	s.r.Set("amount", amount)
+	return s
+}
+
+// Type 1: Add position margin，2: Reduce position margin
+func (s *ChangePositionMargin) Type(type_ int) *ChangePositionMargin {
+	s.r.Set("type", type_)
+	return s
+}
+
+func (s *ChangePositionMargin) RecvWindow(recvWindow int64) *ChangePositionMargin {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *ChangePositionMargin) Do(ctx context.Context) (*futures.ChangePositionMarginResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := new(futures.ChangePositionMarginResponse)
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
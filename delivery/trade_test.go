@@ -0,0 +1,104 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/futures"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type tradeTestSuite struct {
+	baseHttpTestSuite
+}
+
+func TestTrade(t *testing.T) {
+	suite.Run(t, new(tradeTestSuite))
+}
+
+func (s *tradeTestSuite) TestCreateOrder() {
+	msg := []byte(`{
+	  "clientOrderId": "testOrder",
+	  "cumQty": "0",
+	  "cumBase": "0",
+	  "executedQty": "0",
+	  "orderId": 22542179,
+	  "avgPrice": "0.0",
+	  "origQty": "10",
+	  "price": "0",
+	  "reduceOnly": false,
+	  "side": "BUY",
+	  "positionSide": "SHORT",
+	  "status": "NEW",
+	  "stopPrice": "9300",
+	  "closePosition": false,
+	  "symbol": "BTCUSD_200925",
+	  "pair": "BTCUSD",
+	  "timeInForce": "GTC",
+	  "type": "TRAILING_STOP_MARKET",
+	  "origType": "TRAILING_STOP_MARKET",
+	  "activatePrice": "9020",
+	  "priceRate": "0.3",
+	  "updateTime": 1566818724722,
+	  "workingType": "CONTRACT_PRICE",
+	  "priceProtect": false,
+	  "priceMatch": "NONE",
+	  "selfTradePreventionMode": "NONE"
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewCreateOrder().Symbol("BTCUSD_200925").Side(core.OrderSideBUY).
+		Type(core.OrderTypeLIMIT).Quantity("10").Price("9000").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *OrderResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(*testResp, *resp)
+}
+
+func (s *tradeTestSuite) TestPlaceBatchOrderLimit() {
+	_, err := s.client.NewPlaceBatchOrder().BatchOrders(make([]futures.OrderReq, 6)).Do(context.Background())
+	s.r().Error(err)
+}
+
+func (s *tradeTestSuite) TestCancelMultipleOrderLimit() {
+	r := s.r()
+	_, err := s.client.NewCancelMultipleOrder().Symbol("BTCUSD_200925").Do(context.Background())
+	r.Error(err)
+	_, err = s.client.NewCancelMultipleOrder().Symbol("BTCUSD_200925").
+		OrderIdList([]int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}).Do(context.Background())
+	r.Error(err)
+}
+
+func (s *tradeTestSuite) TestPositionRisk() {
+	msg := []byte(`[
+	  {
+		"symbol": "BTCUSD_201225",
+		"positionAmt": "1",
+		"entryPrice": "11707.70000003",
+		"breakEvenPrice": "11707.80000005",
+		"markPrice": "11788.66626667",
+		"unRealizedProfit": "0.00005866",
+		"liquidationPrice": "6170.20509059",
+		"leverage": "125",
+		"maxQty": "50",
+		"marginType": "cross",
+		"isolatedMargin": "0.00000000",
+		"isAutoAddMargin": "false",
+		"positionSide": "BOTH",
+		"notionalValue": "0.00848281",
+		"isolatedWallet": "0",
+		"updateTime": 1627026881327
+	  }
+	]`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewPositionRisk().Pair("BTCUSD").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp []*PositionRiskResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Len(resp, 1)
+	r.Equal(*testResp[0], *resp[0])
+}
@@ -0,0 +1,13 @@
+package delivery
+
+import (
+	"github.com/jekaxv/go-binance/core"
+)
+
+type WsClient struct {
+	*core.WsClient
+}
+
+func (c *WsClient) NewWebsocketStreams() *WebsocketStreams {
+	return &WebsocketStreams{c: c}
+}
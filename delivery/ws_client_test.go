@@ -0,0 +1,69 @@
+package delivery
+
+import (
+	"github.com/gorilla/websocket"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+)
+
+type mockedWsClient struct {
+	mock.Mock
+	*WsClient
+}
+
+type baseWsTestSuite struct {
+	suite.Suite
+	client *mockedWsClient
+}
+
+func (s *baseWsTestSuite) mockClient(url string) {
+	s.client.WsClient.Opt.Endpoint = url
+}
+
+func (s *baseWsTestSuite) SetupTest() {
+	s.client = new(mockedWsClient)
+	client := WsClient{
+		&core.WsClient{
+			Opt: &core.Options{
+				ApiKey:    "YOUR_API_KEY",
+				ApiSecret: "YOUR_API_SECRET",
+				Logger:    slog.Default(),
+			},
+		},
+	}
+	s.client.WsClient = &client
+}
+
+func (s *baseWsTestSuite) r() *require.Assertions {
+	return s.Require()
+}
+
+func (s *baseWsTestSuite) mockServer(msg []byte) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool { return true },
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			if err := conn.WriteMessage(websocket.TextMessage, msg); err != nil {
+				return
+			}
+		}
+	}))
+}
+
+func (s *baseWsTestSuite) setup(msg []byte) *httptest.Server {
+	server := s.mockServer(msg)
+	s.mockClient("ws" + server.URL[4:])
+	return server
+}
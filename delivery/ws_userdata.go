@@ -0,0 +1,54 @@
+package delivery
+
+import (
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/futures"
+)
+
+type UserDataStream struct {
+	*core.Stream[*UserDataEvent]
+}
+
+const (
+	listenKeyExpired      = "listenKeyExpired"
+	ACCOUNT_UPDATE        = "ACCOUNT_UPDATE"
+	MARGIN_CALL           = "MARGIN_CALL"
+	ORDER_TRADE_UPDATE    = "ORDER_TRADE_UPDATE"
+	ACCOUNT_CONFIG_UPDATE = "ACCOUNT_CONFIG_UPDATE"
+)
+
+// UserDataEvent COIN-M user data events share their payload schemas with USDⓈ-M futures.
+type UserDataEvent struct {
+	Event               futures.UserDataEventType `json:"e"`
+	Time                int64                     `json:"E"`
+	AccountUpdate       futures.AccountUpdate
+	ListenExpired       futures.ListenExpired
+	MarginCall          futures.MarginCall
+	OrderTradeUpdate    futures.OrderTradeUpdate
+	AccountConfigUpdate futures.AccountConfigUpdate
+}
+
+func (s *WebsocketStreams) SubscribeUserData(listenKey string) *UserDataStream {
+	return &UserDataStream{core.NewRawStream(s.c.WsClient, Streams.UserData(listenKey))}
+}
+
+func parseUserEvent(message []byte) (*UserDataEvent, error) {
+	var event *UserDataEvent
+	if err := json.Unmarshal(message, &event); err != nil {
+		return event, err
+	}
+	switch event.Event {
+	case listenKeyExpired:
+		return event, json.Unmarshal(message, &event.ListenExpired)
+	case ACCOUNT_UPDATE:
+		return event, json.Unmarshal(message, &event.AccountUpdate)
+	case MARGIN_CALL:
+		return event, json.Unmarshal(message, &event.MarginCall)
+	case ORDER_TRADE_UPDATE:
+		return event, json.Unmarshal(message, &event.OrderTradeUpdate)
+	case ACCOUNT_CONFIG_UPDATE:
+		return event, json.Unmarshal(message, &event.AccountConfigUpdate)
+	}
+	return event, nil
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+	"time"
+)
+
+func main() {
+	client := binance.NewFixClient(core.Options{
+		Endpoint:  core.FixOrderEntryTestnetUrl,
+		ApiKey:    "YOUR_API_KEY",
+		ApiSecret: "YOUR_ED25519_PRIVATE_KEY_PEM",
+		SignType:  core.SignTypeEd25519,
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+	if err := client.Logon(ctx); err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer client.Logout(context.Background())
+	go func() {
+		for event := range client.Events() {
+			if event.OrderUpdate != nil {
+				fmt.Println(binance.PrettyPrint(event.OrderUpdate))
+			}
+		}
+	}()
+	resp, err := client.NewCreateOrder().Symbol("BTCUSDT").
+		Side(core.OrderSideBUY).Type(core.OrderTypeLIMIT).TimeInForce(core.TimeInForceGTC).
+		Quantity("0.001").Price("20000").
+		Do(ctx)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance.PrettyPrint(resp))
+	canceled, err := client.NewCancelOrder().Symbol("BTCUSDT").OrigClientOrderId(resp.ClientOrderId).Do(ctx)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance.PrettyPrint(canceled))
+}
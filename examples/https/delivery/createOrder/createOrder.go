@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/shopspring/decimal"
+	"log/slog"
+	"os"
+)
+
+func main() {
+	opts := &slog.HandlerOptions{Level: slog.LevelDebug}
+	client := binance.NewDeliveryClient(core.Options{
+		ApiKey:    "YOUR_API_KEY",
+		ApiSecret: "YOUR_API_SECRET",
+		Endpoint:  core.DeliveryTestnetUrl,
+		Logger:    slog.New(slog.NewJSONHandler(os.Stdout, opts)),
+	})
+	ctx := context.Background()
+	info, err := client.NewExchangeInfo().Do(ctx)
+	if err != nil {
+		panic(err)
+	}
+	symbol := info.Symbol("BTCUSD_PERP")
+	if symbol == nil {
+		panic("BTCUSD_PERP is not listed")
+	}
+	price := decimal.NewFromInt(60000)
+	// Quantities are contracts, convert 0.01 BTC into whole contracts.
+	contracts := symbol.Contracts(decimal.RequireFromString("0.01"), price)
+	resp, err := client.NewCreateOrder().Symbol(symbol.Symbol).Side(core.OrderSideBUY).
+		Type(core.OrderTypeLIMIT).TimeInForce(core.TimeInForceGTC).
+		Quantity(contracts.String()).Price(price.String()).Do(ctx)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(binance.PrettyPrint(resp))
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/futures"
+	"github.com/shopspring/decimal"
+)
+
+func main() {
+	client := binance.NewFuturesClient(core.Options{
+		ApiKey:    "YOUR_API_KEY",
+		ApiSecret: "YOUR_API_SECRET",
+	})
+	listenKey, err := client.NewGetListenKey().Do(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	manager := client.NewBracketManager()
+	manager.OnError = func(b *futures.BracketOrder, err error) {
+		fmt.Println("bracket error:", err)
+	}
+	bracket, err := manager.Place(context.Background(), &futures.BracketOrderReq{
+		Symbol:     "BTCUSDT",
+		Side:       core.OrderSideBUY,
+		Type:       core.OrderTypeMARKET,
+		Quantity:   decimal.RequireFromString("0.01"),
+		TakeProfit: decimal.RequireFromString("110000"),
+		StopLoss:   decimal.RequireFromString("90000"),
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(binance.PrettyPrint(bracket))
+
+	wsClient := binance.NewFuturesWsClient()
+	onMessage, onError := wsClient.NewWebsocketStreams().SubscribeUserData(listenKey.ListenKey).Do(context.Background())
+	go func() {
+		for err := range onError {
+			fmt.Println(err)
+		}
+	}()
+	if err := manager.Run(context.Background(), onMessage); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/futures"
+	"os"
+	"os/signal"
+	"time"
+)
+
+func main() {
+	client := binance.NewFuturesClient(core.Options{
+		ApiKey:    "YOUR_API_KEY",
+		ApiSecret: "YOUR_API_SECRET",
+	})
+	hb := client.NewHeartbeat(30*time.Second, "BTCUSDT", "ETHUSDT").
+		OnFailure(func(err *futures.HeartbeatError) {
+			fmt.Println(err)
+		})
+	if err := hb.Start(context.Background()); err != nil {
+		panic(err)
+	}
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt)
+	<-stop
+	if err := hb.Stop(context.Background()); err != nil {
+		fmt.Println(err)
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/futures"
+	"github.com/shopspring/decimal"
+)
+
+func main() {
+	client := binance.NewFuturesClient(core.Options{
+		ApiKey:    "YOUR_API_KEY",
+		ApiSecret: "YOUR_API_SECRET",
+	})
+	ctx := context.Background()
+	account, err := client.NewAccountInfo().Do(ctx)
+	if err != nil {
+		panic(err)
+	}
+	brackets, err := client.NewLeverageBracket().Symbol("BTCUSDT").Do(ctx)
+	if err != nil {
+		panic(err)
+	}
+	config, err := client.NewSymbolConfig().Symbol("BTCUSDT").Do(ctx)
+	if err != nil {
+		panic(err)
+	}
+	positions, err := client.NewPositionRisk().Symbol("BTCUSDT").Do(ctx)
+	if err != nil {
+		panic(err)
+	}
+	calculator := futures.NewMarginCalculator(account.TotalCrossWalletBalance)
+	for _, position := range positions {
+		calculator.SetPosition(futures.NewCalcPosition(position, config[0].Leverage, brackets[0]))
+	}
+	for _, position := range positions {
+		price, err := calculator.LiquidationPrice(position.Symbol, core.PositionSideEnum(position.PositionSide))
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(position.PositionSide, "calculated:", price.StringFixed(2), "binance:", position.LiquidationPrice.StringFixed(2))
+	}
+	template := &futures.CalcPosition{Symbol: "BTCUSDT", PositionSide: core.PositionSide_BOTH, Leverage: config[0].Leverage, Brackets: brackets[0]}
+	price := decimal.RequireFromString("100000")
+	fmt.Println("max buy:", calculator.MaxOpenSize(template, core.OrderSideBUY, price, account.AvailableBalance))
+	estimate, err := calculator.EstimateOrder(template, core.OrderSideBUY, decimal.RequireFromString("0.01"), price)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(binance.PrettyPrint(estimate))
+}
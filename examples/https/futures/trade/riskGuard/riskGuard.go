@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/risk"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+func main() {
+	guard := risk.NewGuard(risk.Limits{
+		MaxNotional:   decimal.NewFromInt(10000),
+		MaxOpenOrders: 20,
+		MaxOrderRate:  10,
+		RateWindow:    time.Second,
+		PriceBand:     decimal.RequireFromString("0.05"),
+	})
+	guard.SetLimits("BTCUSDT", risk.Limits{
+		MaxNotional: decimal.NewFromInt(50000),
+		MaxPosition: decimal.RequireFromString("0.5"),
+		PriceBand:   decimal.RequireFromString("0.02"),
+	})
+	client := binance.NewFuturesClient(core.Options{
+		ApiKey:    "YOUR_API_KEY",
+		ApiSecret: "YOUR_API_SECRET",
+		Guard:     guard,
+	})
+	guard.CancelAll = func(ctx context.Context) error {
+		_, err := client.NewCancelOpenOrder().Symbol("BTCUSDT").Do(ctx)
+		return err
+	}
+	guard.UpdatePrice("BTCUSDT", decimal.NewFromInt(100000))
+	resp, err := client.NewCreateOrder().Symbol("BTCUSDT").
+		Side(core.OrderSideBUY).
+		Type(core.OrderTypeLIMIT).
+		TimeInForce(core.TimeInForceGTC).
+		Quantity("0.01").
+		Price("10000").
+		Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance.PrettyPrint(resp))
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+	"log/slog"
+	"os"
+)
+
+func main() {
+	opts := &slog.HandlerOptions{Level: slog.LevelDebug}
+	client := binance.NewMarginClient(core.Options{
+		ApiKey:    "YOUR_API_KEY",
+		ApiSecret: "YOUR_API_SECRET",
+		Logger:    slog.New(slog.NewJSONHandler(os.Stdout, opts)),
+	})
+	ctx := context.Background()
+	maxBorrow, err := client.NewMaxBorrowable().Asset("USDT").IsolatedSymbol("BTCUSDT").Do(ctx)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(binance.PrettyPrint(maxBorrow))
+	resp, err := client.NewCreateOrder().Symbol("BTCUSDT").IsIsolated(true).Side(core.OrderSideBUY).
+		Type(core.OrderTypeLIMIT).TimeInForce(core.TimeInForceGTC).Quantity("0.001").Price("50000").
+		SideEffectType(core.SideEffectTypeMARGIN_BUY).Do(ctx)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(binance.PrettyPrint(resp))
+}
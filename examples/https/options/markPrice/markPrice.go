@@ -0,0 +1,22 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+	"log/slog"
+	"os"
+)
+
+func main() {
+	opts := &slog.HandlerOptions{Level: slog.LevelDebug}
+	client := binance.NewOptionsClient(core.Options{
+		Logger: slog.New(slog.NewJSONHandler(os.Stdout, opts)),
+	})
+	resp, err := client.NewMarkPrice().Symbol("BTC-250328-90000-C").Do(context.Background())
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(binance.PrettyPrint(resp))
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+	"log/slog"
+	"os"
+)
+
+func main() {
+	opts := &slog.HandlerOptions{Level: slog.LevelDebug}
+	client := binance.NewPortfolioClient(core.Options{
+		ApiKey:    "YOUR_API_KEY",
+		ApiSecret: "YOUR_API_SECRET",
+		Logger:    slog.New(slog.NewJSONHandler(os.Stdout, opts)),
+	})
+	ctx := context.Background()
+	account, err := client.NewAccountInfo().Do(ctx)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(binance.PrettyPrint(account))
+	resp, err := client.NewUmCreateOrder().Symbol("BTCUSDT").Side(core.OrderSideBUY).
+		Type(core.OrderTypeLIMIT).TimeInForce(core.TimeInForceGTC).Quantity("0.001").Price("50000").Do(ctx)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(binance.PrettyPrint(resp))
+}
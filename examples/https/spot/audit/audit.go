@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+)
+
+func main() {
+	audit, err := core.OpenJsonlAuditFile("audit.jsonl")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer audit.Close()
+	client := binance.NewClient(core.Options{
+		Endpoint:  core.TestnetURL,
+		ApiKey:    "YOUR_API_KEY",
+		ApiSecret: "YOUR_API_SECRET",
+		Audit:     audit,
+	})
+	resp, err := client.NewCreateOrder().Symbol("BTCUSDT").Side(core.OrderSideBUY).Type(core.OrderTypeMARKET).
+		Quantity("0.001").NewClientOrderId("grid-1").Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance.PrettyPrint(resp))
+}
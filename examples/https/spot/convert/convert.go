@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/spot"
+	"log/slog"
+	"os"
+)
+
+func main() {
+	opts := &slog.HandlerOptions{Level: slog.LevelDebug}
+	client := binance.NewClient(core.Options{
+		ApiKey:    "YOUR_API_KEY",
+		ApiSecret: "YOUR_API_SECRET",
+		Logger:    slog.New(slog.NewJSONHandler(os.Stdout, opts)),
+	})
+	ctx := context.Background()
+	quote, err := client.NewGetQuote().FromAsset("USDT").ToAsset("BNB").FromAmount("20").ValidTime("30s").Do(ctx)
+	if err != nil {
+		panic(err)
+	}
+	accepted, err := client.NewAcceptQuote().Quote(quote).Do(ctx)
+	if errors.Is(err, spot.ErrQuoteExpired) {
+		fmt.Println("quote expired, request a new one")
+		return
+	}
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(binance.PrettyPrint(accepted))
+	products, err := client.NewFlexibleProductList().Asset("USDT").Do(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if len(products.Rows) == 0 {
+		return
+	}
+	subscribed, err := client.NewSubscribeFlexible().ProductId(products.Rows[0].ProductId).Amount("100").Do(ctx)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(binance.PrettyPrint(subscribed))
+}
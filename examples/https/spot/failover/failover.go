@@ -0,0 +1,23 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+	"net/http"
+)
+
+func main() {
+	failover := core.NewSpotFailover().Selection(core.SelectLatency)
+	failover.Probe(context.Background(), http.DefaultClient, "/api/v3/ping")
+	fmt.Println("endpoints by latency:", failover.Endpoints())
+
+	client := binance.NewClient(core.Options{Failover: failover})
+	resp, err := client.NewServerTime().Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance.PrettyPrint(resp))
+}
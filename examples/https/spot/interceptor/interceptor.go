@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+	"log/slog"
+	"net/http"
+	"sync/atomic"
+)
+
+// audit Logs every order request with its outcome.
+func audit(ctx context.Context, r *core.Request, next core.Invoker) (*core.Response, error) {
+	resp, err := next(ctx, r)
+	if r.Path() == "/api/v3/order" {
+		slog.Info("order", "method", r.Method(), "symbol", r.Params()["symbol"], "error", err)
+	}
+	return resp, err
+}
+
+// tag Prefixes the client order ids of a strategy.
+func tag(strategy string) core.Interceptor {
+	var seq atomic.Int64
+	return func(ctx context.Context, r *core.Request, next core.Invoker) (*core.Response, error) {
+		if r.Path() == "/api/v3/order" && r.Method() == http.MethodPost {
+			r.Set("newClientOrderId", fmt.Sprintf("%s-%d", strategy, seq.Add(1)))
+		}
+		return next(ctx, r)
+	}
+}
+
+// breaker Rejects requests without sending them once failures requests in a row got no response or a server error.
+func breaker(failures int64) core.Interceptor {
+	errOpen := errors.New("circuit open")
+	var failed atomic.Int64
+	return func(ctx context.Context, r *core.Request, next core.Invoker) (*core.Response, error) {
+		if failed.Load() >= failures {
+			return nil, errOpen
+		}
+		resp, err := next(ctx, r)
+		if resp == nil || resp.Status >= http.StatusInternalServerError {
+			failed.Add(1)
+		} else {
+			failed.Store(0)
+		}
+		return resp, err
+	}
+}
+
+func main() {
+	client := binance.NewClient(core.Options{
+		Endpoint:     core.TestnetURL,
+		ApiKey:       "YOUR_API_KEY",
+		ApiSecret:    "YOUR_API_SECRET",
+		Interceptors: []core.Interceptor{audit, breaker(5), tag("grid")},
+	})
+	resp, err := client.NewCreateOrder().Symbol("BTCUSDT").Side(core.OrderSideBUY).Type(core.OrderTypeMARKET).Quantity("0.001").Do(context.Background())
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance.PrettyPrint(resp))
+}
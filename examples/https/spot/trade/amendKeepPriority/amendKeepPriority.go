@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+)
+
+func main() {
+	client := binance.NewClient(core.Options{
+		Endpoint:  core.TestnetURL,
+		ApiKey:    "YOUR_API_KEY",
+		ApiSecret: "YOUR_API_SECRET",
+	})
+	ctx := context.Background()
+	resp, err := client.NewOrderAmendKeepPriority().Symbol("BTCUSDT").
+		OrderId(12345).NewQty("0.0005").
+		Do(ctx)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance.PrettyPrint(resp))
+	amendments, err := client.NewOrderAmendments().Symbol("BTCUSDT").OrderId(12345).Do(ctx)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(binance.PrettyPrint(amendments))
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+	"time"
+)
+
+func main() {
+	client := binance.NewClient(core.Options{
+		ApiKey:    "YOUR_API_KEY",
+		ApiSecret: "YOUR_API_SECRET",
+	})
+	wd := client.NewWatchdog(10*time.Second, "BTCUSDT").
+		OnTrigger(func(symbol string, err error) {
+			fmt.Println("canceled open orders of", symbol, err)
+		})
+	if err := wd.Start(context.Background()); err != nil {
+		panic(err)
+	}
+	defer wd.Stop()
+	for i := 0; i < 10; i++ {
+		// call Beat from the strategy loop while it is healthy
+		wd.Beat()
+		time.Sleep(time.Second)
+	}
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+	"log/slog"
+	"os"
+)
+
+func main() {
+	opts := &slog.HandlerOptions{Level: slog.LevelDebug}
+	client := binance.NewSubAccountClient(core.Options{
+		ApiKey:    "YOUR_MASTER_API_KEY",
+		ApiSecret: "YOUR_MASTER_API_SECRET",
+		Logger:    slog.New(slog.NewJSONHandler(os.Stdout, opts)),
+	})
+	ctx := context.Background()
+	list, err := client.NewSubAccountList().Do(ctx)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(binance.PrettyPrint(list))
+	resp, err := client.NewUniversalTransfer().ToEmail("strategy-1@example.com").
+		FromAccountType(core.AccountTypeSPOT).ToAccountType(core.AccountTypeUSDT_FUTURE).
+		Asset("USDT").Amount("100").Do(ctx)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(binance.PrettyPrint(resp))
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+	"log/slog"
+	"os"
+	"time"
+)
+
+func main() {
+	opts := &slog.HandlerOptions{Level: slog.LevelDebug}
+	client := binance.NewWalletClient(core.Options{
+		ApiKey:    "YOUR_API_KEY",
+		ApiSecret: "YOUR_API_SECRET",
+		Logger:    slog.New(slog.NewJSONHandler(os.Stdout, opts)),
+	})
+	ctx := context.Background()
+	restrictions, err := client.NewApiRestrictions().Do(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if !restrictions.CanTrade(time.Now().UnixMilli()) {
+		panic("api key can not trade")
+	}
+	resp, err := client.NewUniversalTransfer().Type(core.UniversalTransferMAIN_UMFUTURE).
+		Asset("USDT").Amount("100").Do(ctx)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(binance.PrettyPrint(resp))
+}
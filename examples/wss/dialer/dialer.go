@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+func main() {
+	proxy, err := url.Parse("socks5://127.0.0.1:1080")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	client := binance.NewFuturesWsClient(core.Options{
+		Dialer: &core.DialerConfig{
+			Proxy:             http.ProxyURL(proxy),
+			HandshakeTimeout:  10 * time.Second,
+			EnableCompression: true,
+			ReadBufferSize:    1 << 16,
+			ReadLimit:         1 << 22,
+		},
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	onMessage, onError := client.NewWebsocketStreams().SubscribeDepth("BTCUSDT", "100ms").Do(ctx)
+	for {
+		select {
+		case event, ok := <-onMessage:
+			if !ok {
+				return
+			}
+			fmt.Println(event.Symbol, len(event.Bids), len(event.Asks))
+		case err, ok := <-onError:
+			if ok {
+				fmt.Println(err)
+			}
+		}
+	}
+}
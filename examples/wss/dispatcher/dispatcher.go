@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/spot"
+	"time"
+)
+
+func main() {
+	client := binance.NewWsClient()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFunc()
+	// One combined connection mixing a diff depth stream, aggregate trades and a listenKey.
+	dispatcher := core.NewDispatcher(client.WsClient, spot.Streams.AggTrade("ETHUSDT"), spot.Streams.AggTrade("BNBUSDT"))
+	core.Handle(dispatcher, spot.Streams.Depth("BTCUSDT", 100*time.Millisecond), func(e *spot.DepthEvent) {
+		fmt.Println("depth", e.Symbol, e.FinalId)
+	})
+	core.Handle(dispatcher, spot.Streams.UserData("YOUR_LISTEN_KEY"), func(e *spot.UserDataEvent) {
+		fmt.Println(binance.PrettyPrint(e))
+	})
+	core.On(dispatcher, func(stream string, e *spot.AggTradeEvent) {
+		fmt.Println(stream, e.Price, e.Quantity)
+	})
+	if err := dispatcher.Run(ctx); err != nil {
+		fmt.Println(err)
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/futures"
+	"time"
+)
+
+func main() {
+	client := binance.NewFuturesWsClient()
+	stream := client.NewWebsocketStreams().SubscribeBestBookTicker().
+		Conflate(func(event *futures.BookTickerEvent) string {
+			return event.Symbol
+		})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	onMessage, onError := stream.Do(ctx)
+	for {
+		select {
+		case event, ok := <-onMessage:
+			if !ok {
+				fmt.Println("conflated:", stream.Conflated())
+				return
+			}
+			fmt.Println(event.Symbol, event.BestBidPrice, event.BestAskPrice)
+			time.Sleep(50 * time.Millisecond)
+		case err, ok := <-onError:
+			if ok {
+				fmt.Println(err)
+			}
+		}
+	}
+}
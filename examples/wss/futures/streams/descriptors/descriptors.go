@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/futures"
+	"time"
+)
+
+func main() {
+	client := binance.NewFuturesWsClient()
+	ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFunc()
+	// Each subscription opens its own connection, the client can be reused for any number of them.
+	onMarkPrice, onMarkPriceError := core.Subscribe(ctx, client.WsClient, futures.Streams.MarkPrice("BTCUSDT", 3*time.Second))
+	onTrade, onTradeError := core.SubscribeCombined(ctx, client.WsClient, futures.Streams.AggTrade("BTCUSDT"), futures.Streams.AggTrade("ETHUSDT"))
+	for {
+		select {
+		case event := <-onMarkPrice:
+			fmt.Println(binance.PrettyPrint(event))
+		case event := <-onTrade:
+			fmt.Println(event.Stream, binance.PrettyPrint(event.Data))
+		case err := <-onMarkPriceError:
+			fmt.Println(err)
+			return
+		case err := <-onTradeError:
+			fmt.Println(err)
+			return
+		case <-ctx.Done():
+			fmt.Println("Timeout")
+			return
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+	"time"
+)
+
+func main() {
+	ctx, cancelFunc := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancelFunc()
+	opt := core.Options{
+		ApiKey:    "YOUR_API_KEY",
+		ApiSecret: "YOUR_API_SECRET",
+	}
+	api := binance.NewFuturesWsApiClient(opt)
+	started, err := api.NewStartUserDataStream().Do(ctx)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	if started.Error != nil {
+		fmt.Printf("Error: %v\n", started.Error.Msg)
+		return
+	}
+	stream := binance.NewFuturesWsClient(opt)
+	onMessage, onError := stream.NewWebsocketStreams().SubscribeUserData(started.Result.ListenKey).Do(ctx)
+	for {
+		select {
+		case event := <-onMessage:
+			fmt.Printf("Received event: %+v\n", event)
+		case err := <-onError:
+			fmt.Printf("Error: %v\n", err)
+			return
+		case <-ctx.Done():
+			if _, err := api.NewStopUserDataStream().Do(context.Background()); err != nil {
+				fmt.Printf("Error: %v\n", err)
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/core"
+	"sync"
+	"time"
+)
+
+// logMetrics A core.Metrics printing calls and keeping per-stream counters,
+// bind the same methods to Prometheus or OpenTelemetry instruments in production.
+type logMetrics struct {
+	mu       sync.Mutex
+	messages map[string]int
+	lag      map[string]time.Duration
+}
+
+func (m *logMetrics) StartCall(ctx context.Context, call *core.Call) (context.Context, func(*core.CallResult)) {
+	return ctx, func(result *core.CallResult) {
+		fmt.Println(call.Kind, call.Endpoint, result.Status, result.Code, result.Duration, result.Err)
+	}
+}
+
+func (m *logMetrics) StreamConnected(stream string, reconnect bool) {
+	fmt.Println("connected", stream, "reconnect:", reconnect)
+}
+
+func (m *logMetrics) StreamMessage(stream string, lag time.Duration, queued int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages[stream]++
+	m.lag[stream] = lag
+}
+
+func main() {
+	metrics := &logMetrics{messages: make(map[string]int), lag: make(map[string]time.Duration)}
+	client := binance.NewWsClient(core.Options{Metrics: metrics})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stream := client.NewWebsocketStreams().SubscribeAggTrade("BTCUSDT")
+	onMessage, _ := stream.Do(ctx)
+	for range onMessage {
+	}
+	metrics.mu.Lock()
+	defer metrics.mu.Unlock()
+	for name, count := range metrics.messages {
+		fmt.Printf("%s: %.1f msg/s, last lag %s\n", name, float64(count)/10, metrics.lag[name])
+	}
+}
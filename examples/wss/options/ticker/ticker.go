@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"time"
+)
+
+func main() {
+	ctx, cancelFunc := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFunc()
+	client := binance.NewOptionsWsClient()
+	onMessage, onError := client.NewWebsocketStreams().SubscribeTicker("BTC-250328-90000-C").Do(ctx)
+	for {
+		select {
+		case message, ok := <-onMessage:
+			if !ok {
+				return
+			}
+			fmt.Println(binance.PrettyPrint(message))
+		case err, ok := <-onError:
+			if !ok {
+				return
+			}
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/jekaxv/go-binance"
+	"github.com/jekaxv/go-binance/spot"
+	"time"
+)
+
+func main() {
+	client := binance.NewWsClient()
+	stream := client.NewWebsocketStreams().SubscribeBookTicker("BTCUSDT").
+		OnMessage(func(event *spot.BookTickerEvent) {
+			fmt.Println(event.Symbol, event.BestBidPrice, event.BestAskPrice)
+		}).
+		OnError(func(err error) {
+			fmt.Println(err)
+		})
+	if err := stream.Start(context.Background()); err != nil {
+		fmt.Println(err)
+		return
+	}
+	select {
+	case <-stream.Done():
+		fmt.Println("stream ended:", stream.Err())
+	case <-time.After(5 * time.Second):
+		_ = stream.Close()
+		fmt.Println("stream closed:", stream.Wait())
+	}
+}
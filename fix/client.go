@@ -0,0 +1,671 @@
+package fix
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/spot"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	targetCompId      = "SPOT"
+	defaultHeartBtInt = 30
+	resendBufferSize  = 1024
+	eventBufferSize   = 64
+)
+
+var (
+	ErrNotLoggedOn      = errors.New("fix: session is not logged on")
+	ErrSessionClosed    = errors.New("fix: session closed")
+	ErrHeartbeatTimeout = errors.New("fix: no message received within the heartbeat interval")
+	ErrSignType         = errors.New("fix: logon requires an Ed25519 API key (core.SignTypeEd25519)")
+)
+
+// Client A FIX 4.4 session with the Binance spot FIX API.
+// The same client is used for order entry, drop copy and market data, only the endpoint and DropCopy differ.
+// Endpoints use the tcp+tls:// scheme, tcp:// connects without TLS and is meant for local acceptors.
+type Client struct {
+	Opt *core.Options
+	// SenderCompId identifies the session, at most 8 characters. A random one is generated when empty.
+	SenderCompId string
+	// HeartBtInt Heartbeat interval in seconds, defaults to 30.
+	HeartBtInt      int
+	MessageHandling MessageHandlingEnum
+	ResponseMode    ResponseModeEnum
+	// RecvWindow in milliseconds, sent on logon when set.
+	RecvWindow int
+	// DropCopy Logs on as a drop copy session that only receives ExecutionReports.
+	DropCopy  bool
+	TLSConfig *tls.Config
+
+	mu         sync.Mutex
+	conn       net.Conn
+	outSeq     int
+	inSeq      int
+	sent       map[int]*sentMessage
+	pending    map[string]chan *Message
+	missing    map[int]bool
+	logon      chan *Message
+	logout     chan struct{}
+	logoutOnce sync.Once
+	events     chan *Event
+	done       chan struct{}
+	closeOnce  sync.Once
+	err        error
+	lastSent   time.Time
+	lastRecv   time.Time
+	testReqId  string
+	loggingOut bool
+	dropped    atomic.Int64
+}
+
+type sentMessage struct {
+	m    *Message
+	time time.Time
+}
+
+// Event An application message received on the session.
+// OrderUpdate is set for ExecutionReport<8>, ListStatus for ListStatus<N> and MarketData for MarketDataSnapshot<W> and MarketDataIncrementalRefresh<X>.
+type Event struct {
+	MsgType     string
+	OrderUpdate *spot.OrderUpdate
+	ListStatus  *spot.ListStatus
+	MarketData  *MarketData
+	Message     *Message
+}
+
+// RejectError Reject<3>, OrderCancelReject<9>, MarketDataRequestReject<Y> or a rejected ExecutionReport.
+type RejectError struct {
+	MsgType string
+	Code    int64
+	Text    string
+}
+
+func (e *RejectError) Error() string {
+	return fmt.Sprintf("fix: %s rejected: code=%d, text=%s", e.MsgType, e.Code, e.Text)
+}
+
+func newRejectError(m *Message) *RejectError {
+	return &RejectError{MsgType: m.MsgType(), Code: m.Int(TagErrorCode), Text: m.Get(TagText)}
+}
+
+// Logon Connects to the endpoint and performs the Logon<A> handshake, signed with the Ed25519 API key.
+func (c *Client) Logon(ctx context.Context) error {
+	if c.Opt.SignType != core.SignTypeEd25519 {
+		return ErrSignType
+	}
+	if c.SenderCompId == "" {
+		c.SenderCompId = randomId(4)
+	}
+	if c.HeartBtInt <= 0 {
+		c.HeartBtInt = defaultHeartBtInt
+	}
+	if c.MessageHandling == 0 {
+		c.MessageHandling = MessageHandlingUNORDERED
+	}
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return err
+	}
+	c.reset(conn)
+	go c.readLoop(bufio.NewReader(conn))
+
+	m, err := c.logonMessage()
+	if err != nil {
+		c.shutdown(err)
+		return err
+	}
+	if err := c.send(m); err != nil {
+		c.shutdown(err)
+		return err
+	}
+	select {
+	case <-ctx.Done():
+		c.shutdown(ctx.Err())
+		return ctx.Err()
+	case <-c.done:
+		return c.Err()
+	case <-c.logon:
+	}
+	go c.heartbeatLoop()
+	return nil
+}
+
+// Logout Sends Logout<5> and waits for the acceptor to confirm it before closing the connection.
+func (c *Client) Logout(ctx context.Context) error {
+	c.mu.Lock()
+	c.loggingOut = true
+	c.mu.Unlock()
+	if err := c.send(NewMessage(MsgTypeLogout)); err != nil {
+		return err
+	}
+	select {
+	case <-ctx.Done():
+	case <-c.logout:
+	case <-c.done:
+	}
+	c.shutdown(ErrSessionClosed)
+	return nil
+}
+
+// Close Drops the connection without a Logout<5>.
+func (c *Client) Close() error {
+	c.shutdown(ErrSessionClosed)
+	return nil
+}
+
+// Events Application messages received on the session, closed when the session ends.
+// The reader never waits for consumers: events that do not fit in the buffer are dropped and counted by Dropped.
+func (c *Client) Events() <-chan *Event {
+	return c.events
+}
+
+// Dropped The number of events dropped because Events was not drained in time.
+func (c *Client) Dropped() int64 {
+	return c.dropped.Load()
+}
+
+// Done Closed when the session ends, Err then returns the reason.
+func (c *Client) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *Client) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+// Send Sends an application message as is, responses arrive on Events.
+func (c *Client) Send(m *Message) error {
+	return c.send(m)
+}
+
+func (c *Client) dial(ctx context.Context) (net.Conn, error) {
+	endpoint := c.Opt.Endpoint
+	switch {
+	case strings.HasPrefix(endpoint, "tcp+tls://"):
+		addr := strings.TrimPrefix(endpoint, "tcp+tls://")
+		config := c.TLSConfig
+		if config == nil {
+			host, _, _ := net.SplitHostPort(addr)
+			config = &tls.Config{ServerName: host}
+		}
+		d := &tls.Dialer{Config: config}
+		return d.DialContext(ctx, "tcp", addr)
+	case strings.HasPrefix(endpoint, "tcp://"):
+		d := &net.Dialer{}
+		return d.DialContext(ctx, "tcp", strings.TrimPrefix(endpoint, "tcp://"))
+	}
+	return nil, fmt.Errorf("fix: unsupported endpoint %q", endpoint)
+}
+
+func (c *Client) reset(conn net.Conn) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.conn = conn
+	c.outSeq = 0
+	c.inSeq = 0
+	c.sent = make(map[int]*sentMessage)
+	c.pending = make(map[string]chan *Message)
+	c.missing = make(map[int]bool)
+	c.logon = make(chan *Message, 1)
+	c.logout = make(chan struct{})
+	c.logoutOnce = sync.Once{}
+	c.events = make(chan *Event, eventBufferSize)
+	c.done = make(chan struct{})
+	c.closeOnce = sync.Once{}
+	c.err = nil
+	c.loggingOut = false
+	c.lastRecv = time.Now()
+}
+
+// logonMessage Builds Logon<A>. The signature covers MsgType, SenderCompID, TargetCompID, MsgSeqNum and SendingTime joined by SOH,
+// so the sequence number and sending time are fixed here and reused by the header.
+func (c *Client) logonMessage() (*Message, error) {
+	now := time.Now().UTC()
+	payload := strings.Join([]string{MsgTypeLogon, c.SenderCompId, targetCompId, "1", now.Format(timeLayout)}, string(soh))
+	signature, err := core.Ed25519Sign(c.Opt.ApiSecret, payload)
+	if err != nil {
+		return nil, err
+	}
+	m := NewMessage(MsgTypeLogon).
+		Set(TagEncryptMethod, 0).
+		Set(TagHeartBtInt, c.HeartBtInt).
+		Set(TagRawDataLength, len(signature)).
+		Set(TagRawData, signature).
+		Set(TagResetSeqNumFlag, true).
+		Set(TagUsername, c.Opt.ApiKey).
+		Set(TagMessageHandling, int(c.MessageHandling))
+	if c.ResponseMode != 0 {
+		m.Set(TagResponseMode, int(c.ResponseMode))
+	}
+	if c.RecvWindow > 0 {
+		m.Set(TagRecvWindow, c.RecvWindow)
+	}
+	if c.DropCopy {
+		m.Set(TagDropCopyFlag, true)
+	}
+	m.Set(TagSendingTime, now)
+	return m, nil
+}
+
+func (c *Client) send(m *Message) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.conn == nil || c.err != nil {
+		return ErrNotLoggedOn
+	}
+	_, err := c.write(m)
+	return err
+}
+
+// write Assigns the next MsgSeqNum, keeps application messages for resend and writes to the connection. c.mu must be held.
+func (c *Client) write(m *Message) (int, error) {
+	c.outSeq++
+	seq := c.outSeq
+	now := time.Now().UTC()
+	if m.Has(TagSendingTime) {
+		t, _ := time.Parse(timeLayout, m.Get(TagSendingTime))
+		now = t
+		m = m.clone()
+		m.fields = removeTag(m.fields, TagSendingTime)
+	}
+	if !isAdmin(m.MsgType()) {
+		c.sent[seq] = &sentMessage{m: m, time: now}
+		delete(c.sent, seq-resendBufferSize)
+	}
+	if err := c.writeRaw(m, seq, now); err != nil {
+		return seq, err
+	}
+	return seq, nil
+}
+
+func (c *Client) writeRaw(m *Message, seq int, now time.Time, extra ...Field) error {
+	header := append([]Field{
+		{Tag: TagSenderCompID, Value: c.SenderCompId},
+		{Tag: TagTargetCompID, Value: targetCompId},
+		{Tag: TagMsgSeqNum, Value: strconv.Itoa(seq)},
+		{Tag: TagSendingTime, Value: now.Format(timeLayout)},
+	}, extra...)
+	c.Opt.Logger.Debug("fix send", "message", m.String(), "seq", seq)
+	c.lastSent = time.Now()
+	_, err := c.conn.Write(m.encode(header...))
+	return err
+}
+
+// request Sends m and waits for the first response correlated by one of keys or by RefSeqNum<45>.
+func (c *Client) request(ctx context.Context, m *Message, keys ...string) (*Message, error) {
+	ch := make(chan *Message, 1)
+	c.mu.Lock()
+	if c.conn == nil || c.err != nil {
+		c.mu.Unlock()
+		return nil, ErrNotLoggedOn
+	}
+	seqKey := "seq:" + strconv.Itoa(c.outSeq+1)
+	keys = append(keys, seqKey)
+	for _, key := range keys {
+		if !strings.HasSuffix(key, ":") {
+			c.pending[key] = ch
+		}
+	}
+	_, err := c.write(m)
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		for _, key := range keys {
+			delete(c.pending, key)
+		}
+		c.mu.Unlock()
+	}()
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-c.done:
+		return nil, c.Err()
+	case resp := <-ch:
+		return resp, nil
+	}
+}
+
+// order Sends an order entry message with request once Options.Guard accepts it, and records it in Options.Audit.
+func (c *Client) order(ctx context.Context, m *Message, keys ...string) (*Message, error) {
+	start := time.Now()
+	var resp *Message
+	var err error
+	if c.Opt.Guard != nil {
+		if err = c.Opt.Guard.Check(m.intent()); err != nil {
+			c.Opt.Logger.Debug("fix order rejected by guard", "msg_type", m.MsgType(), "error", err)
+		}
+	}
+	if err == nil {
+		resp, err = c.request(ctx, m, keys...)
+	}
+	if tracker, ok := c.Opt.Guard.(core.OrderTracker); ok && err == nil && accepted(resp) {
+		tracker.Accepted(m.intent())
+	}
+	if c.Opt.Audit != nil {
+		c.audit(ctx, m, resp, err, start)
+	}
+	return resp, err
+}
+
+// accepted Whether resp acknowledges an order entry message rather than rejecting it.
+func accepted(resp *Message) bool {
+	switch resp.MsgType() {
+	case MsgTypeExecutionReport:
+		return resp.Get(TagExecType) != "8"
+	case MsgTypeListStatus:
+		return resp.Get(TagListOrderStatus) != "7"
+	}
+	return false
+}
+
+func (c *Client) audit(ctx context.Context, m, resp *Message, err error, start time.Time) {
+	record := &core.AuditRecord{Time: start, Kind: core.CallFix, Endpoint: m.MsgType(), Params: m.params()}
+	record.ClientOrderId = m.Get(TagClOrdID)
+	if record.ClientOrderId == "" {
+		record.ClientOrderId = m.Get(TagClListID)
+	}
+	if resp != nil {
+		record.Code = int(resp.Int(TagErrorCode))
+		record.Response, _ = json.Marshal(resp.String())
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	record.Duration = time.Since(start)
+	if auditErr := c.Opt.Audit.Audit(ctx, record); auditErr != nil {
+		c.Opt.Logger.Error("audit record failed", "endpoint", record.Endpoint, "error", auditErr)
+	}
+}
+
+func (c *Client) readLoop(r *bufio.Reader) {
+	defer close(c.events)
+	for {
+		m, err := readMessage(r)
+		if err != nil {
+			c.shutdown(err)
+			return
+		}
+		c.Opt.Logger.Debug("fix receive", "message", m.String())
+		if err := c.handle(m); err != nil {
+			c.shutdown(err)
+			return
+		}
+	}
+}
+
+func (c *Client) handle(m *Message) error {
+	c.mu.Lock()
+	c.lastRecv = time.Now()
+	c.testReqId = ""
+	process, err := c.checkSeq(m)
+	c.mu.Unlock()
+	if err != nil || !process {
+		return err
+	}
+	switch m.MsgType() {
+	case MsgTypeLogon:
+		c.logon <- m
+	case MsgTypeHeartbeat:
+	case MsgTypeTestRequest:
+		return c.send(NewMessage(MsgTypeHeartbeat).Set(TagTestReqID, m.Get(TagTestReqID)))
+	case MsgTypeResendRequest:
+		return c.resend(int(m.Int(TagBeginSeqNo)), int(m.Int(TagEndSeqNo)))
+	case MsgTypeLogout:
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if !c.loggingOut {
+			c.loggingOut = true
+			if _, err := c.write(NewMessage(MsgTypeLogout)); err != nil {
+				return err
+			}
+		}
+		c.logoutOnce.Do(func() { close(c.logout) })
+		if text := m.Get(TagText); text != "" {
+			return fmt.Errorf("%w: %s", ErrSessionClosed, text)
+		}
+		return ErrSessionClosed
+	case MsgTypeReject:
+		c.deliver(m, "seq:"+m.Get(TagRefSeqNum))
+	case MsgTypeExecutionReport, MsgTypeOrderCancelReject:
+		c.deliver(m, "ord:"+m.Get(TagClOrdID), "ord:"+m.Get(TagOrigClOrdID), "list:"+m.Get(TagClListID))
+		c.emit(m)
+	case MsgTypeListStatus:
+		c.deliver(m, "list:"+m.Get(TagClListID))
+		c.emit(m)
+	default:
+		c.emit(m)
+	}
+	return nil
+}
+
+// checkSeq Validates MsgSeqNum<34>. A gap triggers a ResendRequest<2>, the message itself is still processed
+// and the resent messages are accepted as PossDup. c.mu must be held.
+func (c *Client) checkSeq(m *Message) (bool, error) {
+	seq := int(m.Int(TagMsgSeqNum))
+	expected := c.inSeq + 1
+	if m.MsgType() == MsgTypeSequenceReset {
+		newSeq := int(m.Int(TagNewSeqNo))
+		for i := seq; i < newSeq; i++ {
+			delete(c.missing, i)
+		}
+		if newSeq > expected {
+			c.inSeq = newSeq - 1
+		}
+		return false, nil
+	}
+	switch {
+	case seq == expected:
+		c.inSeq = seq
+	case seq > expected:
+		for i := expected; i < seq; i++ {
+			c.missing[i] = true
+		}
+		c.inSeq = seq
+		resend := NewMessage(MsgTypeResendRequest).Set(TagBeginSeqNo, expected).Set(TagEndSeqNo, seq-1)
+		if _, err := c.write(resend); err != nil {
+			return false, err
+		}
+	case m.Get(TagPossDupFlag) == "Y":
+		if !c.missing[seq] {
+			return false, nil
+		}
+		delete(c.missing, seq)
+	default:
+		return false, fmt.Errorf("fix: MsgSeqNum too low, expected %d got %d", expected, seq)
+	}
+	return true, nil
+}
+
+// resend Answers a ResendRequest<2>, application messages are sent again with PossDupFlag<43>,
+// administrative ones and those no longer kept are skipped with a gap fill SequenceReset<4>.
+func (c *Client) resend(begin, end int) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if end == 0 || end > c.outSeq {
+		end = c.outSeq
+	}
+	gapStart := 0
+	flush := func(next int) error {
+		if gapStart == 0 {
+			return nil
+		}
+		reset := NewMessage(MsgTypeSequenceReset).Set(TagGapFillFlag, true).Set(TagNewSeqNo, next)
+		err := c.writeRaw(reset, gapStart, time.Now().UTC(), Field{Tag: TagPossDupFlag, Value: "Y"})
+		gapStart = 0
+		return err
+	}
+	for seq := begin; seq <= end; seq++ {
+		s, ok := c.sent[seq]
+		if !ok {
+			if gapStart == 0 {
+				gapStart = seq
+			}
+			continue
+		}
+		if err := flush(seq); err != nil {
+			return err
+		}
+		err := c.writeRaw(s.m, seq, time.Now().UTC(),
+			Field{Tag: TagPossDupFlag, Value: "Y"},
+			Field{Tag: TagOrigSendingTime, Value: s.time.Format(timeLayout)})
+		if err != nil {
+			return err
+		}
+	}
+	return flush(end + 1)
+}
+
+func (c *Client) deliver(m *Message, keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		if ch, ok := c.pending[key]; ok {
+			select {
+			case ch <- m:
+			default:
+			}
+			return
+		}
+	}
+}
+
+// emit Queues m on Events. A message that cannot be decoded is logged and still delivered as the raw Message.
+func (c *Client) emit(m *Message) {
+	event := &Event{MsgType: m.MsgType(), Message: m}
+	switch m.MsgType() {
+	case MsgTypeExecutionReport:
+		update, err := NewOrderUpdate(m)
+		if err != nil {
+			c.Opt.Logger.Error("fix decode failed", "msg_type", event.MsgType, "message", m.String(), "error", err)
+		} else {
+			event.OrderUpdate = update
+		}
+	case MsgTypeListStatus:
+		event.ListStatus = NewListStatus(m)
+	case MsgTypeMarketDataSnapshot, MsgTypeMarketDataIncrementalRefresh:
+		event.MarketData = NewMarketData(m)
+	}
+	select {
+	case c.events <- event:
+	default:
+		dropped := c.dropped.Add(1)
+		c.Opt.Logger.Warn("fix event dropped", "msg_type", event.MsgType, "dropped", dropped)
+	}
+}
+
+// heartbeatLoop Sends Heartbeat<0> when idle and a TestRequest<1> when the acceptor went quiet,
+// the session is dropped if the TestRequest is not answered within another interval.
+func (c *Client) heartbeatLoop() {
+	interval := time.Duration(c.HeartBtInt) * time.Second
+	ticker := time.NewTicker(interval / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+			c.mu.Lock()
+			idle := time.Since(c.lastSent)
+			quiet := time.Since(c.lastRecv)
+			var err error
+			switch {
+			case c.testReqId != "" && quiet >= 2*interval:
+				err = ErrHeartbeatTimeout
+			case c.testReqId == "" && quiet >= interval+interval/5:
+				c.testReqId = randomId(8)
+				_, err = c.write(NewMessage(MsgTypeTestRequest).Set(TagTestReqID, c.testReqId))
+			case idle >= interval:
+				_, err = c.write(NewMessage(MsgTypeHeartbeat))
+			}
+			c.mu.Unlock()
+			if err != nil {
+				c.shutdown(err)
+				return
+			}
+		}
+	}
+}
+
+func (c *Client) shutdown(err error) {
+	c.closeOnce.Do(func() {
+		c.mu.Lock()
+		if c.done == nil {
+			c.mu.Unlock()
+			return
+		}
+		c.err = err
+		conn := c.conn
+		c.mu.Unlock()
+		close(c.done)
+		if conn != nil {
+			_ = conn.Close()
+		}
+	})
+}
+
+func isAdmin(msgType string) bool {
+	switch msgType {
+	case MsgTypeHeartbeat, MsgTypeTestRequest, MsgTypeResendRequest, MsgTypeReject, MsgTypeSequenceReset, MsgTypeLogout, MsgTypeLogon:
+		return true
+	}
+	return false
+}
+
+func removeTag(fields []Field, tag int) []Field {
+	out := fields[:0]
+	for _, f := range fields {
+		if f.Tag != tag {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+func randomId(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// NewCreateOrder NewOrderSingle<D> (TRADE)
+func (c *Client) NewCreateOrder() *CreateOrder {
+	return &CreateOrder{c: c, m: NewMessage(MsgTypeNewOrderSingle)}
+}
+
+// NewCancelOrder OrderCancelRequest<F> (TRADE)
+func (c *Client) NewCancelOrder() *CancelOrder {
+	return &CancelOrder{c: c, m: NewMessage(MsgTypeOrderCancelRequest)}
+}
+
+// NewCancelReplace OrderCancelRequestAndNewOrderSingle<XCN> (TRADE)
+func (c *Client) NewCancelReplace() *CancelReplace {
+	return &CancelReplace{c: c, m: NewMessage(MsgTypeOrderCancelRequestAndNew)}
+}
+
+// NewCreateOrderList NewOrderList<E> (TRADE)
+func (c *Client) NewCreateOrderList() *CreateOrderList {
+	return &CreateOrderList{c: c, m: NewMessage(MsgTypeNewOrderList)}
+}
+
+// NewMarketDataRequest MarketDataRequest<V> (MARKET_DATA)
+func (c *Client) NewMarketDataRequest() *MarketDataRequest {
+	return &MarketDataRequest{c: c, m: NewMessage(MsgTypeMarketDataRequest)}
+}
@@ -0,0 +1,318 @@
+package fix
+
+import (
+	"bufio"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"log/slog"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// acceptor A local stand-in for the Binance FIX acceptor. It verifies the logon signature,
+// answers Logon<A> and Logout<5> and hands every other message to the test.
+type acceptor struct {
+	ln       net.Listener
+	pub      ed25519.PublicKey
+	mu       sync.Mutex
+	conn     net.Conn
+	seq      int
+	logon    *Message
+	received chan *Message
+}
+
+func newAcceptor(pub ed25519.PublicKey) (*acceptor, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	a := &acceptor{ln: ln, pub: pub, received: make(chan *Message, 16)}
+	go a.serve()
+	return a, nil
+}
+
+func (a *acceptor) endpoint() string {
+	return "tcp://" + a.ln.Addr().String()
+}
+
+func (a *acceptor) serve() {
+	conn, err := a.ln.Accept()
+	if err != nil {
+		return
+	}
+	a.mu.Lock()
+	a.conn = conn
+	a.mu.Unlock()
+	r := bufio.NewReader(conn)
+	for {
+		m, err := readMessage(r)
+		if err != nil {
+			close(a.received)
+			return
+		}
+		switch m.MsgType() {
+		case MsgTypeLogon:
+			a.logon = m
+			if a.verify(m) {
+				a.send(NewMessage(MsgTypeLogon).Set(TagHeartBtInt, m.Get(TagHeartBtInt)))
+			} else {
+				a.send(NewMessage(MsgTypeLogout).Set(TagText, "Signature is invalid"))
+			}
+		case MsgTypeLogout:
+			a.send(NewMessage(MsgTypeLogout))
+			a.received <- m
+		default:
+			a.received <- m
+		}
+	}
+}
+
+func (a *acceptor) verify(m *Message) bool {
+	payload := strings.Join([]string{m.MsgType(), m.Get(TagSenderCompID), m.Get(TagTargetCompID), m.Get(TagMsgSeqNum), m.Get(TagSendingTime)}, string(soh))
+	signature, err := base64.StdEncoding.DecodeString(m.Get(TagRawData))
+	if err != nil {
+		return false
+	}
+	return ed25519.Verify(a.pub, []byte(payload), signature)
+}
+
+func (a *acceptor) send(m *Message, extra ...Field) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.seq++
+	a.sendSeq(m, a.seq, extra...)
+}
+
+// sendSeq Writes m with an explicit MsgSeqNum, used to simulate gaps and resends. a.mu must be held.
+func (a *acceptor) sendSeq(m *Message, seq int, extra ...Field) {
+	header := append([]Field{
+		{Tag: TagSenderCompID, Value: targetCompId},
+		{Tag: TagTargetCompID, Value: "TEST"},
+		{Tag: TagMsgSeqNum, Value: strconv.Itoa(seq)},
+		{Tag: TagSendingTime, Value: time.Now().UTC().Format(timeLayout)},
+	}, extra...)
+	_, _ = a.conn.Write(m.encode(header...))
+}
+
+// next Returns the next message of msgType, skipping heartbeats.
+func (a *acceptor) next(msgType string) *Message {
+	timeout := time.After(time.Second * 5)
+	for {
+		select {
+		case m, ok := <-a.received:
+			if !ok {
+				return nil
+			}
+			if m.MsgType() == msgType {
+				return m
+			}
+		case <-timeout:
+			return nil
+		}
+	}
+}
+
+func (a *acceptor) close() {
+	_ = a.ln.Close()
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.conn != nil {
+		_ = a.conn.Close()
+	}
+}
+
+type baseFixTestSuite struct {
+	suite.Suite
+	client   *Client
+	acceptor *acceptor
+}
+
+func (s *baseFixTestSuite) SetupTest() {
+	pub, private, err := ed25519.GenerateKey(rand.Reader)
+	s.r().NoError(err)
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	s.r().NoError(err)
+	s.acceptor, err = newAcceptor(pub)
+	s.r().NoError(err)
+	s.client = &Client{
+		Opt: &core.Options{
+			Endpoint:  s.acceptor.endpoint(),
+			ApiKey:    "YOUR_API_KEY",
+			ApiSecret: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+			SignType:  core.SignTypeEd25519,
+			Logger:    slog.Default(),
+		},
+		SenderCompId: "TEST",
+	}
+}
+
+func (s *baseFixTestSuite) TearDownTest() {
+	_ = s.client.Close()
+	s.acceptor.close()
+}
+
+func (s *baseFixTestSuite) r() *require.Assertions {
+	return s.Require()
+}
+
+func (s *baseFixTestSuite) logon() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	s.r().NoError(s.client.Logon(ctx))
+}
+
+// event Returns the next event from the client.
+func (s *baseFixTestSuite) event() *Event {
+	select {
+	case event := <-s.client.Events():
+		return event
+	case <-time.After(time.Second * 5):
+		s.FailNow("no event received")
+	}
+	return nil
+}
+
+type clientTestSuite struct {
+	baseFixTestSuite
+}
+
+func TestClient(t *testing.T) {
+	suite.Run(t, new(clientTestSuite))
+}
+
+func (s *clientTestSuite) TestLogon() {
+	s.client.MessageHandling = MessageHandlingSEQUENCED
+	s.client.RecvWindow = 5000
+	s.logon()
+	r := s.r()
+	logon := s.acceptor.logon
+	r.Equal("1", logon.Get(TagMsgSeqNum), "MsgSeqNum")
+	r.Equal("YOUR_API_KEY", logon.Get(TagUsername), "Username")
+	r.Equal("Y", logon.Get(TagResetSeqNumFlag), "ResetSeqNumFlag")
+	r.Equal("30", logon.Get(TagHeartBtInt), "HeartBtInt")
+	r.Equal("2", logon.Get(TagMessageHandling), "MessageHandling")
+	r.Equal("5000", logon.Get(TagRecvWindow), "RecvWindow")
+	r.False(logon.Has(TagDropCopyFlag), "DropCopyFlag")
+	r.Equal(strconv.Itoa(len(logon.Get(TagRawData))), logon.Get(TagRawDataLength), "RawDataLength")
+}
+
+func (s *clientTestSuite) TestLogonDropCopy() {
+	s.client.DropCopy = true
+	s.logon()
+	s.r().Equal("Y", s.acceptor.logon.Get(TagDropCopyFlag), "DropCopyFlag")
+}
+
+func (s *clientTestSuite) TestLogonInvalidSignature() {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	s.r().NoError(err)
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	s.r().NoError(err)
+	s.client.Opt.ApiSecret = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	err = s.client.Logon(context.Background())
+	s.r().ErrorIs(err, ErrSessionClosed)
+	s.r().ErrorContains(err, "Signature is invalid")
+}
+
+func (s *clientTestSuite) TestLogonRequiresEd25519() {
+	s.client.Opt.SignType = core.SignTypeHmac
+	s.r().ErrorIs(s.client.Logon(context.Background()), ErrSignType)
+}
+
+func (s *clientTestSuite) TestTestRequest() {
+	s.logon()
+	s.acceptor.send(NewMessage(MsgTypeTestRequest).Set(TagTestReqID, "ping-1"))
+	heartbeat := s.acceptor.next(MsgTypeHeartbeat)
+	s.r().NotNil(heartbeat)
+	s.r().Equal("ping-1", heartbeat.Get(TagTestReqID), "TestReqID")
+}
+
+func (s *clientTestSuite) TestResendRequest() {
+	s.logon()
+	r := s.r()
+	r.NoError(s.client.Send(NewMessage(MsgTypeNewOrderSingle).Set(TagClOrdID, "order-1")))
+	r.NotNil(s.acceptor.next(MsgTypeNewOrderSingle))
+	s.acceptor.send(NewMessage(MsgTypeResendRequest).Set(TagBeginSeqNo, 1).Set(TagEndSeqNo, 0))
+
+	reset := s.acceptor.next(MsgTypeSequenceReset)
+	r.NotNil(reset)
+	r.Equal("1", reset.Get(TagMsgSeqNum), "MsgSeqNum")
+	r.Equal("2", reset.Get(TagNewSeqNo), "NewSeqNo")
+	r.Equal("Y", reset.Get(TagGapFillFlag), "GapFillFlag")
+
+	order := s.acceptor.next(MsgTypeNewOrderSingle)
+	r.NotNil(order)
+	r.Equal("2", order.Get(TagMsgSeqNum), "MsgSeqNum")
+	r.Equal("Y", order.Get(TagPossDupFlag), "PossDupFlag")
+	r.Equal("order-1", order.Get(TagClOrdID), "ClOrdID")
+	r.True(order.Has(TagOrigSendingTime), "OrigSendingTime")
+}
+
+func (s *clientTestSuite) TestSequenceGap() {
+	s.logon()
+	r := s.r()
+	s.acceptor.mu.Lock()
+	s.acceptor.seq = 4
+	s.acceptor.sendSeq(NewMessage(MsgTypeNews).Set(TagHeadline, "maintenance"), 4)
+	s.acceptor.mu.Unlock()
+
+	resend := s.acceptor.next(MsgTypeResendRequest)
+	r.NotNil(resend)
+	r.Equal("2", resend.Get(TagBeginSeqNo), "BeginSeqNo")
+	r.Equal("3", resend.Get(TagEndSeqNo), "EndSeqNo")
+	r.Equal("maintenance", s.event().Message.Get(TagHeadline))
+
+	s.acceptor.mu.Lock()
+	s.acceptor.sendSeq(NewMessage(MsgTypeNews).Set(TagHeadline, "resent"), 2, Field{Tag: TagPossDupFlag, Value: "Y"})
+	s.acceptor.sendSeq(NewMessage(MsgTypeNews).Set(TagHeadline, "duplicate"), 4, Field{Tag: TagPossDupFlag, Value: "Y"})
+	s.acceptor.sendSeq(NewMessage(MsgTypeSequenceReset).Set(TagNewSeqNo, 5), 3)
+	s.acceptor.seq = 4
+	s.acceptor.mu.Unlock()
+	s.acceptor.send(NewMessage(MsgTypeNews).Set(TagHeadline, "next"))
+
+	r.Equal("resent", s.event().Message.Get(TagHeadline))
+	r.Equal("next", s.event().Message.Get(TagHeadline))
+}
+
+func (s *clientTestSuite) TestSequenceTooLow() {
+	s.logon()
+	s.acceptor.mu.Lock()
+	s.acceptor.sendSeq(NewMessage(MsgTypeNews), 1)
+	s.acceptor.mu.Unlock()
+	select {
+	case <-s.client.Done():
+		s.r().ErrorContains(s.client.Err(), "MsgSeqNum too low")
+	case <-time.After(time.Second * 5):
+		s.FailNow("session not closed")
+	}
+}
+
+func (s *clientTestSuite) TestLogout() {
+	s.logon()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	s.r().NoError(s.client.Logout(ctx))
+	s.r().NotNil(s.acceptor.next(MsgTypeLogout))
+	s.r().ErrorIs(s.client.Err(), ErrSessionClosed)
+	_, ok := <-s.client.Events()
+	s.r().False(ok, "events closed")
+}
+
+func (s *clientTestSuite) TestRepeatedLogout() {
+	s.logon()
+	r := s.r()
+	r.NotPanics(func() {
+		r.ErrorIs(s.client.handle(NewMessage(MsgTypeLogout).Set(TagMsgSeqNum, 2)), ErrSessionClosed)
+		r.ErrorIs(s.client.handle(NewMessage(MsgTypeLogout).Set(TagMsgSeqNum, 3)), ErrSessionClosed)
+	})
+}
@@ -0,0 +1,260 @@
+package fix
+
+import "github.com/jekaxv/go-binance/core"
+
+// Standard FIX 4.4 tags and the Binance specific ones used by the spot FIX API.
+const (
+	TagAvgPx                    = 6
+	TagBeginSeqNo               = 7
+	TagBeginString              = 8
+	TagBodyLength               = 9
+	TagCheckSum                 = 10
+	TagClOrdID                  = 11
+	TagCumQty                   = 14
+	TagEndSeqNo                 = 16
+	TagExecID                   = 17
+	TagExecInst                 = 18
+	TagLastPx                   = 31
+	TagLastQty                  = 32
+	TagMsgSeqNum                = 34
+	TagMsgType                  = 35
+	TagNewSeqNo                 = 36
+	TagOrderID                  = 37
+	TagOrderQty                 = 38
+	TagOrdStatus                = 39
+	TagOrdType                  = 40
+	TagOrigClOrdID              = 41
+	TagPossDupFlag              = 43
+	TagPrice                    = 44
+	TagRefSeqNum                = 45
+	TagSenderCompID             = 49
+	TagSendingTime              = 52
+	TagSide                     = 54
+	TagSymbol                   = 55
+	TagTargetCompID             = 56
+	TagText                     = 58
+	TagTimeInForce              = 59
+	TagTransactTime             = 60
+	TagListID                   = 66
+	TagNoOrders                 = 73
+	TagRawDataLength            = 95
+	TagRawData                  = 96
+	TagEncryptMethod            = 98
+	TagHeartBtInt               = 108
+	TagMaxFloor                 = 111
+	TagTestReqID                = 112
+	TagOrigSendingTime          = 122
+	TagGapFillFlag              = 123
+	TagNoMiscFees               = 136
+	TagMiscFeeAmt               = 137
+	TagMiscFeeCurr              = 138
+	TagMiscFeeType              = 139
+	TagResetSeqNumFlag          = 141
+	TagNoRelatedSym             = 146
+	TagHeadline                 = 148
+	TagExecType                 = 150
+	TagCashOrderQty             = 152
+	TagMDReqID                  = 262
+	TagSubscriptionRequestType  = 263
+	TagMarketDepth              = 264
+	TagAggregatedBook           = 266
+	TagNoMDEntryTypes           = 267
+	TagNoMDEntries              = 268
+	TagMDEntryType              = 269
+	TagMDEntryPx                = 270
+	TagMDEntrySize              = 271
+	TagMDUpdateAction           = 279
+	TagMDReqRejReason           = 281
+	TagRefTagID                 = 371
+	TagRefMsgType               = 372
+	TagSessionRejectReason      = 373
+	TagListStatusType           = 429
+	TagListOrderStatus          = 431
+	TagUsername                 = 553
+	TagWorkingIndicator         = 636
+	TagTradeID                  = 1003
+	TagAggressorIndicator       = 1057
+	TagTriggerType              = 1100
+	TagTriggerAction            = 1101
+	TagTriggerPrice             = 1102
+	TagTriggerPriceType         = 1107
+	TagTriggerPriceDirection    = 1109
+	TagContingencyType          = 1385
+	TagListRejectReason         = 1386
+	TagAggressorSide            = 2446
+	TagDropCopyFlag             = 9406
+	TagRecvWindow               = 25000
+	TagSelfTradePreventionMode  = 25001
+	TagCancelRestrictions       = 25002
+	TagTriggerTrailingDeltaBips = 25009
+	TagNoListTriggers           = 25010
+	TagListTriggerType          = 25011
+	TagListTriggerTriggerIndex  = 25012
+	TagListTriggerAction        = 25013
+	TagClListID                 = 25014
+	TagOrigClListID             = 25015
+	TagErrorCode                = 25016
+	TagCumQuoteQty              = 25017
+	TagOrderCreationTime        = 25018
+	TagWorkingTime              = 25023
+	TagPreventedMatchID         = 25024
+	TagCancelReplaceMode        = 25033
+	TagCancelClOrdID            = 25034
+	TagMessageHandling          = 25035
+	TagResponseMode             = 25036
+	TagOrderRateLimitExceeded   = 25038
+	TagFirstBookUpdateID        = 25043
+	TagLastBookUpdateID         = 25044
+)
+
+// MsgType<35> values.
+const (
+	MsgTypeHeartbeat                    = "0"
+	MsgTypeTestRequest                  = "1"
+	MsgTypeResendRequest                = "2"
+	MsgTypeReject                       = "3"
+	MsgTypeSequenceReset                = "4"
+	MsgTypeLogout                       = "5"
+	MsgTypeExecutionReport              = "8"
+	MsgTypeOrderCancelReject            = "9"
+	MsgTypeLogon                        = "A"
+	MsgTypeNews                         = "B"
+	MsgTypeNewOrderSingle               = "D"
+	MsgTypeNewOrderList                 = "E"
+	MsgTypeOrderCancelRequest           = "F"
+	MsgTypeListStatus                   = "N"
+	MsgTypeMarketDataRequest            = "V"
+	MsgTypeMarketDataSnapshot           = "W"
+	MsgTypeMarketDataIncrementalRefresh = "X"
+	MsgTypeMarketDataRequestReject      = "Y"
+	MsgTypeOrderCancelRequestAndNew     = "XCN"
+)
+
+type MessageHandlingEnum int
+
+// UNORDERED - messages from the client may be processed in any order.
+// SEQUENCED - messages are processed in MsgSeqNum order.
+const (
+	MessageHandlingUNORDERED MessageHandlingEnum = 1
+	MessageHandlingSEQUENCED MessageHandlingEnum = 2
+)
+
+type ResponseModeEnum int
+
+// EVERYTHING - every ExecutionReport is sent (default).
+// ONLY_ACKS - only the ExecutionReport acknowledging an order is sent, fills are not.
+const (
+	ResponseModeEVERYTHING ResponseModeEnum = 1
+	ResponseModeONLY_ACKS  ResponseModeEnum = 2
+)
+
+type ContingencyTypeEnum int
+
+const (
+	ContingencyTypeOCO ContingencyTypeEnum = 1
+	ContingencyTypeOTO ContingencyTypeEnum = 2
+)
+
+type ListTriggerTypeEnum int
+
+const (
+	ListTriggerTypeACTIVATED        ListTriggerTypeEnum = 1
+	ListTriggerTypePARTIALLY_FILLED ListTriggerTypeEnum = 2
+	ListTriggerTypeFILLED           ListTriggerTypeEnum = 3
+)
+
+type ListTriggerActionEnum int
+
+const (
+	ListTriggerActionRELEASE ListTriggerActionEnum = 1
+	ListTriggerActionCANCEL  ListTriggerActionEnum = 2
+)
+
+var sides = map[string]string{"1": "BUY", "2": "SELL"}
+
+var timeInForces = map[string]string{"1": "GTC", "3": "IOC", "4": "FOK"}
+
+var execTypes = map[string]string{
+	"0": "NEW",
+	"4": "CANCELED",
+	"5": "REPLACED",
+	"8": "REJECTED",
+	"F": "TRADE",
+	"C": "EXPIRED",
+}
+
+var ordStatuses = map[string]string{
+	"0": "NEW",
+	"1": "PARTIALLY_FILLED",
+	"2": "FILLED",
+	"4": "CANCELED",
+	"6": "PENDING_CANCEL",
+	"8": "REJECTED",
+	"A": "PENDING_NEW",
+	"C": "EXPIRED",
+}
+
+var stpModes = map[string]string{"1": "NONE", "2": "EXPIRE_TAKER", "3": "EXPIRE_MAKER", "4": "EXPIRE_BOTH"}
+
+var contingencyTypes = map[string]string{"1": "OCO", "2": "OTO"}
+
+var listStatusTypes = map[string]string{"2": "RESPONSE", "4": "EXEC_STARTED", "5": "ALL_DONE", "100": "UPDATED"}
+
+var listOrderStatuses = map[string]string{"3": "EXECUTING", "6": "ALL_DONE", "7": "REJECT"}
+
+var mdEntryTypes = map[string]string{"0": "BID", "1": "OFFER", "2": "TRADE"}
+
+var mdUpdateActions = map[string]string{"0": "NEW", "1": "CHANGE", "2": "DELETE"}
+
+func fixSide(side core.OrderSideEnum) string {
+	if side == core.OrderSideSELL {
+		return "2"
+	}
+	return "1"
+}
+
+func fixTimeInForce(tif core.TimeInForceEnum) string {
+	switch tif {
+	case core.TimeInForceIOC:
+		return "3"
+	case core.TimeInForceFOK:
+		return "4"
+	}
+	return "1"
+}
+
+func fixSTPMode(mode core.STPModeEnum) string {
+	switch mode {
+	case core.STPModeEXPIRE_TAKER:
+		return "2"
+	case core.STPModeEXPIRE_MAKER:
+		return "3"
+	case core.STPModeEXPIRE_BOTH:
+		return "4"
+	}
+	return "1"
+}
+
+// orderType Maps OrdType<40>, ExecInst<18> and the trigger direction back to the spot order type names.
+// STOP_LOSS orders trigger against the order side (up for BUY, down for SELL), TAKE_PROFIT ones with it.
+func orderType(ordType, execInst, side, direction string) string {
+	switch ordType {
+	case "1":
+		return "MARKET"
+	case "2":
+		if execInst == "6" {
+			return "LIMIT_MAKER"
+		}
+		return "LIMIT"
+	case "3", "4":
+		name := "TAKE_PROFIT"
+		if (side == "1" && direction == "U") || (side == "2" && direction == "D") {
+			name = "STOP_LOSS"
+		}
+		if ordType == "4" {
+			name += "_LIMIT"
+		}
+		return name
+	}
+	return ordType
+}
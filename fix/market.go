@@ -0,0 +1,137 @@
+package fix
+
+import (
+	"context"
+	"github.com/shopspring/decimal"
+)
+
+// MarketDataRequest MarketDataRequest<V> Subscribe to or unsubscribe from book and trade streams.
+// Do returns once the request is written. Data arrives on Client.Events as MarketDataSnapshot<W> and MarketDataIncrementalRefresh<X>,
+// a rejected request as MarketDataRequestReject<Y>.
+type MarketDataRequest struct {
+	c          *Client
+	m          *Message
+	symbols    []string
+	entryTypes []string
+}
+
+type MarketData struct {
+	ReqId string
+	// Snapshot true for MarketDataSnapshot<W>, false for MarketDataIncrementalRefresh<X>.
+	Snapshot         bool
+	Symbol           string
+	LastBookUpdateId int64
+	Entries          []*MarketDataEntry
+}
+
+type MarketDataEntry struct {
+	// UpdateAction NEW, CHANGE or DELETE, incremental refreshes only.
+	UpdateAction      string
+	EntryType         string // BID, OFFER or TRADE
+	Symbol            string
+	Price             decimal.Decimal
+	Size              decimal.Decimal
+	TransactTime      int64
+	TradeId           int64
+	AggressorSide     string
+	FirstBookUpdateId int64
+	LastBookUpdateId  int64
+}
+
+// ReqId Identifies the subscription, generated when not sent. Use the same id to unsubscribe.
+func (s *MarketDataRequest) ReqId(reqId string) *MarketDataRequest {
+	s.m.Set(TagMDReqID, reqId)
+	return s
+}
+
+func (s *MarketDataRequest) Symbol(symbol string) *MarketDataRequest {
+	s.symbols = append(s.symbols, symbol)
+	return s
+}
+
+// Depth The book depth, 1 for the best bid and offer only.
+func (s *MarketDataRequest) Depth(depth int) *MarketDataRequest {
+	s.m.Set(TagMarketDepth, depth)
+	return s
+}
+
+// Book Subscribe to bids and offers.
+func (s *MarketDataRequest) Book() *MarketDataRequest {
+	s.entryTypes = append(s.entryTypes, "0", "1")
+	return s
+}
+
+// Trades Subscribe to trades.
+func (s *MarketDataRequest) Trades() *MarketDataRequest {
+	s.entryTypes = append(s.entryTypes, "2")
+	return s
+}
+
+// Unsubscribe Cancels the subscription identified by ReqId.
+func (s *MarketDataRequest) Unsubscribe() *MarketDataRequest {
+	s.m.Set(TagSubscriptionRequestType, "2")
+	return s
+}
+
+func (s *MarketDataRequest) Do(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if !s.m.Has(TagMDReqID) {
+		s.m.Set(TagMDReqID, randomId(8))
+	}
+	if !s.m.Has(TagSubscriptionRequestType) {
+		s.m.Set(TagSubscriptionRequestType, "1")
+	}
+	if len(s.entryTypes) > 0 {
+		s.m.Set(TagNoMDEntryTypes, len(s.entryTypes))
+		for _, entryType := range s.entryTypes {
+			s.m.Add(TagMDEntryType, entryType)
+		}
+	}
+	s.m.Set(TagNoRelatedSym, len(s.symbols))
+	for _, symbol := range s.symbols {
+		s.m.Add(TagSymbol, symbol)
+	}
+	return s.c.send(s.m)
+}
+
+// NewMarketData Decodes a MarketDataSnapshot<W> or MarketDataIncrementalRefresh<X>.
+// Incremental entries carry the symbol only when it changes, it is filled in from the previous entry.
+func NewMarketData(m *Message) *MarketData {
+	data := &MarketData{
+		ReqId:            m.Get(TagMDReqID),
+		Snapshot:         m.MsgType() == MsgTypeMarketDataSnapshot,
+		Symbol:           m.Get(TagSymbol),
+		LastBookUpdateId: m.Int(TagLastBookUpdateID),
+	}
+	delimiter := TagMDEntryType
+	if !data.Snapshot {
+		delimiter = TagMDUpdateAction
+	}
+	symbol := data.Symbol
+	groups := m.Group(TagNoMDEntries, delimiter, TagMDEntryType, TagMDUpdateAction, TagSymbol, TagMDEntryPx, TagMDEntrySize,
+		TagTransactTime, TagTradeID, TagAggressorSide, TagFirstBookUpdateID, TagLastBookUpdateID)
+	for _, g := range groups {
+		if g.Has(TagSymbol) {
+			symbol = g.Get(TagSymbol)
+		}
+		entry := &MarketDataEntry{
+			UpdateAction:      mdUpdateActions[g.Get(TagMDUpdateAction)],
+			EntryType:         mdEntryTypes[g.Get(TagMDEntryType)],
+			Symbol:            symbol,
+			TransactTime:      parseTime(g.Get(TagTransactTime)),
+			TradeId:           g.Int(TagTradeID),
+			AggressorSide:     sides[g.Get(TagAggressorSide)],
+			FirstBookUpdateId: g.Int(TagFirstBookUpdateID),
+			LastBookUpdateId:  g.Int(TagLastBookUpdateID),
+		}
+		entry.Price, _ = decimal.NewFromString(g.Get(TagMDEntryPx))
+		entry.Size, _ = decimal.NewFromString(g.Get(TagMDEntrySize))
+		data.Entries = append(data.Entries, entry)
+	}
+	if data.Symbol == "" && len(data.Entries) > 0 {
+		data.Symbol = data.Entries[0].Symbol
+	}
+	return data
+}
@@ -0,0 +1,77 @@
+package fix
+
+import (
+	"context"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type marketTestSuite struct {
+	baseFixTestSuite
+}
+
+func TestMarket(t *testing.T) {
+	suite.Run(t, new(marketTestSuite))
+}
+
+func (s *marketTestSuite) TestMarketDataRequest() {
+	s.logon()
+	r := s.r()
+	r.NoError(s.client.NewMarketDataRequest().ReqId("book-1").Symbol("BTCUSDT").Depth(2).Book().Do(context.Background()))
+	request := s.acceptor.next(MsgTypeMarketDataRequest)
+	r.NotNil(request)
+	r.Equal("book-1", request.Get(TagMDReqID), "MDReqID")
+	r.Equal("1", request.Get(TagSubscriptionRequestType), "SubscriptionRequestType")
+	r.Equal("2", request.Get(TagMarketDepth), "MarketDepth")
+	r.Equal("2", request.Get(TagNoMDEntryTypes), "NoMDEntryTypes")
+	r.Len(request.Group(TagNoMDEntryTypes, TagMDEntryType), 2)
+	r.Equal("BTCUSDT", request.Group(TagNoRelatedSym, TagSymbol)[0].Get(TagSymbol), "Symbol")
+
+	s.acceptor.send(NewMessage(MsgTypeMarketDataSnapshot).
+		Set(TagMDReqID, "book-1").
+		Set(TagSymbol, "BTCUSDT").
+		Set(TagLastBookUpdateID, 100).
+		Set(TagNoMDEntries, 2).
+		Add(TagMDEntryType, "0").Add(TagMDEntryPx, "59999.99").Add(TagMDEntrySize, "1.5").
+		Add(TagMDEntryType, "1").Add(TagMDEntryPx, "60000.01").Add(TagMDEntrySize, "0.5"))
+	snapshot := s.event().MarketData
+	r.True(snapshot.Snapshot, "Snapshot")
+	r.Equal("BTCUSDT", snapshot.Symbol, "Symbol")
+	r.Equal(int64(100), snapshot.LastBookUpdateId, "LastBookUpdateId")
+	r.Len(snapshot.Entries, 2)
+	r.Equal("BID", snapshot.Entries[0].EntryType, "EntryType")
+	r.Equal("59999.99", snapshot.Entries[0].Price.String(), "Price")
+	r.Equal("OFFER", snapshot.Entries[1].EntryType, "EntryType")
+
+	s.acceptor.send(NewMessage(MsgTypeMarketDataIncrementalRefresh).
+		Set(TagMDReqID, "book-1").
+		Set(TagNoMDEntries, 2).
+		Add(TagMDUpdateAction, "2").Add(TagMDEntryType, "0").Add(TagSymbol, "BTCUSDT").Add(TagMDEntryPx, "59999.99").
+		Add(TagFirstBookUpdateID, 101).Add(TagLastBookUpdateID, 101).
+		Add(TagMDUpdateAction, "0").Add(TagMDEntryType, "0").Add(TagMDEntryPx, "59999.98").Add(TagMDEntrySize, "2"))
+	update := s.event().MarketData
+	r.False(update.Snapshot, "Snapshot")
+	r.Equal("BTCUSDT", update.Symbol, "Symbol")
+	r.Len(update.Entries, 2)
+	r.Equal("DELETE", update.Entries[0].UpdateAction, "UpdateAction")
+	r.Equal(int64(101), update.Entries[0].FirstBookUpdateId, "FirstBookUpdateId")
+	r.Equal("NEW", update.Entries[1].UpdateAction, "UpdateAction")
+	r.Equal("BTCUSDT", update.Entries[1].Symbol, "Symbol")
+	r.Equal("2", update.Entries[1].Size.String(), "Size")
+}
+
+func (s *marketTestSuite) TestTrades() {
+	m := NewMessage(MsgTypeMarketDataIncrementalRefresh).
+		Set(TagMDReqID, "trades-1").
+		Set(TagNoMDEntries, 1).
+		Add(TagMDUpdateAction, "0").Add(TagMDEntryType, "2").Add(TagSymbol, "ETHUSDT").
+		Add(TagMDEntryPx, "3000.5").Add(TagMDEntrySize, "0.1").
+		Add(TagTransactTime, "20240627-11:17:25.223000").Add(TagTradeID, 42).Add(TagAggressorSide, "2")
+	data := NewMarketData(m)
+	r := s.r()
+	r.Equal("ETHUSDT", data.Symbol, "Symbol")
+	r.Equal("TRADE", data.Entries[0].EntryType, "EntryType")
+	r.Equal(int64(42), data.Entries[0].TradeId, "TradeId")
+	r.Equal("SELL", data.Entries[0].AggressorSide, "AggressorSide")
+	r.Equal(int64(1719487045223), data.Entries[0].TransactTime, "TransactTime")
+}
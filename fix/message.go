@@ -0,0 +1,281 @@
+package fix
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/jekaxv/go-binance/core"
+	"io"
+	"strconv"
+	"time"
+)
+
+const (
+	soh         = '\x01'
+	beginString = "FIX.4.4"
+	timeLayout  = "20060102-15:04:05.000"
+)
+
+var ErrGarbled = errors.New("fix: garbled message")
+
+// redactedTags The tags never written to logs or audit records: RawDataLength<95> and RawData<96> of the logon signature,
+// and Username<553> holding the API key.
+var redactedTags = map[int]bool{TagRawDataLength: true, TagRawData: true, TagUsername: true}
+
+// Field A single tag=value pair. Fields keep the order they were added in, which repeating groups rely on.
+type Field struct {
+	Tag   int
+	Value string
+}
+
+// Message A FIX message without the BeginString, BodyLength and CheckSum fields, which are computed on encode.
+// Outgoing messages only carry the MsgType and body, the session adds the standard header when sending.
+type Message struct {
+	fields []Field
+}
+
+// NewMessage Creates an empty message of the given MsgType<35>.
+func NewMessage(msgType string) *Message {
+	return &Message{fields: []Field{{Tag: TagMsgType, Value: msgType}}}
+}
+
+func (m *Message) MsgType() string {
+	return m.Get(TagMsgType)
+}
+
+// Set Replaces the first occurrence of tag or appends it.
+func (m *Message) Set(tag int, value any) *Message {
+	v := format(value)
+	for i := range m.fields {
+		if m.fields[i].Tag == tag {
+			m.fields[i].Value = v
+			return m
+		}
+	}
+	m.fields = append(m.fields, Field{Tag: tag, Value: v})
+	return m
+}
+
+// Add Appends tag even if it is already present, used for repeating groups.
+func (m *Message) Add(tag int, value any) *Message {
+	m.fields = append(m.fields, Field{Tag: tag, Value: format(value)})
+	return m
+}
+
+// Get Returns the first value of tag, or an empty string.
+func (m *Message) Get(tag int) string {
+	for _, f := range m.fields {
+		if f.Tag == tag {
+			return f.Value
+		}
+	}
+	return ""
+}
+
+func (m *Message) Has(tag int) bool {
+	for _, f := range m.fields {
+		if f.Tag == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Message) Int(tag int) int64 {
+	v, _ := strconv.ParseInt(m.Get(tag), 10, 64)
+	return v
+}
+
+func (m *Message) Fields() []Field {
+	return m.fields
+}
+
+// Group Splits the repeating group introduced by countTag into one message per entry.
+// tags lists the tags that belong to an entry, the first one is the delimiter that starts each entry.
+func (m *Message) Group(countTag int, tags ...int) []*Message {
+	if len(tags) == 0 {
+		return nil
+	}
+	member := make(map[int]bool, len(tags))
+	for _, t := range tags {
+		member[t] = true
+	}
+	var entries []*Message
+	var current *Message
+	in := false
+	for _, f := range m.fields {
+		if f.Tag == countTag {
+			in = true
+			continue
+		}
+		if !in {
+			continue
+		}
+		if f.Tag == tags[0] {
+			current = &Message{}
+			entries = append(entries, current)
+		} else if !member[f.Tag] || current == nil {
+			if current != nil {
+				break
+			}
+			continue
+		}
+		current.fields = append(current.fields, f)
+	}
+	return entries
+}
+
+func (m *Message) clone() *Message {
+	fields := make([]Field, len(m.fields))
+	copy(fields, m.fields)
+	return &Message{fields: fields}
+}
+
+// String Renders the message with '|' in place of SOH, for logging. The logon signature and API key are redacted.
+func (m *Message) String() string {
+	var b bytes.Buffer
+	for _, f := range m.fields {
+		fmt.Fprintf(&b, "%d=%s|", f.Tag, redact(f))
+	}
+	return b.String()
+}
+
+// params The fields of m by tag for audit records, the values of a repeated tag are joined by commas.
+func (m *Message) params() map[string]string {
+	params := make(map[string]string, len(m.fields))
+	for _, f := range m.fields {
+		tag := strconv.Itoa(f.Tag)
+		if value, ok := params[tag]; ok {
+			params[tag] = value + "," + redact(f)
+		} else {
+			params[tag] = redact(f)
+		}
+	}
+	return params
+}
+
+func redact(f Field) string {
+	if redactedTags[f.Tag] {
+		return core.Redacted
+	}
+	return f.Value
+}
+
+// encode Writes the message with the given header fields placed right after MsgType.
+func (m *Message) encode(header ...Field) []byte {
+	var body bytes.Buffer
+	writeField(&body, TagMsgType, m.MsgType())
+	for _, f := range header {
+		writeField(&body, f.Tag, f.Value)
+	}
+	for _, f := range m.fields {
+		if f.Tag == TagMsgType {
+			continue
+		}
+		writeField(&body, f.Tag, f.Value)
+	}
+	var out bytes.Buffer
+	writeField(&out, TagBeginString, beginString)
+	writeField(&out, TagBodyLength, strconv.Itoa(body.Len()))
+	out.Write(body.Bytes())
+	writeField(&out, TagCheckSum, fmt.Sprintf("%03d", checksum(out.Bytes())))
+	return out.Bytes()
+}
+
+func writeField(b *bytes.Buffer, tag int, value string) {
+	b.WriteString(strconv.Itoa(tag))
+	b.WriteByte('=')
+	b.WriteString(value)
+	b.WriteByte(soh)
+}
+
+func checksum(b []byte) int {
+	sum := 0
+	for _, c := range b {
+		sum += int(c)
+	}
+	return sum % 256
+}
+
+// readMessage Reads one message off the wire, validating BodyLength and CheckSum.
+func readMessage(r *bufio.Reader) (*Message, error) {
+	begin, err := r.ReadBytes(soh)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(begin, []byte("8=")) {
+		return nil, ErrGarbled
+	}
+	length, err := r.ReadBytes(soh)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(length, []byte("9=")) {
+		return nil, ErrGarbled
+	}
+	n, err := strconv.Atoi(string(length[2 : len(length)-1]))
+	if err != nil || n <= 0 {
+		return nil, ErrGarbled
+	}
+	body := make([]byte, n)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return nil, err
+	}
+	trailer := make([]byte, 7)
+	if _, err := io.ReadFull(r, trailer); err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(trailer, []byte("10=")) || trailer[6] != soh {
+		return nil, ErrGarbled
+	}
+	sum := checksum(begin) + checksum(length) + checksum(body)
+	if fmt.Sprintf("%03d", sum%256) != string(trailer[3:6]) {
+		return nil, fmt.Errorf("%w: checksum mismatch", ErrGarbled)
+	}
+	return parseBody(body)
+}
+
+func parseBody(body []byte) (*Message, error) {
+	m := &Message{}
+	for _, raw := range bytes.Split(bytes.TrimSuffix(body, []byte{soh}), []byte{soh}) {
+		tag, value, ok := bytes.Cut(raw, []byte("="))
+		if !ok {
+			return nil, ErrGarbled
+		}
+		t, err := strconv.Atoi(string(tag))
+		if err != nil {
+			return nil, ErrGarbled
+		}
+		m.fields = append(m.fields, Field{Tag: t, Value: string(value)})
+	}
+	if len(m.fields) == 0 || m.fields[0].Tag != TagMsgType {
+		return nil, ErrGarbled
+	}
+	return m, nil
+}
+
+func format(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool:
+		if v {
+			return "Y"
+		}
+		return "N"
+	case time.Time:
+		return v.UTC().Format(timeLayout)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// parseTime Parses an UTCTimestamp into unix milliseconds, Binance sends micro or nanosecond precision.
+func parseTime(v string) int64 {
+	t, err := time.Parse("20060102-15:04:05.999999999", v)
+	if err != nil {
+		return 0
+	}
+	return t.UnixMilli()
+}
@@ -0,0 +1,54 @@
+package fix
+
+import (
+	"bufio"
+	"bytes"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type messageTestSuite struct {
+	suite.Suite
+}
+
+func TestMessage(t *testing.T) {
+	suite.Run(t, new(messageTestSuite))
+}
+
+func (s *messageTestSuite) TestEncode() {
+	m := NewMessage(MsgTypeHeartbeat).Set(TagTestReqID, "1")
+	raw := m.encode(Field{Tag: TagSenderCompID, Value: "TEST"})
+	s.Require().Equal("8=FIX.4.4\x019=19\x0135=0\x0149=TEST\x01112=1\x0110=198\x01", string(raw))
+}
+
+func (s *messageTestSuite) TestRoundTrip() {
+	r := s.Require()
+	m := NewMessage(MsgTypeNewOrderSingle).Set(TagSymbol, "BTCUSDT").Set(TagPrice, "1.5").Set(TagResetSeqNumFlag, true)
+	decoded, err := readMessage(bufio.NewReader(bytes.NewReader(m.encode(Field{Tag: TagMsgSeqNum, Value: "2"}))))
+	r.NoError(err)
+	r.Equal(MsgTypeNewOrderSingle, decoded.MsgType())
+	r.Equal("BTCUSDT", decoded.Get(TagSymbol))
+	r.Equal("Y", decoded.Get(TagResetSeqNumFlag))
+	r.Equal(int64(2), decoded.Int(TagMsgSeqNum))
+}
+
+func (s *messageTestSuite) TestChecksumMismatch() {
+	raw := NewMessage(MsgTypeHeartbeat).encode()
+	raw[len(raw)-2] = '9'
+	_, err := readMessage(bufio.NewReader(bytes.NewReader(raw)))
+	s.Require().ErrorIs(err, ErrGarbled)
+}
+
+func (s *messageTestSuite) TestGroup() {
+	m := NewMessage(MsgTypeListStatus).
+		Set(TagSymbol, "BTCUSDT").
+		Set(TagNoOrders, 2).
+		Add(TagSymbol, "BTCUSDT").Add(TagOrderID, 1).Add(TagClOrdID, "a").
+		Add(TagSymbol, "BTCUSDT").Add(TagOrderID, 2).Add(TagClOrdID, "b").
+		Add(TagText, "after")
+	groups := m.Group(TagNoOrders, TagSymbol, TagOrderID, TagClOrdID)
+	r := s.Require()
+	r.Len(groups, 2)
+	r.Equal("b", groups[1].Get(TagClOrdID))
+	r.False(groups[1].Has(TagText))
+}
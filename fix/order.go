@@ -0,0 +1,594 @@
+package fix
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/spot"
+	"github.com/shopspring/decimal"
+	"strconv"
+)
+
+// orderFields The order parameters shared by NewOrderSingle<D>, OrderCancelRequestAndNewOrderSingle<XCN> and the NewOrderList<E> entries.
+// OrdType<40> and the trigger fields depend on each other, so they are resolved once the whole order is known.
+type orderFields struct {
+	side          core.OrderSideEnum
+	orderType     core.OrderTypeEnum
+	stopPrice     string
+	trailingDelta int
+}
+
+func (o *orderFields) apply(m *Message) {
+	m.Set(TagSide, fixSide(o.side))
+	switch o.orderType {
+	case core.OrderTypeMARKET:
+		m.Set(TagOrdType, "1")
+	case core.OrderTypeLIMIT:
+		m.Set(TagOrdType, "2")
+	case core.OrderTypeLIMIT_MAKER:
+		m.Set(TagOrdType, "2").Set(TagExecInst, "6")
+	case core.OrderTypeSTOP_LOSS, core.OrderTypeSTOP_LOSS_LIMIT, core.OrderTypeTAKE_PROFIT, core.OrderTypeTAKE_PROFIT_LIMIT:
+		ordType := "3"
+		if o.orderType == core.OrderTypeSTOP_LOSS_LIMIT || o.orderType == core.OrderTypeTAKE_PROFIT_LIMIT {
+			ordType = "4"
+		}
+		stopLoss := o.orderType == core.OrderTypeSTOP_LOSS || o.orderType == core.OrderTypeSTOP_LOSS_LIMIT
+		direction := "D"
+		if stopLoss == (o.side == core.OrderSideBUY) {
+			direction = "U"
+		}
+		// TriggerType PRICE_MOVEMENT, TriggerAction ACTIVATE, TriggerPriceType LAST_TRADE
+		m.Set(TagOrdType, ordType).
+			Set(TagTriggerType, "4").
+			Set(TagTriggerAction, "1").
+			Set(TagTriggerPriceType, "2").
+			Set(TagTriggerPriceDirection, direction)
+		if o.stopPrice != "" {
+			m.Set(TagTriggerPrice, o.stopPrice)
+		}
+		if o.trailingDelta > 0 {
+			m.Set(TagTriggerTrailingDeltaBips, o.trailingDelta)
+		}
+	}
+}
+
+// CreateOrder NewOrderSingle<D> Send in a new order.
+// Do waits for the first ExecutionReport<8> of the order, later ones arrive on Client.Events.
+type CreateOrder struct {
+	c *Client
+	m *Message
+	o orderFields
+}
+
+func (s *CreateOrder) Symbol(symbol string) *CreateOrder {
+	s.m.Set(TagSymbol, symbol)
+	return s
+}
+
+// NewClientOrderId A unique id among open orders, generated when not sent.
+func (s *CreateOrder) NewClientOrderId(newClientOrderId string) *CreateOrder {
+	s.m.Set(TagClOrdID, newClientOrderId)
+	return s
+}
+
+func (s *CreateOrder) Side(side core.OrderSideEnum) *CreateOrder {
+	s.o.side = side
+	return s
+}
+
+// Type MARKET, LIMIT, LIMIT_MAKER, STOP_LOSS, STOP_LOSS_LIMIT, TAKE_PROFIT, TAKE_PROFIT_LIMIT
+func (s *CreateOrder) Type(orderType core.OrderTypeEnum) *CreateOrder {
+	s.o.orderType = orderType
+	return s
+}
+
+func (s *CreateOrder) TimeInForce(timeInForce core.TimeInForceEnum) *CreateOrder {
+	s.m.Set(TagTimeInForce, fixTimeInForce(timeInForce))
+	return s
+}
+
+func (s *CreateOrder) Quantity(quantity string) *CreateOrder {
+	s.m.Set(TagOrderQty, quantity)
+	return s
+}
+
+// QuoteOrderQty Sent as CashOrderQty<152>, MARKET orders only.
+func (s *CreateOrder) QuoteOrderQty(quoteOrderQty string) *CreateOrder {
+	s.m.Set(TagCashOrderQty, quoteOrderQty)
+	return s
+}
+
+func (s *CreateOrder) Price(price string) *CreateOrder {
+	s.m.Set(TagPrice, price)
+	return s
+}
+
+// StopPrice Sent as TriggerPrice<1102>, used with STOP_LOSS, STOP_LOSS_LIMIT, TAKE_PROFIT, and TAKE_PROFIT_LIMIT orders.
+func (s *CreateOrder) StopPrice(stopPrice string) *CreateOrder {
+	s.o.stopPrice = stopPrice
+	return s
+}
+
+// TrailingDelta Sent as TriggerTrailingDeltaBips<25009>.
+func (s *CreateOrder) TrailingDelta(trailingDelta int) *CreateOrder {
+	s.o.trailingDelta = trailingDelta
+	return s
+}
+
+// IcebergQty Sent as MaxFloor<111>.
+func (s *CreateOrder) IcebergQty(icebergQty string) *CreateOrder {
+	s.m.Set(TagMaxFloor, icebergQty)
+	return s
+}
+
+func (s *CreateOrder) SelfTradePreventionMode(selfTradePreventionMode core.STPModeEnum) *CreateOrder {
+	s.m.Set(TagSelfTradePreventionMode, fixSTPMode(selfTradePreventionMode))
+	return s
+}
+
+func (s *CreateOrder) Do(ctx context.Context) (*spot.OrderUpdate, error) {
+	if !s.m.Has(TagClOrdID) {
+		s.m.Set(TagClOrdID, randomId(11))
+	}
+	s.o.apply(s.m)
+	resp, err := s.c.order(ctx, s.m, "ord:"+s.m.Get(TagClOrdID))
+	if err != nil {
+		return nil, err
+	}
+	return orderResponse(resp)
+}
+
+// CancelOrder OrderCancelRequest<F> Cancel an active order, or a whole order list with OrigClListID or ListID.
+type CancelOrder struct {
+	c *Client
+	m *Message
+}
+
+func (s *CancelOrder) Symbol(symbol string) *CancelOrder {
+	s.m.Set(TagSymbol, symbol)
+	return s
+}
+
+func (s *CancelOrder) OrderId(orderId int64) *CancelOrder {
+	s.m.Set(TagOrderID, orderId)
+	return s
+}
+
+func (s *CancelOrder) OrigClientOrderId(origClientOrderId string) *CancelOrder {
+	s.m.Set(TagOrigClOrdID, origClientOrderId)
+	return s
+}
+
+// NewClientOrderId Used to uniquely identify this cancel, generated when not sent.
+func (s *CancelOrder) NewClientOrderId(newClientOrderId string) *CancelOrder {
+	s.m.Set(TagClOrdID, newClientOrderId)
+	return s
+}
+
+func (s *CancelOrder) ListId(listId int64) *CancelOrder {
+	s.m.Set(TagListID, listId)
+	return s
+}
+
+func (s *CancelOrder) OrigClientListId(origClientListId string) *CancelOrder {
+	s.m.Set(TagOrigClListID, origClientListId)
+	return s
+}
+
+// CancelRestrictions ONLY_NEW or ONLY_PARTIALLY_FILLED
+func (s *CancelOrder) CancelRestrictions(cancelRestrictions core.CancelRestrictionEnum) *CancelOrder {
+	value := "1"
+	if cancelRestrictions == core.CancelRestrictionONLY_PARTIALLY_FILLED {
+		value = "2"
+	}
+	s.m.Set(TagCancelRestrictions, value)
+	return s
+}
+
+func (s *CancelOrder) Do(ctx context.Context) (*spot.OrderUpdate, error) {
+	if !s.m.Has(TagClOrdID) {
+		s.m.Set(TagClOrdID, randomId(11))
+	}
+	resp, err := s.c.order(ctx, s.m, "ord:"+s.m.Get(TagClOrdID))
+	if err != nil {
+		return nil, err
+	}
+	return orderResponse(resp)
+}
+
+// CancelReplace OrderCancelRequestAndNewOrderSingle<XCN> Cancel an existing order and place a new one on the same symbol.
+// Do waits for the first report of either the cancel or the new order.
+type CancelReplace struct {
+	c *Client
+	m *Message
+	o orderFields
+}
+
+func (s *CancelReplace) Symbol(symbol string) *CancelReplace {
+	s.m.Set(TagSymbol, symbol)
+	return s
+}
+
+// CancelReplaceMode STOP_ON_FAILURE or ALLOW_FAILURE
+func (s *CancelReplace) CancelReplaceMode(cancelReplaceMode core.CancelReplaceModeEnum) *CancelReplace {
+	value := "1"
+	if cancelReplaceMode == core.ReplaceModeALLOW_FAILURE {
+		value = "2"
+	}
+	s.m.Set(TagCancelReplaceMode, value)
+	return s
+}
+
+func (s *CancelReplace) CancelOrderId(cancelOrderId int64) *CancelReplace {
+	s.m.Set(TagOrderID, cancelOrderId)
+	return s
+}
+
+func (s *CancelReplace) CancelOrigClientOrderId(cancelOrigClientOrderId string) *CancelReplace {
+	s.m.Set(TagOrigClOrdID, cancelOrigClientOrderId)
+	return s
+}
+
+// CancelNewClientOrderId Used to uniquely identify the cancel, generated when not sent.
+func (s *CancelReplace) CancelNewClientOrderId(cancelNewClientOrderId string) *CancelReplace {
+	s.m.Set(TagCancelClOrdID, cancelNewClientOrderId)
+	return s
+}
+
+func (s *CancelReplace) NewClientOrderId(newClientOrderId string) *CancelReplace {
+	s.m.Set(TagClOrdID, newClientOrderId)
+	return s
+}
+
+func (s *CancelReplace) Side(side core.OrderSideEnum) *CancelReplace {
+	s.o.side = side
+	return s
+}
+
+func (s *CancelReplace) Type(orderType core.OrderTypeEnum) *CancelReplace {
+	s.o.orderType = orderType
+	return s
+}
+
+func (s *CancelReplace) TimeInForce(timeInForce core.TimeInForceEnum) *CancelReplace {
+	s.m.Set(TagTimeInForce, fixTimeInForce(timeInForce))
+	return s
+}
+
+func (s *CancelReplace) Quantity(quantity string) *CancelReplace {
+	s.m.Set(TagOrderQty, quantity)
+	return s
+}
+
+func (s *CancelReplace) QuoteOrderQty(quoteOrderQty string) *CancelReplace {
+	s.m.Set(TagCashOrderQty, quoteOrderQty)
+	return s
+}
+
+func (s *CancelReplace) Price(price string) *CancelReplace {
+	s.m.Set(TagPrice, price)
+	return s
+}
+
+func (s *CancelReplace) StopPrice(stopPrice string) *CancelReplace {
+	s.o.stopPrice = stopPrice
+	return s
+}
+
+func (s *CancelReplace) TrailingDelta(trailingDelta int) *CancelReplace {
+	s.o.trailingDelta = trailingDelta
+	return s
+}
+
+func (s *CancelReplace) IcebergQty(icebergQty string) *CancelReplace {
+	s.m.Set(TagMaxFloor, icebergQty)
+	return s
+}
+
+func (s *CancelReplace) SelfTradePreventionMode(selfTradePreventionMode core.STPModeEnum) *CancelReplace {
+	s.m.Set(TagSelfTradePreventionMode, fixSTPMode(selfTradePreventionMode))
+	return s
+}
+
+// OrderRateLimitExceededMode DO_NOTHING or CANCEL_ONLY
+func (s *CancelReplace) OrderRateLimitExceededMode(orderRateLimitExceededMode core.OrderExceededModeEnum) *CancelReplace {
+	value := "1"
+	if orderRateLimitExceededMode == core.OrderExceededModeCANCEL_ONLY {
+		value = "2"
+	}
+	s.m.Set(TagOrderRateLimitExceeded, value)
+	return s
+}
+
+func (s *CancelReplace) Do(ctx context.Context) (*spot.OrderUpdate, error) {
+	if !s.m.Has(TagClOrdID) {
+		s.m.Set(TagClOrdID, randomId(11))
+	}
+	if !s.m.Has(TagCancelClOrdID) {
+		s.m.Set(TagCancelClOrdID, randomId(11))
+	}
+	s.o.apply(s.m)
+	resp, err := s.c.order(ctx, s.m, "ord:"+s.m.Get(TagClOrdID), "ord:"+s.m.Get(TagCancelClOrdID))
+	if err != nil {
+		return nil, err
+	}
+	return orderResponse(resp)
+}
+
+// ListOrder An entry of NewOrderList<E>.
+type ListOrder struct {
+	ClientOrderId string
+	Side          core.OrderSideEnum
+	Type          core.OrderTypeEnum
+	TimeInForce   core.TimeInForceEnum
+	Quantity      string
+	Price         string
+	StopPrice     string
+	TrailingDelta int
+	IcebergQty    string
+	// Triggers ListTriggeringInstructions of the entry, e.g. the OCO leg cancelled when the other one activates.
+	Triggers []ListTrigger
+}
+
+type ListTrigger struct {
+	Type         ListTriggerTypeEnum
+	TriggerIndex int
+	Action       ListTriggerActionEnum
+}
+
+// CreateOrderList NewOrderList<E> Send in an OCO or OTO order list.
+// Do waits for the ListStatus<N> of the list.
+type CreateOrderList struct {
+	c      *Client
+	m      *Message
+	symbol string
+	orders []*ListOrder
+}
+
+// Symbol Sent on every NoOrders<73> entry, NewOrderList<E> has no Symbol<55> of its own.
+func (s *CreateOrderList) Symbol(symbol string) *CreateOrderList {
+	s.symbol = symbol
+	return s
+}
+
+// ListClientOrderId A unique id for the list, generated when not sent.
+func (s *CreateOrderList) ListClientOrderId(listClientOrderId string) *CreateOrderList {
+	s.m.Set(TagClListID, listClientOrderId)
+	return s
+}
+
+func (s *CreateOrderList) ContingencyType(contingencyType ContingencyTypeEnum) *CreateOrderList {
+	s.m.Set(TagContingencyType, int(contingencyType))
+	return s
+}
+
+func (s *CreateOrderList) SelfTradePreventionMode(selfTradePreventionMode core.STPModeEnum) *CreateOrderList {
+	s.m.Set(TagSelfTradePreventionMode, fixSTPMode(selfTradePreventionMode))
+	return s
+}
+
+// Order Appends an entry, the order of the calls is the index used by ListTrigger.TriggerIndex.
+func (s *CreateOrderList) Order(order *ListOrder) *CreateOrderList {
+	s.orders = append(s.orders, order)
+	return s
+}
+
+// message Builds the NewOrderList<E> afresh on every call, so the builder can be sent again.
+func (s *CreateOrderList) message() *Message {
+	m := s.m.clone()
+	if !m.Has(TagClListID) {
+		m.Set(TagClListID, randomId(11))
+	}
+	m.Set(TagNoOrders, len(s.orders))
+	for _, order := range s.orders {
+		clientOrderId := order.ClientOrderId
+		if clientOrderId == "" {
+			clientOrderId = randomId(11)
+		}
+		entry := &Message{}
+		entry.Set(TagClOrdID, clientOrderId).Set(TagSymbol, s.symbol)
+		o := orderFields{side: order.Side, orderType: order.Type, stopPrice: order.StopPrice, trailingDelta: order.TrailingDelta}
+		o.apply(entry)
+		if order.Quantity != "" {
+			entry.Set(TagOrderQty, order.Quantity)
+		}
+		if order.Price != "" {
+			entry.Set(TagPrice, order.Price)
+		}
+		if order.TimeInForce != "" {
+			entry.Set(TagTimeInForce, fixTimeInForce(order.TimeInForce))
+		}
+		if order.IcebergQty != "" {
+			entry.Set(TagMaxFloor, order.IcebergQty)
+		}
+		if len(order.Triggers) > 0 {
+			entry.Set(TagNoListTriggers, len(order.Triggers))
+			for _, trigger := range order.Triggers {
+				entry.Add(TagListTriggerType, int(trigger.Type)).
+					Add(TagListTriggerTriggerIndex, trigger.TriggerIndex).
+					Add(TagListTriggerAction, int(trigger.Action))
+			}
+		}
+		m.fields = append(m.fields, entry.fields...)
+	}
+	return m
+}
+
+func (s *CreateOrderList) Do(ctx context.Context) (*spot.ListStatus, error) {
+	m := s.message()
+	resp, err := s.c.order(ctx, m, "list:"+m.Get(TagClListID))
+	if err != nil {
+		return nil, err
+	}
+	if resp.MsgType() != MsgTypeListStatus {
+		return nil, newRejectError(resp)
+	}
+	status := NewListStatus(resp)
+	if status.ListOrderStatus == "REJECT" {
+		return status, newRejectError(resp)
+	}
+	return status, nil
+}
+
+// intent The order of m named as the REST params for the OrderGuard, the orders of a NewOrderList<E> go in batchOrders.
+func (m *Message) intent() *core.OrderIntent {
+	intent := &core.OrderIntent{MsgType: m.MsgType(), Params: orderParams(m.fields)}
+	if m.MsgType() != MsgTypeNewOrderList {
+		return intent
+	}
+	var orders []map[string]string
+	var entry []Field
+	in := false
+	for _, f := range m.fields {
+		if f.Tag == TagNoOrders {
+			in = true
+			continue
+		}
+		if !in {
+			continue
+		}
+		if f.Tag == TagClOrdID && len(entry) > 0 {
+			orders = append(orders, orderParams(entry))
+			entry = nil
+		}
+		entry = append(entry, f)
+	}
+	if len(entry) > 0 {
+		orders = append(orders, orderParams(entry))
+	}
+	batch, _ := json.Marshal(orders)
+	intent.Params = map[string]string{"symbol": m.Get(TagSymbol), "batchOrders": string(batch)}
+	return intent
+}
+
+// orderParams The first symbol, side, quantity, price and quoteOrderQty of fields.
+func orderParams(fields []Field) map[string]string {
+	names := map[int]string{TagSymbol: "symbol", TagSide: "side", TagOrderQty: "quantity", TagPrice: "price", TagCashOrderQty: "quoteOrderQty"}
+	params := make(map[string]string)
+	for _, f := range fields {
+		name, ok := names[f.Tag]
+		if !ok {
+			continue
+		}
+		if _, seen := params[name]; seen {
+			continue
+		}
+		if f.Tag == TagSide {
+			params[name] = sides[f.Value]
+		} else {
+			params[name] = f.Value
+		}
+	}
+	return params
+}
+
+// orderResponse Turns the first response of an order request into the report, rejections become a RejectError.
+func orderResponse(m *Message) (*spot.OrderUpdate, error) {
+	if m.MsgType() != MsgTypeExecutionReport {
+		return nil, newRejectError(m)
+	}
+	update, err := NewOrderUpdate(m)
+	if err != nil {
+		return nil, err
+	}
+	if update.CurrentExecType == "REJECTED" {
+		return update, newRejectError(m)
+	}
+	return update, nil
+}
+
+// NewOrderUpdate Decodes an ExecutionReport<8> into the executionReport event of the spot user data stream.
+// Enumerations are translated to the names used by the REST and WebSocket APIs, times are in milliseconds.
+func NewOrderUpdate(m *Message) (*spot.OrderUpdate, error) {
+	update := &spot.OrderUpdate{
+		Symbol:                  m.Get(TagSymbol),
+		ClientOrderId:           m.Get(TagClOrdID),
+		Side:                    sides[m.Get(TagSide)],
+		OrderType:               orderType(m.Get(TagOrdType), m.Get(TagExecInst), m.Get(TagSide), m.Get(TagTriggerPriceDirection)),
+		TimeForce:               timeInForces[m.Get(TagTimeInForce)],
+		OriginalOrderId:         m.Get(TagOrigClOrdID),
+		CurrentExecType:         execTypes[m.Get(TagExecType)],
+		CurrentOrderStatus:      ordStatuses[m.Get(TagOrdStatus)],
+		OrderRejectReason:       "NONE",
+		OrderId:                 int(m.Int(TagOrderID)),
+		TransactionTime:         parseTime(m.Get(TagTransactTime)),
+		TradeId:                 -1,
+		PreventedMatchId:        int(m.Int(TagPreventedMatchID)),
+		ExecutionId:             int(m.Int(TagExecID)),
+		IsInOrderBook:           m.Get(TagWorkingIndicator) == "Y",
+		IsMaker:                 m.Get(TagAggressorIndicator) == "N",
+		CreateTime:              parseTime(m.Get(TagOrderCreationTime)),
+		WorkingTime:             parseTime(m.Get(TagWorkingTime)),
+		SelfTradePreventionMode: stpModes[m.Get(TagSelfTradePreventionMode)],
+		OrderListId:             -1,
+	}
+	if m.Has(TagListID) {
+		update.OrderListId = int(m.Int(TagListID))
+	}
+	if m.Has(TagTradeID) {
+		update.TradeId = int(m.Int(TagTradeID))
+	}
+	if m.Has(TagErrorCode) {
+		update.OrderRejectReason = m.Get(TagErrorCode)
+	}
+	decimals := []struct {
+		tag int
+		dst *decimal.Decimal
+	}{
+		{TagOrderQty, &update.OrderQuantity},
+		{TagPrice, &update.OrderPrice},
+		{TagTriggerPrice, &update.StopPrice},
+		{TagMaxFloor, &update.IcebergQuantity},
+		{TagLastQty, &update.LastExecQuantity},
+		{TagCumQty, &update.CumulativeQuantity},
+		{TagLastPx, &update.LastExecPrice},
+		{TagCumQuoteQty, &update.FilledQuoteVolume},
+		{TagCashOrderQty, &update.QuoteVolume},
+	}
+	for _, d := range decimals {
+		if m.Get(d.tag) == "" {
+			continue
+		}
+		v, err := decimal.NewFromString(m.Get(d.tag))
+		if err != nil {
+			return nil, err
+		}
+		*d.dst = v
+	}
+	update.LatestQuoteVolume = update.LastExecQuantity.Mul(update.LastExecPrice)
+	for _, fee := range m.Group(TagNoMiscFees, TagMiscFeeAmt, TagMiscFeeCurr, TagMiscFeeType) {
+		amount, err := decimal.NewFromString(fee.Get(TagMiscFeeAmt))
+		if err != nil {
+			return nil, err
+		}
+		update.CommissionAmount = update.CommissionAmount.Add(amount)
+		update.CommissionAsset = fee.Get(TagMiscFeeCurr)
+	}
+	return update, nil
+}
+
+// NewListStatus Decodes a ListStatus<N> into the listStatus event of the spot user data stream.
+func NewListStatus(m *Message) *spot.ListStatus {
+	status := &spot.ListStatus{
+		Symbol:           m.Get(TagSymbol),
+		OrderListId:      int(m.Int(TagListID)),
+		ContingencyType:  contingencyTypes[m.Get(TagContingencyType)],
+		ListStatusType:   listStatusTypes[m.Get(TagListStatusType)],
+		ListOrderStatus:  listOrderStatuses[m.Get(TagListOrderStatus)],
+		ListRejectReason: "NONE",
+		ClientOrderId:    m.Get(TagClListID),
+		TransactionTime:  parseTime(m.Get(TagTransactTime)),
+	}
+	if m.Has(TagErrorCode) {
+		status.ListRejectReason = m.Get(TagErrorCode)
+	}
+	for _, order := range m.Group(TagNoOrders, TagSymbol, TagOrderID, TagClOrdID) {
+		orderId, _ := strconv.Atoi(order.Get(TagOrderID))
+		status.ListStatusObj = append(status.ListStatusObj, spot.ListStatusObj{
+			Symbol:        order.Get(TagSymbol),
+			OrderId:       orderId,
+			ClientOrderId: order.Get(TagClOrdID),
+		})
+	}
+	return status
+}
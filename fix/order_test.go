@@ -0,0 +1,484 @@
+package fix
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/spot"
+	"github.com/stretchr/testify/suite"
+	"log/slog"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+type orderTestSuite struct {
+	baseFixTestSuite
+}
+
+func TestOrder(t *testing.T) {
+	suite.Run(t, new(orderTestSuite))
+}
+
+func executionReport(request *Message) *Message {
+	return NewMessage(MsgTypeExecutionReport).
+		Set(TagClOrdID, request.Get(TagClOrdID)).
+		Set(TagOrderID, 12).
+		Set(TagSymbol, request.Get(TagSymbol)).
+		Set(TagSide, request.Get(TagSide)).
+		Set(TagOrdType, request.Get(TagOrdType)).
+		Set(TagTimeInForce, request.Get(TagTimeInForce)).
+		Set(TagOrderQty, request.Get(TagOrderQty)).
+		Set(TagPrice, request.Get(TagPrice)).
+		Set(TagCumQty, "0").
+		Set(TagCumQuoteQty, "0").
+		Set(TagExecID, 77).
+		Set(TagExecType, "0").
+		Set(TagOrdStatus, "0").
+		Set(TagTransactTime, "20240627-11:17:25.223000").
+		Set(TagOrderCreationTime, "20240627-11:17:25.223000").
+		Set(TagWorkingIndicator, "Y").
+		Set(TagSelfTradePreventionMode, "3")
+}
+
+func (s *orderTestSuite) TestCreateOrder() {
+	s.logon()
+	go func() {
+		request := s.acceptor.next(MsgTypeNewOrderSingle)
+		if request != nil {
+			s.acceptor.send(executionReport(request))
+		}
+	}()
+	resp, err := s.client.NewCreateOrder().Symbol("BTCUSDT").
+		Side(core.OrderSideBUY).
+		Type(core.OrderTypeLIMIT).
+		TimeInForce(core.TimeInForceGTC).
+		Quantity("0.001").
+		Price("60000").
+		NewClientOrderId("order-1").
+		Do(context.Background())
+	r := s.r()
+	r.NoError(err)
+	r.Equal("BTCUSDT", resp.Symbol, "Symbol")
+	r.Equal("order-1", resp.ClientOrderId, "ClientOrderId")
+	r.Equal("BUY", resp.Side, "Side")
+	r.Equal("LIMIT", resp.OrderType, "OrderType")
+	r.Equal("GTC", resp.TimeForce, "TimeForce")
+	r.Equal("0.001", resp.OrderQuantity.String(), "OrderQuantity")
+	r.Equal("60000", resp.OrderPrice.String(), "OrderPrice")
+	r.Equal("NEW", resp.CurrentExecType, "CurrentExecType")
+	r.Equal("NEW", resp.CurrentOrderStatus, "CurrentOrderStatus")
+	r.Equal(12, resp.OrderId, "OrderId")
+	r.Equal(77, resp.ExecutionId, "ExecutionId")
+	r.Equal(-1, resp.OrderListId, "OrderListId")
+	r.Equal(int64(1719487045223), resp.TransactionTime, "TransactionTime")
+	r.True(resp.IsInOrderBook, "IsInOrderBook")
+	r.Equal("EXPIRE_MAKER", resp.SelfTradePreventionMode, "SelfTradePreventionMode")
+
+	event := s.event()
+	r.Equal(MsgTypeExecutionReport, event.MsgType)
+	r.Equal("order-1", event.OrderUpdate.ClientOrderId)
+}
+
+func (s *orderTestSuite) TestEventsNotDrained() {
+	s.logon()
+	const orders = eventBufferSize + 6
+	go func() {
+		for i := 0; i < orders; i++ {
+			request := s.acceptor.next(MsgTypeNewOrderSingle)
+			if request == nil {
+				return
+			}
+			s.acceptor.send(executionReport(request))
+		}
+	}()
+	r := s.r()
+	for i := 0; i < orders; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		_, err := s.client.NewCreateOrder().Symbol("BTCUSDT").
+			Side(core.OrderSideBUY).
+			Type(core.OrderTypeLIMIT).
+			TimeInForce(core.TimeInForceGTC).
+			Quantity("0.001").
+			Price("60000").
+			NewClientOrderId("order-" + strconv.Itoa(i)).
+			Do(ctx)
+		cancel()
+		r.NoError(err, "order %d", i)
+	}
+	r.Equal(int64(orders-eventBufferSize), s.client.Dropped())
+	r.Len(s.client.Events(), eventBufferSize)
+}
+
+func (s *orderTestSuite) TestUndecodableExecutionReport() {
+	s.logon()
+	s.acceptor.send(NewMessage(MsgTypeExecutionReport).Set(TagClOrdID, "order-1").Set(TagCumQty, "abc"))
+	r := s.r()
+	event := s.event()
+	r.Equal(MsgTypeExecutionReport, event.MsgType)
+	r.Nil(event.OrderUpdate)
+	r.Equal("abc", event.Message.Get(TagCumQty))
+
+	// The session is still up.
+	s.acceptor.send(NewMessage(MsgTypeExecutionReport).Set(TagClOrdID, "order-2").Set(TagCumQty, "1"))
+	event = s.event()
+	r.Equal("order-2", event.OrderUpdate.ClientOrderId)
+	r.NoError(s.client.Err())
+}
+
+func (s *orderTestSuite) TestCreateOrderFields() {
+	s.logon()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+	_, _ = s.client.NewCreateOrder().Symbol("BTCUSDT").
+		Side(core.OrderSideSELL).
+		Type(core.OrderTypeSTOP_LOSS_LIMIT).
+		Quantity("0.001").
+		Price("59000").
+		StopPrice("59500").
+		IcebergQty("0.0005").
+		SelfTradePreventionMode(core.STPModeEXPIRE_BOTH).
+		Do(ctx)
+	request := s.acceptor.next(MsgTypeNewOrderSingle)
+	r := s.r()
+	r.NotNil(request)
+	r.Len(request.Get(TagClOrdID), 22, "ClOrdID")
+	r.Equal("2", request.Get(TagSide), "Side")
+	r.Equal("4", request.Get(TagOrdType), "OrdType")
+	r.Equal("4", request.Get(TagTriggerType), "TriggerType")
+	r.Equal("59500", request.Get(TagTriggerPrice), "TriggerPrice")
+	r.Equal("D", request.Get(TagTriggerPriceDirection), "TriggerPriceDirection")
+	r.Equal("0.0005", request.Get(TagMaxFloor), "MaxFloor")
+	r.Equal("4", request.Get(TagSelfTradePreventionMode), "SelfTradePreventionMode")
+	r.Equal("STOP_LOSS_LIMIT", orderType(request.Get(TagOrdType), "", request.Get(TagSide), request.Get(TagTriggerPriceDirection)))
+}
+
+func (s *orderTestSuite) TestCreateOrderRejected() {
+	s.logon()
+	go func() {
+		request := s.acceptor.next(MsgTypeNewOrderSingle)
+		if request != nil {
+			s.acceptor.send(executionReport(request).
+				Set(TagExecType, "8").
+				Set(TagOrdStatus, "8").
+				Set(TagErrorCode, -2010).
+				Set(TagText, "Account has insufficient balance for requested action."))
+		}
+	}()
+	resp, err := s.client.NewCreateOrder().Symbol("BTCUSDT").
+		Side(core.OrderSideBUY).
+		Type(core.OrderTypeMARKET).
+		Quantity("1000").
+		Do(context.Background())
+	r := s.r()
+	var reject *RejectError
+	r.ErrorAs(err, &reject)
+	r.Equal(int64(-2010), reject.Code, "Code")
+	r.Equal("REJECTED", resp.CurrentOrderStatus, "CurrentOrderStatus")
+	r.Equal("-2010", resp.OrderRejectReason, "OrderRejectReason")
+}
+
+// lockedBuffer A buffer for the debug log, which the session writes from its own goroutines.
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func (s *orderTestSuite) TestAudit() {
+	audit, debug := new(bytes.Buffer), new(lockedBuffer)
+	s.client.Opt.Audit = core.NewJsonlAuditSink(audit)
+	s.client.Opt.Logger = slog.New(slog.NewTextHandler(debug, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	s.logon()
+	go func() {
+		request := s.acceptor.next(MsgTypeNewOrderSingle)
+		if request != nil {
+			s.acceptor.send(executionReport(request).
+				Set(TagExecType, "8").
+				Set(TagOrdStatus, "8").
+				Set(TagErrorCode, -2010))
+		}
+	}()
+	_, err := s.client.NewCreateOrder().Symbol("BTCUSDT").
+		Side(core.OrderSideBUY).
+		Type(core.OrderTypeMARKET).
+		Quantity("1000").
+		NewClientOrderId("order-1").
+		Do(context.Background())
+	r := s.r()
+	r.Error(err)
+	var record core.AuditRecord
+	r.NoError(json.Unmarshal(audit.Bytes(), &record))
+	r.Equal(core.CallFix, record.Kind)
+	r.Equal(MsgTypeNewOrderSingle, record.Endpoint)
+	r.Equal("order-1", record.ClientOrderId)
+	r.Equal("BTCUSDT", record.Params[strconv.Itoa(TagSymbol)])
+	r.Equal(-2010, record.Code)
+	r.Contains(string(record.Response), "25016=-2010|")
+	r.NotContains(debug.String(), "YOUR_API_KEY", "debug logging leaves out the API key")
+	r.NotContains(debug.String(), s.acceptor.logon.Get(TagRawData), "debug logging leaves out the logon signature")
+	r.Contains(debug.String(), "553=[REDACTED]|")
+}
+
+type guardFunc func(intent *core.OrderIntent) error
+
+func (f guardFunc) Check(intent *core.OrderIntent) error {
+	return f(intent)
+}
+
+func (s *orderTestSuite) TestGuard() {
+	rejected := errors.New("rejected by guard")
+	var intents []*core.OrderIntent
+	s.client.Opt.Guard = guardFunc(func(intent *core.OrderIntent) error {
+		intents = append(intents, intent)
+		return rejected
+	})
+	s.logon()
+	_, err := s.client.NewCreateOrder().Symbol("BTCUSDT").
+		Side(core.OrderSideSELL).
+		Type(core.OrderTypeLIMIT).
+		TimeInForce(core.TimeInForceGTC).
+		Quantity("0.001").
+		Price("60000").
+		Do(context.Background())
+	r := s.r()
+	r.ErrorIs(err, rejected)
+	_, err = s.client.NewCreateOrderList().Symbol("BTCUSDT").
+		ContingencyType(ContingencyTypeOCO).
+		Order(&ListOrder{Side: core.OrderSideBUY, Type: core.OrderTypeLIMIT_MAKER, Quantity: "1", Price: "50000"}).
+		Order(&ListOrder{Side: core.OrderSideBUY, Type: core.OrderTypeSTOP_LOSS, Quantity: "1", StopPrice: "70000"}).
+		Do(context.Background())
+	r.ErrorIs(err, rejected)
+	r.Len(intents, 2)
+	r.Equal(&core.OrderIntent{MsgType: MsgTypeNewOrderSingle, Params: map[string]string{
+		"symbol": "BTCUSDT", "side": "SELL", "quantity": "0.001", "price": "60000",
+	}}, intents[0])
+	r.Equal(MsgTypeNewOrderList, intents[1].MsgType)
+	r.JSONEq(`[{"symbol":"BTCUSDT","side":"BUY","quantity":"1","price":"50000"},{"symbol":"BTCUSDT","side":"BUY","quantity":"1"}]`,
+		intents[1].Params["batchOrders"])
+	r.Empty(s.acceptor.received, "rejected orders are never sent")
+}
+
+func (s *orderTestSuite) TestSessionReject() {
+	s.logon()
+	go func() {
+		request := s.acceptor.next(MsgTypeNewOrderSingle)
+		if request != nil {
+			s.acceptor.send(NewMessage(MsgTypeReject).
+				Set(TagRefSeqNum, request.Get(TagMsgSeqNum)).
+				Set(TagErrorCode, -1102).
+				Set(TagText, "Field value was empty or malformed."))
+		}
+	}()
+	_, err := s.client.NewCreateOrder().Side(core.OrderSideBUY).Type(core.OrderTypeMARKET).Do(context.Background())
+	var reject *RejectError
+	s.r().ErrorAs(err, &reject)
+	s.r().Equal(MsgTypeReject, reject.MsgType, "MsgType")
+	s.r().Equal(int64(-1102), reject.Code, "Code")
+}
+
+func (s *orderTestSuite) TestCancelOrderRejected() {
+	s.logon()
+	go func() {
+		request := s.acceptor.next(MsgTypeOrderCancelRequest)
+		if request != nil {
+			s.acceptor.send(NewMessage(MsgTypeOrderCancelReject).
+				Set(TagClOrdID, request.Get(TagClOrdID)).
+				Set(TagOrigClOrdID, request.Get(TagOrigClOrdID)).
+				Set(TagSymbol, "BTCUSDT").
+				Set(TagErrorCode, -2011).
+				Set(TagText, "Unknown order sent."))
+		}
+	}()
+	_, err := s.client.NewCancelOrder().Symbol("BTCUSDT").
+		OrigClientOrderId("order-1").
+		CancelRestrictions(core.CancelRestrictionONLY_NEW).
+		Do(context.Background())
+	var reject *RejectError
+	s.r().ErrorAs(err, &reject)
+	s.r().Equal(MsgTypeOrderCancelReject, reject.MsgType, "MsgType")
+	s.r().Equal(int64(-2011), reject.Code, "Code")
+}
+
+func (s *orderTestSuite) TestCancelReplace() {
+	s.logon()
+	requests := make(chan *Message, 1)
+	go func() {
+		request := s.acceptor.next(MsgTypeOrderCancelRequestAndNew)
+		requests <- request
+		if request != nil {
+			s.acceptor.send(executionReport(request).
+				Set(TagClOrdID, request.Get(TagCancelClOrdID)).
+				Set(TagExecType, "4").
+				Set(TagOrdStatus, "4"))
+		}
+	}()
+	resp, err := s.client.NewCancelReplace().Symbol("BTCUSDT").
+		CancelReplaceMode(core.ReplaceModeALLOW_FAILURE).
+		CancelOrigClientOrderId("order-1").
+		NewClientOrderId("order-2").
+		Side(core.OrderSideBUY).
+		Type(core.OrderTypeLIMIT_MAKER).
+		Quantity("0.002").
+		Price("59000").
+		Do(context.Background())
+	r := s.r()
+	r.NoError(err)
+	r.Equal("CANCELED", resp.CurrentExecType, "CurrentExecType")
+	request := <-requests
+	r.Equal("2", request.Get(TagCancelReplaceMode), "CancelReplaceMode")
+	r.Equal("order-1", request.Get(TagOrigClOrdID), "OrigClOrdID")
+	r.Equal("order-2", request.Get(TagClOrdID), "ClOrdID")
+	r.Equal("2", request.Get(TagOrdType), "OrdType")
+	r.Equal("6", request.Get(TagExecInst), "ExecInst")
+}
+
+func (s *orderTestSuite) TestCreateOrderList() {
+	s.logon()
+	requests := make(chan *Message, 1)
+	go func() {
+		request := s.acceptor.next(MsgTypeNewOrderList)
+		requests <- request
+		if request == nil {
+			return
+		}
+		orders := request.Group(TagNoOrders, TagClOrdID, TagSymbol, TagSide, TagOrdType, TagOrderQty, TagPrice, TagExecInst,
+			TagTriggerType, TagTriggerAction, TagTriggerPrice, TagTriggerPriceType, TagTriggerPriceDirection,
+			TagNoListTriggers, TagListTriggerType, TagListTriggerTriggerIndex, TagListTriggerAction)
+		status := NewMessage(MsgTypeListStatus).
+			Set(TagSymbol, "BTCUSDT").
+			Set(TagListID, 3).
+			Set(TagClListID, request.Get(TagClListID)).
+			Set(TagContingencyType, request.Get(TagContingencyType)).
+			Set(TagListStatusType, "4").
+			Set(TagListOrderStatus, "3").
+			Set(TagTransactTime, "20240627-11:17:25.223000").
+			Set(TagNoOrders, len(orders))
+		for i, order := range orders {
+			status.Add(TagSymbol, "BTCUSDT").Add(TagOrderID, 20+i).Add(TagClOrdID, order.Get(TagClOrdID))
+		}
+		s.acceptor.send(status)
+	}()
+	resp, err := s.client.NewCreateOrderList().Symbol("BTCUSDT").
+		ListClientOrderId("list-1").
+		ContingencyType(ContingencyTypeOCO).
+		Order(&ListOrder{
+			ClientOrderId: "above",
+			Side:          core.OrderSideSELL,
+			Type:          core.OrderTypeLIMIT_MAKER,
+			Quantity:      "0.001",
+			Price:         "70000",
+			Triggers:      []ListTrigger{{Type: ListTriggerTypeACTIVATED, TriggerIndex: 1, Action: ListTriggerActionCANCEL}},
+		}).
+		Order(&ListOrder{
+			ClientOrderId: "below",
+			Side:          core.OrderSideSELL,
+			Type:          core.OrderTypeSTOP_LOSS,
+			Quantity:      "0.001",
+			StopPrice:     "55000",
+			Triggers:      []ListTrigger{{Type: ListTriggerTypeACTIVATED, TriggerIndex: 0, Action: ListTriggerActionCANCEL}},
+		}).
+		Do(context.Background())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(3, resp.OrderListId, "OrderListId")
+	r.Equal("list-1", resp.ClientOrderId, "ClientOrderId")
+	r.Equal("OCO", resp.ContingencyType, "ContingencyType")
+	r.Equal("EXEC_STARTED", resp.ListStatusType, "ListStatusType")
+	r.Equal("EXECUTING", resp.ListOrderStatus, "ListOrderStatus")
+	r.Equal([]spot.ListStatusObj{
+		{Symbol: "BTCUSDT", OrderId: 20, ClientOrderId: "above"},
+		{Symbol: "BTCUSDT", OrderId: 21, ClientOrderId: "below"},
+	}, resp.ListStatusObj)
+
+	request := <-requests
+	r.Equal("1", request.Get(TagContingencyType), "ContingencyType")
+	r.Equal("2", request.Get(TagNoOrders), "NoOrders")
+	orders := request.Group(TagNoOrders, TagClOrdID, TagSymbol, TagSide, TagOrdType, TagOrderQty, TagPrice, TagExecInst,
+		TagTriggerType, TagTriggerAction, TagTriggerPrice, TagTriggerPriceType, TagTriggerPriceDirection,
+		TagNoListTriggers, TagListTriggerType, TagListTriggerTriggerIndex, TagListTriggerAction)
+	r.Len(orders, 2)
+	r.Equal("6", orders[0].Get(TagExecInst), "ExecInst")
+	r.Equal("1", orders[0].Get(TagListTriggerTriggerIndex), "ListTriggerTriggerIndex")
+	r.Equal("55000", orders[1].Get(TagTriggerPrice), "TriggerPrice")
+	r.Equal("D", orders[1].Get(TagTriggerPriceDirection), "TriggerPriceDirection")
+}
+
+func (s *orderTestSuite) TestCreateOrderListEncoding() {
+	builder := s.client.NewCreateOrderList().Symbol("BTCUSDT").
+		ListClientOrderId("list-1").
+		ContingencyType(ContingencyTypeOCO).
+		Order(&ListOrder{Side: core.OrderSideSELL, Type: core.OrderTypeLIMIT_MAKER, Quantity: "0.001", Price: "70000"}).
+		Order(&ListOrder{Side: core.OrderSideSELL, Type: core.OrderTypeSTOP_LOSS, Quantity: "0.001", StopPrice: "55000"})
+	r := s.r()
+	builder.message()
+	decoded, err := readMessage(bufio.NewReader(bytes.NewReader(builder.message().encode(Field{Tag: TagMsgSeqNum, Value: "2"}))))
+	r.NoError(err)
+	r.Equal("2", decoded.Get(TagNoOrders), "NoOrders")
+	for _, f := range decoded.fields {
+		if f.Tag == TagNoOrders {
+			break
+		}
+		r.NotEqual(TagSymbol, f.Tag, "Symbol before NoOrders")
+	}
+	orders := decoded.Group(TagNoOrders, TagClOrdID, TagSymbol, TagSide, TagOrdType, TagOrderQty, TagPrice, TagExecInst,
+		TagTriggerType, TagTriggerAction, TagTriggerPrice, TagTriggerPriceType, TagTriggerPriceDirection)
+	r.Len(orders, 2, "the entries of an earlier build are not sent again")
+	for _, order := range orders {
+		r.Equal("BTCUSDT", order.Get(TagSymbol), "Symbol")
+		r.NotEmpty(order.Get(TagClOrdID), "ClOrdID")
+	}
+	r.Equal("70000", orders[0].Get(TagPrice), "Price")
+	r.Equal("55000", orders[1].Get(TagTriggerPrice), "TriggerPrice")
+}
+
+func (s *orderTestSuite) TestNewOrderUpdateTrade() {
+	m := NewMessage(MsgTypeExecutionReport).
+		Set(TagClOrdID, "order-1").
+		Set(TagOrderID, 12).
+		Set(TagSymbol, "BTCUSDT").
+		Set(TagSide, "2").
+		Set(TagOrdType, "3").
+		Set(TagTriggerPriceDirection, "U").
+		Set(TagOrderQty, "0.002").
+		Set(TagLastQty, "0.001").
+		Set(TagLastPx, "60000").
+		Set(TagCumQty, "0.001").
+		Set(TagCumQuoteQty, "60").
+		Set(TagExecType, "F").
+		Set(TagOrdStatus, "1").
+		Set(TagTradeID, 501).
+		Set(TagAggressorIndicator, "N").
+		Set(TagListID, 3).
+		Set(TagNoMiscFees, 1).
+		Set(TagMiscFeeAmt, "0.06").
+		Set(TagMiscFeeCurr, "USDT").
+		Set(TagMiscFeeType, 4)
+	update, err := NewOrderUpdate(m)
+	r := s.r()
+	r.NoError(err)
+	r.Equal("TAKE_PROFIT", update.OrderType, "OrderType")
+	r.Equal("TRADE", update.CurrentExecType, "CurrentExecType")
+	r.Equal("PARTIALLY_FILLED", update.CurrentOrderStatus, "CurrentOrderStatus")
+	r.Equal(501, update.TradeId, "TradeId")
+	r.Equal(3, update.OrderListId, "OrderListId")
+	r.True(update.IsMaker, "IsMaker")
+	r.Equal("60", update.LatestQuoteVolume.String(), "LatestQuoteVolume")
+	r.Equal("0.06", update.CommissionAmount.String(), "CommissionAmount")
+	r.Equal("USDT", update.CommissionAsset, "CommissionAsset")
+}
@@ -223,13 +223,15 @@ type LeverageBracket struct {
 	r *core.Request
 }
 
+// Bracket A notional tier of a leverage bracket. The notional bounds, maintenance margin ratio and cum are decimals:
+// Binance sends them as fractional numbers such as "cum":0.0, which do not decode into int.
 type Bracket struct {
-	Bracket          int     `json:"bracket"`
-	InitialLeverage  int     `json:"initialLeverage"`
-	NotionalCap      int     `json:"notionalCap"`
-	NotionalFloor    int     `json:"notionalFloor"`
-	MaintMarginRatio float64 `json:"maintMarginRatio"`
-	Cum              int     `json:"cum"`
+	Bracket          int             `json:"bracket"`
+	InitialLeverage  int             `json:"initialLeverage"`
+	NotionalCap      decimal.Decimal `json:"notionalCap"`
+	NotionalFloor    decimal.Decimal `json:"notionalFloor"`
+	MaintMarginRatio decimal.Decimal `json:"maintMarginRatio"`
+	Cum              decimal.Decimal `json:"cum"`
 }
 type LeverageBracketResponse struct {
 	Symbol       string     `json:"symbol"`
@@ -0,0 +1,100 @@
+package futures
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+const (
+	maxBatchOrders          = 5
+	maxBatchCancel          = 10
+	defaultBatchConcurrency = 4
+)
+
+// BatchItemError The failure of a single item of a batch request. Code and Msg are set when Binance
+// rejected the item, Err when the whole chunk the item was sent in failed.
+type BatchItemError struct {
+	Index int
+	Code  int
+	Msg   string
+	Err   error
+}
+
+func (e *BatchItemError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("batch item %d: %v", e.Index, e.Err)
+	}
+	return fmt.Sprintf("batch item %d: code=%d, msg=%s", e.Index, e.Code, e.Msg)
+}
+
+func (e *BatchItemError) Unwrap() error {
+	return e.Err
+}
+
+type batchChunk struct {
+	start, end int
+}
+
+// splitBatch bounds of the chunks of size covering [offset, offset+n).
+func splitBatch(offset, n, size int) []batchChunk {
+	chunks := make([]batchChunk, 0, (n+size-1)/size)
+	for start := 0; start < n; start += size {
+		end := min(start+size, n)
+		chunks = append(chunks, batchChunk{start: offset + start, end: offset + end})
+	}
+	return chunks
+}
+
+// dispatchBatch runs send for every chunk with at most concurrency chunks in flight. Each concurrent
+// send gets a client of its own, a single chunk is sent with c so that RawBody keeps working.
+// The failure of a chunk is passed to fail; an error is returned only if no chunk could be sent.
+func (c *Client) dispatchBatch(ctx context.Context, chunks []batchChunk, concurrency int,
+	send func(ctx context.Context, c *Client, chunk batchChunk) error, fail func(chunk batchChunk, err error)) error {
+	if len(chunks) == 1 {
+		if err := send(ctx, c, chunks[0]); err != nil {
+			fail(chunks[0], err)
+			return err
+		}
+		return nil
+	}
+	if concurrency <= 0 {
+		concurrency = defaultBatchConcurrency
+	}
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		failed   int
+		firstErr error
+	)
+	sem := make(chan struct{}, concurrency)
+	for _, chunk := range chunks {
+		wg.Add(1)
+		go func(chunk batchChunk) {
+			defer wg.Done()
+			var err error
+			select {
+			case sem <- struct{}{}:
+				err = send(ctx, &Client{c.Clone()}, chunk)
+				<-sem
+			case <-ctx.Done():
+				err = ctx.Err()
+			}
+			if err == nil {
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			failed++
+			if firstErr == nil {
+				firstErr = err
+			}
+			fail(chunk, err)
+		}(chunk)
+	}
+	wg.Wait()
+	if failed == len(chunks) {
+		return firstErr
+	}
+	return nil
+}
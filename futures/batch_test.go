@@ -0,0 +1,181 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type batchTestSuite struct {
+	baseHttpTestSuite
+}
+
+func TestBatch(t *testing.T) {
+	suite.Run(t, new(batchTestSuite))
+}
+
+func (s *batchTestSuite) TestSplitBatch() {
+	r := s.r()
+	r.Equal([]batchChunk{{0, 5}, {5, 10}, {10, 12}}, splitBatch(0, 12, 5))
+	r.Equal([]batchChunk{{3, 5}}, splitBatch(3, 2, 10))
+	r.Empty(splitBatch(0, 0, 5))
+}
+
+func (s *batchTestSuite) TestPlaceBatchOrderChunks() {
+	var inFlight, maxInFlight, requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		var orders []OrderReq
+		if err := json.Unmarshal([]byte(r.URL.Query().Get("batchOrders")), &orders); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if len(orders) > maxBatchOrders {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code":-4079,"msg":"too many orders"}`))
+			return
+		}
+		if orders[0].NewClientOrderId == "order-5" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}`))
+			return
+		}
+		items := make([]string, 0, len(orders))
+		for _, o := range orders {
+			if o.NewClientOrderId == "order-11" {
+				items = append(items, `{"code":-2019,"msg":"Margin is insufficient."}`)
+				continue
+			}
+			items = append(items, fmt.Sprintf(`{"clientOrderId":%q,"status":"NEW"}`, o.NewClientOrderId))
+		}
+		w.Write([]byte("[" + strings.Join(items, ",") + "]"))
+	}))
+	defer server.Close()
+	s.client.Opt.Endpoint = server.URL
+
+	orders := make([]OrderReq, 13)
+	for i := range orders {
+		orders[i] = OrderReq{Symbol: "BTCUSDT", Side: "BUY", OrderType: "MARKET", Quantity: "1", NewClientOrderId: fmt.Sprintf("order-%d", i)}
+	}
+	resp, err := s.client.NewPlaceBatchOrder().BatchOrders(orders).Concurrency(2).Do(context.Background())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int32(3), requests.Load())
+	r.LessOrEqual(maxInFlight.Load(), int32(2))
+	r.Len(resp, len(orders))
+	for i, item := range resp {
+		switch {
+		case i >= 5 && i < 10:
+			r.NotNil(item.Err, "chunk failure is reported on every item")
+			r.Equal(i, item.Err.Index)
+			r.Error(errors.Unwrap(item.Err))
+		case i == 11:
+			r.Equal(&BatchItemError{Index: 11, Code: -2019, Msg: "Margin is insufficient."}, item.Err)
+		default:
+			r.Nil(item.Err)
+			r.Equal(fmt.Sprintf("order-%d", i), item.ClientOrderId)
+		}
+	}
+}
+
+func (s *batchTestSuite) TestPlaceBatchOrderAllFailed() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":-2015,"msg":"Invalid API-key, IP, or permissions for action."}`))
+	}))
+	defer server.Close()
+	s.client.Opt.Endpoint = server.URL
+	orders := make([]OrderReq, 7)
+	resp, err := s.client.NewPlaceBatchOrder().BatchOrders(orders).Do(context.Background())
+	r := s.r()
+	r.Error(err)
+	r.Len(resp, 7)
+	for _, item := range resp {
+		r.NotNil(item.Err)
+	}
+	_, err = s.client.NewPlaceBatchOrder().Do(context.Background())
+	r.Error(err)
+}
+
+func (s *batchTestSuite) TestCancelMultipleOrderChunks() {
+	var mu sync.Mutex
+	lists := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		mu.Lock()
+		lists = append(lists, query.Get("orderIdList")+query.Get("origClientOrderIdList"))
+		mu.Unlock()
+		items := make([]string, 0)
+		if ids := query.Get("orderIdList"); ids != "" {
+			var orderIds []int64
+			if err := json.Unmarshal([]byte(ids), &orderIds); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			for _, id := range orderIds {
+				items = append(items, fmt.Sprintf(`{"orderId":%d,"status":"CANCELED"}`, id))
+			}
+		} else {
+			var clientIds []string
+			if err := json.Unmarshal([]byte(query.Get("origClientOrderIdList")), &clientIds); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			for _, id := range clientIds {
+				items = append(items, fmt.Sprintf(`{"clientOrderId":%q,"status":"CANCELED"}`, id))
+			}
+		}
+		w.Write([]byte("[" + strings.Join(items, ",") + "]"))
+	}))
+	defer server.Close()
+	s.client.Opt.Endpoint = server.URL
+
+	orderIds := make([]int64, 23)
+	for i := range orderIds {
+		orderIds[i] = int64(i + 1)
+	}
+	clientIds := make([]string, 12)
+	for i := range clientIds {
+		clientIds[i] = fmt.Sprintf("c%d", i)
+	}
+	resp, err := s.client.NewCancelMultipleOrder().Symbol("BTCUSDT").
+		OrderIdList(orderIds).
+		OrigClientOrderIdList(clientIds).
+		DoBatch(context.Background())
+	r := s.r()
+	r.NoError(err)
+	r.ElementsMatch([]string{
+		"[1,2,3,4,5,6,7,8,9,10]",
+		"[11,12,13,14,15,16,17,18,19,20]",
+		"[21,22,23]",
+		`["c0","c1","c2","c3","c4","c5","c6","c7","c8","c9"]`,
+		`["c10","c11"]`,
+	}, lists, "at most 10 ids per request, order ids and client ids are never mixed")
+	r.Len(resp, 35)
+	for i := 0; i < 23; i++ {
+		r.Nil(resp[i].Err)
+		r.Equal(i+1, resp[i].OrderId, "result %d aligned with its order id", i)
+	}
+	for i := range clientIds {
+		r.Nil(resp[23+i].Err)
+		r.Equal(clientIds[i], resp[23+i].ClientOrderId, "result %d aligned with its client order id", 23+i)
+	}
+}
@@ -0,0 +1,702 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/shopspring/decimal"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type BracketStatus string
+
+const (
+	BracketStatusPENDING  BracketStatus = "PENDING"
+	BracketStatusOPEN     BracketStatus = "OPEN"
+	BracketStatusCLOSED   BracketStatus = "CLOSED"
+	BracketStatusCANCELED BracketStatus = "CANCELED"
+)
+
+const (
+	bracketEntry      = "en"
+	bracketTakeProfit = "tp"
+	bracketStopLoss   = "sl"
+)
+
+var bracketSeq atomic.Int64
+
+// BracketOrderReq An entry order protected by a TAKE_PROFIT_MARKET and a STOP_MARKET leg.
+type BracketOrderReq struct {
+	Id           string // optional, generated when empty; at most 28 characters
+	Symbol       string
+	Side         core.OrderSideEnum
+	PositionSide core.PositionSideEnum
+	Type         core.OrderTypeEnum // entry type, LIMIT or MARKET
+	TimeInForce  core.TimeInForceEnum
+	Quantity     decimal.Decimal
+	Price        decimal.Decimal // entry price, LIMIT only
+	TakeProfit   decimal.Decimal // stop price of the take-profit leg
+	StopLoss     decimal.Decimal // stop price of the stop-loss leg
+	WorkingType  core.WorkingType
+}
+
+type BracketLeg struct {
+	ClientOrderId string
+	OrderId       int64
+	Quantity      decimal.Decimal
+	StopPrice     decimal.Decimal
+	Status        string
+	revision      int
+}
+
+// BracketOrder The state of one entry with its take-profit and stop-loss legs.
+// Filled follows the accumulated quantity of the entry; the legs are kept sized to it.
+type BracketOrder struct {
+	Id           string
+	Symbol       string
+	Side         core.OrderSideEnum
+	PositionSide core.PositionSideEnum
+	WorkingType  core.WorkingType
+	Quantity     decimal.Decimal
+	Filled       decimal.Decimal
+	Status       BracketStatus
+	Entry        *BracketLeg
+	TakeProfit   *BracketLeg
+	StopLoss     *BracketLeg
+}
+
+// BracketManager Places brackets and keeps their legs consistent from ORDER_TRADE_UPDATE events:
+// the sibling is canceled when a leg fills, and both legs are resized on partial entry fills.
+// USDⓈ-M futures has no native OCO, so the manager is the only thing linking the legs.
+type BracketManager struct {
+	c        *Client
+	mu       sync.Mutex // guards brackets and their snapshots, never held across a request
+	brackets map[string]*bracketState
+	// OnError receives failures raised while reacting to user data events.
+	OnError func(b *BracketOrder, err error)
+}
+
+// bracketState The working copy of one bracket. mu serializes its events and is held across its requests,
+// so a slow request only delays this bracket; Get and Brackets read the snapshot published after each change.
+type bracketState struct {
+	mu       sync.Mutex
+	order    *BracketOrder
+	snapshot BracketOrder
+}
+
+// NewBracketManager Bracket order manager on top of the futures trade endpoints
+func (c *Client) NewBracketManager() *BracketManager {
+	return &BracketManager{c: c, brackets: make(map[string]*bracketState)}
+}
+
+// track Register b with its lock held, events for it wait until the caller releases it.
+func (m *BracketManager) track(b *BracketOrder) *bracketState {
+	st := &bracketState{order: b, snapshot: b.clone()}
+	st.mu.Lock()
+	m.mu.Lock()
+	m.brackets[b.Id] = st
+	m.mu.Unlock()
+	return st
+}
+
+// untrack Forget a bracket that could not be set up.
+func (m *BracketManager) untrack(st *bracketState) {
+	m.mu.Lock()
+	if m.brackets[st.order.Id] == st {
+		delete(m.brackets, st.order.Id)
+	}
+	m.mu.Unlock()
+	st.mu.Unlock()
+}
+
+// release Publish the working copy and let the next event through.
+func (m *BracketManager) release(st *bracketState) {
+	snapshot := st.order.clone()
+	m.mu.Lock()
+	st.snapshot = snapshot
+	m.mu.Unlock()
+	st.mu.Unlock()
+}
+
+func (m *BracketManager) state(id string) *bracketState {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.brackets[id]
+}
+
+// acquire Lock the bracket with the given id, nil when it is unknown or was replaced while waiting.
+func (m *BracketManager) acquire(id string) *bracketState {
+	st := m.state(id)
+	if st == nil {
+		return nil
+	}
+	st.mu.Lock()
+	if m.state(id) != st {
+		st.mu.Unlock()
+		return nil
+	}
+	return st
+}
+
+func newBracketId() string {
+	return "bk" + strconv.FormatInt(time.Now().UnixNano(), 36) + strconv.FormatInt(bracketSeq.Add(1)%1296, 36)
+}
+
+func bracketClientId(id, leg string, revision int) string {
+	if leg == bracketEntry {
+		return fmt.Sprintf("%s-%s", id, leg)
+	}
+	return fmt.Sprintf("%s-%s%d", id, leg, revision)
+}
+
+// parseBracketClientId splits a client order id produced by bracketClientId.
+func parseBracketClientId(clientOrderId string) (id, leg string, revision int, ok bool) {
+	idx := strings.LastIndex(clientOrderId, "-")
+	if idx <= 0 || len(clientOrderId)-idx < 3 {
+		return "", "", 0, false
+	}
+	id, suffix := clientOrderId[:idx], clientOrderId[idx+1:]
+	leg = suffix[:2]
+	switch leg {
+	case bracketEntry:
+		return id, leg, 0, len(suffix) == 2
+	case bracketTakeProfit, bracketStopLoss:
+		rev, err := strconv.Atoi(suffix[2:])
+		if err != nil {
+			return "", "", 0, false
+		}
+		return id, leg, rev, true
+	}
+	return "", "", 0, false
+}
+
+func oppositeSide(side core.OrderSideEnum) core.OrderSideEnum {
+	if side == core.OrderSideBUY {
+		return core.OrderSideSELL
+	}
+	return core.OrderSideBUY
+}
+
+func (b *BracketOrder) legOrder(leg *BracketLeg, orderType core.OrderTypeEnum) OrderReq {
+	order := OrderReq{
+		Symbol:           b.Symbol,
+		Side:             oppositeSide(b.Side),
+		PositionSide:     b.PositionSide,
+		OrderType:        orderType,
+		Quantity:         leg.Quantity.String(),
+		StopPrice:        leg.StopPrice.String(),
+		NewClientOrderId: leg.ClientOrderId,
+		WorkingType:      b.WorkingType,
+	}
+	// reduceOnly cannot be sent in Hedge Mode, the position side already restricts the leg there.
+	if b.PositionSide == "" || b.PositionSide == core.PositionSide_BOTH {
+		order.ReduceOnly = "true"
+	}
+	return order
+}
+
+func (b *BracketOrder) terminal() bool {
+	return b.Status == BracketStatusCLOSED || b.Status == BracketStatusCANCELED
+}
+
+func (b *BracketOrder) clone() BracketOrder {
+	c := *b
+	for _, leg := range []**BracketLeg{&c.Entry, &c.TakeProfit, &c.StopLoss} {
+		if *leg != nil {
+			copied := **leg
+			*leg = &copied
+		}
+	}
+	return c
+}
+
+// Place Submit the entry, take-profit and stop-loss in a single batch.
+// If any leg is rejected the accepted ones are canceled and the rejection is returned.
+func (m *BracketManager) Place(ctx context.Context, req *BracketOrderReq) (*BracketOrder, error) {
+	if req.Quantity.Sign() <= 0 {
+		return nil, errors.New("bracket: quantity must be positive")
+	}
+	if req.TakeProfit.Sign() <= 0 || req.StopLoss.Sign() <= 0 {
+		return nil, errors.New("bracket: take profit and stop loss prices are required")
+	}
+	id := req.Id
+	if id == "" {
+		id = newBracketId()
+	}
+	entryType := req.Type
+	if entryType == "" {
+		entryType = core.OrderTypeMARKET
+	}
+	b := &BracketOrder{
+		Id:           id,
+		Symbol:       req.Symbol,
+		Side:         req.Side,
+		PositionSide: req.PositionSide,
+		WorkingType:  req.WorkingType,
+		Quantity:     req.Quantity,
+		Status:       BracketStatusPENDING,
+		Entry:        &BracketLeg{ClientOrderId: bracketClientId(id, bracketEntry, 0), Quantity: req.Quantity},
+		TakeProfit:   &BracketLeg{ClientOrderId: bracketClientId(id, bracketTakeProfit, 0), Quantity: req.Quantity, StopPrice: req.TakeProfit},
+		StopLoss:     &BracketLeg{ClientOrderId: bracketClientId(id, bracketStopLoss, 0), Quantity: req.Quantity, StopPrice: req.StopLoss},
+	}
+	entry := OrderReq{
+		Symbol:           req.Symbol,
+		Side:             req.Side,
+		PositionSide:     req.PositionSide,
+		OrderType:        entryType,
+		TimeInForce:      req.TimeInForce,
+		Quantity:         req.Quantity.String(),
+		NewClientOrderId: b.Entry.ClientOrderId,
+	}
+	if !req.Price.IsZero() {
+		entry.Price = req.Price.String()
+	}
+	orders := []OrderReq{
+		entry,
+		b.legOrder(b.TakeProfit, core.OrderTypeTAKE_PROFIT_MARKET),
+		b.legOrder(b.StopLoss, core.OrderTypeSTOP_MARKET),
+	}
+	st := m.track(b)
+	resp, err := m.c.NewPlaceBatchOrder().BatchOrders(orders).Do(ctx)
+	if err != nil {
+		m.untrack(st)
+		return nil, err
+	}
+	if len(resp) != len(orders) {
+		m.untrack(st)
+		return nil, fmt.Errorf("bracket: expected %d batch results, got %d", len(orders), len(resp))
+	}
+	var rejected error
+	for i, leg := range []*BracketLeg{b.Entry, b.TakeProfit, b.StopLoss} {
+		if resp[i].Code != 0 {
+			if rejected == nil {
+				rejected = fmt.Errorf("bracket: %s rejected: code=%d, msg=%s", leg.ClientOrderId, resp[i].Code, resp[i].Msg)
+			}
+			continue
+		}
+		leg.OrderId = int64(resp[i].OrderId)
+		leg.Status = resp[i].Status
+	}
+	if rejected != nil {
+		defer m.untrack(st)
+		for _, leg := range []*BracketLeg{b.Entry, b.TakeProfit, b.StopLoss} {
+			if leg.OrderId != 0 {
+				if err := m.cancelLeg(ctx, b, leg); err != nil {
+					return nil, errors.Join(rejected, err)
+				}
+			}
+		}
+		return nil, rejected
+	}
+	b.Status = BracketStatusOPEN
+	placed := b.clone()
+	m.release(st)
+	return &placed, nil
+}
+
+// Handle Apply a user data event. Events that do not belong to a known bracket are ignored.
+func (m *BracketManager) Handle(ctx context.Context, event *UserDataEvent) error {
+	if event == nil || event.Event != ORDER_TRADE_UPDATE {
+		return nil
+	}
+	o := event.OrderTradeUpdate.O
+	id, leg, revision, ok := parseBracketClientId(o.ClientOrderId)
+	if !ok {
+		return nil
+	}
+	st := m.acquire(id)
+	if st == nil {
+		return nil
+	}
+	defer m.release(st)
+	b := st.order
+	if b.terminal() {
+		return nil
+	}
+	switch leg {
+	case bracketEntry:
+		return m.onEntryUpdate(ctx, b, &o)
+	case bracketTakeProfit, bracketStopLoss:
+		current := b.leg(leg)
+		if revision != current.revision {
+			// A replaced leg can still fill before its cancel lands, which closes the position all the same.
+			if o.OrderStatus != "FILLED" && o.OrderStatus != "PARTIALLY_FILLED" {
+				return nil
+			}
+			current = &BracketLeg{ClientOrderId: o.ClientOrderId, revision: revision}
+		}
+		return m.onLegUpdate(ctx, b, current, &o)
+	}
+	return nil
+}
+
+// Run Feed events from a user data stream into Handle until ctx is done or the stream ends.
+func (m *BracketManager) Run(ctx context.Context, onMessage <-chan *UserDataEvent) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-onMessage:
+			if !ok {
+				return nil
+			}
+			if err := m.Handle(ctx, event); err != nil && m.OnError != nil {
+				m.OnError(m.bracketOf(event), err)
+			}
+		}
+	}
+}
+
+func (m *BracketManager) bracketOf(event *UserDataEvent) *BracketOrder {
+	id, _, _, ok := parseBracketClientId(event.OrderTradeUpdate.O.ClientOrderId)
+	if !ok {
+		return nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	st, ok := m.brackets[id]
+	if !ok {
+		return nil
+	}
+	b := st.snapshot
+	return &b
+}
+
+func (m *BracketManager) onEntryUpdate(ctx context.Context, b *BracketOrder, o *UpdateOrder) error {
+	b.Entry.OrderId = int64(o.OrderId)
+	b.Entry.Status = o.OrderStatus
+	if o.AccumulatedQuantity.GreaterThan(b.Filled) {
+		b.Filled = o.AccumulatedQuantity
+	}
+	switch o.OrderStatus {
+	case "PARTIALLY_FILLED", "FILLED":
+		return m.resize(ctx, b, b.Filled)
+	case "CANCELED", "EXPIRED", "REJECTED", "EXPIRED_IN_MATCH":
+		if b.Filled.IsZero() {
+			b.Status = BracketStatusCANCELED
+			return errors.Join(m.cancelLeg(ctx, b, b.TakeProfit), m.cancelLeg(ctx, b, b.StopLoss))
+		}
+		// The unfilled remainder is gone, protect only what was filled.
+		return m.resize(ctx, b, b.Filled)
+	}
+	return nil
+}
+
+func (m *BracketManager) onLegUpdate(ctx context.Context, b *BracketOrder, leg *BracketLeg, o *UpdateOrder) error {
+	leg.OrderId = int64(o.OrderId)
+	leg.Status = o.OrderStatus
+	stale := leg != b.TakeProfit && leg != b.StopLoss
+	switch o.OrderStatus {
+	case "PARTIALLY_FILLED":
+		if !stale {
+			return nil
+		}
+		fallthrough
+	case "FILLED":
+		b.Status = BracketStatusCLOSED
+		var errs []error
+		if b.Entry.Status == "NEW" || b.Entry.Status == "PARTIALLY_FILLED" {
+			errs = append(errs, m.cancelLeg(ctx, b, b.Entry))
+		}
+		for _, other := range []*BracketLeg{b.TakeProfit, b.StopLoss} {
+			if other != leg {
+				errs = append(errs, m.cancelLeg(ctx, b, other))
+			}
+		}
+		return errors.Join(errs...)
+	case "CANCELED", "EXPIRED", "REJECTED", "EXPIRED_IN_MATCH":
+		// Canceled outside the manager, the bracket is no longer protected on this side.
+		sibling := b.TakeProfit
+		if leg == b.TakeProfit {
+			sibling = b.StopLoss
+		}
+		if sibling.Status == "CANCELED" || sibling.Status == "FILLED" {
+			b.Status = BracketStatusCANCELED
+		}
+	}
+	return nil
+}
+
+// resize Replace both legs so that they cover qty. Conditional orders cannot be modified, so each leg is placed again
+// and the old one canceled afterwards: a failed placement leaves the old leg protecting the position.
+func (m *BracketManager) resize(ctx context.Context, b *BracketOrder, qty decimal.Decimal) error {
+	if qty.IsZero() {
+		return nil
+	}
+	for _, name := range []string{bracketTakeProfit, bracketStopLoss} {
+		leg := b.leg(name)
+		if leg.Quantity.Equal(qty) {
+			continue
+		}
+		next, err := m.placeLeg(ctx, b, name, leg.StopPrice, qty, leg.revision+1)
+		if err != nil {
+			return err
+		}
+		b.setLeg(name, next)
+		if err := m.cancelLeg(ctx, b, leg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// placeLeg Place the take-profit or stop-loss leg of b at the given revision.
+func (m *BracketManager) placeLeg(ctx context.Context, b *BracketOrder, name string, stopPrice, qty decimal.Decimal, revision int) (*BracketLeg, error) {
+	next := &BracketLeg{
+		ClientOrderId: bracketClientId(b.Id, name, revision),
+		Quantity:      qty,
+		StopPrice:     stopPrice,
+		revision:      revision,
+	}
+	orderType := core.OrderTypeEnum(core.OrderTypeSTOP_MARKET)
+	if name == bracketTakeProfit {
+		orderType = core.OrderTypeTAKE_PROFIT_MARKET
+	}
+	order := b.legOrder(next, orderType)
+	resp, err := m.createOrder(ctx, &order)
+	if err != nil {
+		return nil, err
+	}
+	next.OrderId = int64(resp.OrderId)
+	next.Status = resp.Status
+	return next, nil
+}
+
+func (b *BracketOrder) leg(name string) *BracketLeg {
+	if name == bracketTakeProfit {
+		return b.TakeProfit
+	}
+	return b.StopLoss
+}
+
+func (b *BracketOrder) setLeg(name string, leg *BracketLeg) {
+	if name == bracketTakeProfit {
+		b.TakeProfit = leg
+	} else {
+		b.StopLoss = leg
+	}
+}
+
+func (m *BracketManager) createOrder(ctx context.Context, order *OrderReq) (*OrderResponse, error) {
+	builder := m.c.NewCreateOrder().Symbol(order.Symbol).
+		Side(order.Side).
+		Type(order.OrderType).
+		Quantity(order.Quantity).
+		StopPrice(order.StopPrice).
+		NewClientOrderId(order.NewClientOrderId)
+	if order.PositionSide != "" {
+		builder.PositionSide(order.PositionSide)
+	}
+	if order.ReduceOnly != "" {
+		builder.ReduceOnly(order.ReduceOnly)
+	}
+	if order.WorkingType != "" {
+		builder.WorkingType(order.WorkingType)
+	}
+	return builder.Do(ctx)
+}
+
+func (m *BracketManager) cancelLeg(ctx context.Context, b *BracketOrder, leg *BracketLeg) error {
+	if leg == nil || leg.Status == "CANCELED" || leg.Status == "FILLED" || leg.Status == "EXPIRED" {
+		return nil
+	}
+	req := m.c.NewCancelOrder().Symbol(b.Symbol)
+	if leg.OrderId != 0 {
+		req.OrderId(leg.OrderId)
+	} else {
+		req.OrigClientOrderId(leg.ClientOrderId)
+	}
+	if _, err := req.Do(ctx); err != nil {
+		return fmt.Errorf("bracket: cancel %s: %w", leg.ClientOrderId, err)
+	}
+	leg.Status = "CANCELED"
+	return nil
+}
+
+// Cancel Cancel every open order of the bracket.
+func (m *BracketManager) Cancel(ctx context.Context, id string) error {
+	st := m.acquire(id)
+	if st == nil {
+		return fmt.Errorf("bracket: %s not found", id)
+	}
+	defer m.release(st)
+	b := st.order
+	if b.terminal() {
+		return nil
+	}
+	b.Status = BracketStatusCANCELED
+	return errors.Join(m.cancelLeg(ctx, b, b.Entry), m.cancelLeg(ctx, b, b.TakeProfit), m.cancelLeg(ctx, b, b.StopLoss))
+}
+
+// Get Return a copy of the bracket state.
+func (m *BracketManager) Get(id string) (BracketOrder, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	st, ok := m.brackets[id]
+	if !ok {
+		return BracketOrder{}, false
+	}
+	return st.snapshot, true
+}
+
+// Brackets Return a copy of every bracket that is still open.
+func (m *BracketManager) Brackets() []BracketOrder {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := make([]BracketOrder, 0, len(m.brackets))
+	for _, st := range m.brackets {
+		if !st.snapshot.terminal() {
+			result = append(result, st.snapshot)
+		}
+	}
+	return result
+}
+
+// Recover Rebuild bracket state from the open orders of symbol, e.g. after a restart.
+// An entry that is no longer open is queried for what it executed; if nothing was, the legs are canceled.
+// When a leg is gone while the entry is no longer open, the missing leg is looked up: if it filled the position is closed
+// and the remaining leg is canceled, otherwise it was canceled outside the manager and is placed again.
+func (m *BracketManager) Recover(ctx context.Context, symbol string) ([]BracketOrder, error) {
+	orders, err := m.c.NewAllOpenOrder().Symbol(symbol).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]*BracketOrder)
+	for _, o := range orders {
+		id, leg, revision, ok := parseBracketClientId(o.ClientOrderId)
+		if !ok {
+			continue
+		}
+		b, ok := found[id]
+		if !ok {
+			b = &BracketOrder{
+				Id:           id,
+				Symbol:       o.Symbol,
+				PositionSide: core.PositionSideEnum(o.PositionSide),
+				WorkingType:  core.WorkingType(o.WorkingType),
+				Status:       BracketStatusOPEN,
+			}
+			found[id] = b
+		}
+		current := &BracketLeg{
+			ClientOrderId: o.ClientOrderId,
+			OrderId:       int64(o.OrderId),
+			Quantity:      o.OrigQty,
+			StopPrice:     o.StopPrice,
+			Status:        o.Status,
+			revision:      revision,
+		}
+		switch leg {
+		case bracketEntry:
+			b.Entry = current
+			b.Side = core.OrderSideEnum(o.Side)
+			b.Quantity = o.OrigQty
+			b.Filled = o.ExecutedQty
+		case bracketTakeProfit:
+			if b.TakeProfit == nil || b.TakeProfit.revision < revision {
+				b.TakeProfit = current
+			}
+			b.Side = oppositeSide(core.OrderSideEnum(o.Side))
+		case bracketStopLoss:
+			if b.StopLoss == nil || b.StopLoss.revision < revision {
+				b.StopLoss = current
+			}
+			b.Side = oppositeSide(core.OrderSideEnum(o.Side))
+		}
+	}
+	result := make([]BracketOrder, 0, len(found))
+	for _, b := range found {
+		st := m.track(b)
+		if err := m.recoverBracket(ctx, b); err != nil {
+			m.untrack(st)
+			return nil, err
+		}
+		if !b.terminal() {
+			result = append(result, b.clone())
+		}
+		m.release(st)
+	}
+	return result, nil
+}
+
+// recoverBracket Complete a bracket rebuilt from open orders, closing or canceling it when nothing is left to protect.
+func (m *BracketManager) recoverBracket(ctx context.Context, b *BracketOrder) error {
+	if b.Entry == nil {
+		// The entry is no longer open, what it executed is what the legs protect.
+		entry, err := m.c.NewQueryOrder().Symbol(b.Symbol).OrigClientOrderId(bracketClientId(b.Id, bracketEntry, 0)).Do(ctx)
+		if err != nil {
+			return fmt.Errorf("bracket: %s entry not found: %w", b.Id, err)
+		}
+		b.Entry = &BracketLeg{
+			ClientOrderId: bracketClientId(b.Id, bracketEntry, 0),
+			OrderId:       int64(entry.OrderId),
+			Quantity:      entry.OrigQty,
+			Status:        entry.Status,
+		}
+		b.Side = core.OrderSideEnum(entry.Side)
+		b.Quantity = entry.OrigQty
+		b.Filled = entry.ExecutedQty
+		if b.Filled.IsZero() {
+			b.Status = BracketStatusCANCELED
+			return errors.Join(m.cancelLeg(ctx, b, b.TakeProfit), m.cancelLeg(ctx, b, b.StopLoss))
+		}
+	}
+	if b.TakeProfit != nil && b.StopLoss != nil {
+		return nil
+	}
+	if b.Entry.Status != "NEW" && b.Entry.Status != "PARTIALLY_FILLED" {
+		restored, err := m.restoreLeg(ctx, b)
+		if err != nil {
+			return err
+		}
+		if !restored {
+			b.Status = BracketStatusCLOSED
+			return errors.Join(m.cancelLeg(ctx, b, b.TakeProfit), m.cancelLeg(ctx, b, b.StopLoss))
+		}
+	}
+	if b.TakeProfit == nil {
+		b.TakeProfit = &BracketLeg{ClientOrderId: bracketClientId(b.Id, bracketTakeProfit, 0), Status: "CANCELED"}
+	}
+	if b.StopLoss == nil {
+		b.StopLoss = &BracketLeg{ClientOrderId: bracketClientId(b.Id, bracketStopLoss, 0), Status: "CANCELED"}
+	}
+	return nil
+}
+
+// restoreLeg Place the missing leg of a filled bracket again, sized like the remaining leg, unless the missing leg filled.
+// The missing leg usually has the revision of the remaining one, a leg restored before may be one apart.
+func (m *BracketManager) restoreLeg(ctx context.Context, b *BracketOrder) (bool, error) {
+	name, present := bracketStopLoss, b.TakeProfit
+	if present == nil {
+		name, present = bracketTakeProfit, b.StopLoss
+	}
+	var missing *OrderResponse
+	var err error
+	for _, revision := range []int{present.revision, present.revision + 1, present.revision - 1} {
+		if revision < 0 {
+			continue
+		}
+		missing, err = m.c.NewQueryOrder().Symbol(b.Symbol).OrigClientOrderId(bracketClientId(b.Id, name, revision)).Do(ctx)
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return false, fmt.Errorf("bracket: %s %s leg not found: %w", b.Id, name, err)
+	}
+	if missing.Status == "FILLED" {
+		return false, nil
+	}
+	_, _, revision, _ := parseBracketClientId(missing.ClientOrderId)
+	next, err := m.placeLeg(ctx, b, name, missing.StopPrice, present.Quantity, max(revision, present.revision)+1)
+	if err != nil {
+		return false, err
+	}
+	b.setLeg(name, next)
+	return true, nil
+}
@@ -0,0 +1,378 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+type bracketTestSuite struct {
+	baseHttpTestSuite
+	mu       sync.Mutex
+	requests []*http.Request
+	routes   map[string][]byte
+	gate     chan struct{}
+}
+
+func TestBracketManager(t *testing.T) {
+	suite.Run(t, new(bracketTestSuite))
+}
+
+func (s *bracketTestSuite) route(routes map[string][]byte) *httptest.Server {
+	s.requests = nil
+	s.routes = routes
+	s.gate = nil
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests = append(s.requests, r)
+		gate := s.gate
+		s.mu.Unlock()
+		if gate != nil && r.Method == http.MethodPost {
+			<-gate
+		}
+		msg, ok := s.routes[r.Method+" "+r.URL.Path+" "+r.URL.Query().Get("origClientOrderId")]
+		if !ok {
+			msg, ok = s.routes[r.Method+" "+r.URL.Path]
+		}
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(msg)
+	}))
+	s.client.Opt.Endpoint = server.URL
+	return server
+}
+
+func (s *bracketTestSuite) calls(method, path string) []*http.Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result := make([]*http.Request, 0)
+	for _, r := range s.requests {
+		if r.Method == method && r.URL.Path == path {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+func (s *bracketTestSuite) orderUpdate(clientOrderId string, orderId int, status, filled string) *UserDataEvent {
+	event := &UserDataEvent{Event: ORDER_TRADE_UPDATE}
+	event.OrderTradeUpdate.O = UpdateOrder{
+		Symbol:              "BTCUSDT",
+		ClientOrderId:       clientOrderId,
+		OrderId:             orderId,
+		OrderStatus:         status,
+		AccumulatedQuantity: decimal.RequireFromString(filled),
+	}
+	return event
+}
+
+func (s *bracketTestSuite) newManager(routes map[string][]byte) (*BracketManager, *BracketOrder, *httptest.Server) {
+	server := s.route(routes)
+	m := s.client.NewBracketManager()
+	b, err := m.Place(context.Background(), &BracketOrderReq{
+		Id:         "bk1",
+		Symbol:     "BTCUSDT",
+		Side:       core.OrderSideBUY,
+		Type:       core.OrderTypeLIMIT,
+		Quantity:   decimal.RequireFromString("2"),
+		Price:      decimal.RequireFromString("100"),
+		TakeProfit: decimal.RequireFromString("110"),
+		StopLoss:   decimal.RequireFromString("95"),
+	})
+	s.r().NoError(err)
+	return m, b, server
+}
+
+func (s *bracketTestSuite) defaultRoutes() map[string][]byte {
+	return map[string][]byte{
+		"POST /fapi/v1/batchOrders": []byte(`[
+			{"orderId": 1, "clientOrderId": "bk1-en", "status": "NEW"},
+			{"orderId": 2, "clientOrderId": "bk1-tp0", "status": "NEW"},
+			{"orderId": 3, "clientOrderId": "bk1-sl0", "status": "NEW"}
+		]`),
+		"DELETE /fapi/v1/order": []byte(`{"orderId": 3, "status": "CANCELED"}`),
+		"POST /fapi/v1/order":   []byte(`{"orderId": 9, "status": "NEW"}`),
+	}
+}
+
+func (s *bracketTestSuite) TestPlaceValidation() {
+	m := s.client.NewBracketManager()
+	_, err := m.Place(context.Background(), &BracketOrderReq{Symbol: "BTCUSDT"})
+	s.r().Error(err)
+	_, err = m.Place(context.Background(), &BracketOrderReq{Symbol: "BTCUSDT", Quantity: decimal.NewFromInt(1)})
+	s.r().Error(err)
+}
+
+func (s *bracketTestSuite) TestPlace() {
+	_, b, server := s.newManager(s.defaultRoutes())
+	defer server.Close()
+	r := s.r()
+	r.Equal(BracketStatusOPEN, b.Status)
+	r.Equal(int64(1), b.Entry.OrderId)
+	r.Equal(int64(2), b.TakeProfit.OrderId)
+	r.Equal(int64(3), b.StopLoss.OrderId)
+
+	calls := s.calls(http.MethodPost, "/fapi/v1/batchOrders")
+	r.Len(calls, 1)
+	var orders []OrderReq
+	r.NoError(json.Unmarshal([]byte(calls[0].URL.Query().Get("batchOrders")), &orders))
+	r.Len(orders, 3)
+	r.Equal(core.OrderTypeEnum(core.OrderTypeLIMIT), orders[0].OrderType)
+	r.Equal("bk1-en", orders[0].NewClientOrderId)
+	r.Equal(core.OrderTypeEnum(core.OrderTypeTAKE_PROFIT_MARKET), orders[1].OrderType)
+	r.Equal(core.OrderSideEnum(core.OrderSideSELL), orders[1].Side)
+	r.Equal("110", orders[1].StopPrice)
+	r.Equal("true", orders[1].ReduceOnly)
+	r.Equal(core.OrderTypeEnum(core.OrderTypeSTOP_MARKET), orders[2].OrderType)
+	r.Equal("95", orders[2].StopPrice)
+}
+
+func (s *bracketTestSuite) TestPlaceRejectedLeg() {
+	routes := s.defaultRoutes()
+	routes["POST /fapi/v1/batchOrders"] = []byte(`[
+		{"orderId": 1, "clientOrderId": "bk1-en", "status": "NEW"},
+		{"code": -2021, "msg": "Order would immediately trigger."},
+		{"orderId": 3, "clientOrderId": "bk1-sl0", "status": "NEW"}
+	]`)
+	server := s.route(routes)
+	defer server.Close()
+	m := s.client.NewBracketManager()
+	b, err := m.Place(context.Background(), &BracketOrderReq{
+		Id:         "bk1",
+		Symbol:     "BTCUSDT",
+		Side:       core.OrderSideBUY,
+		Quantity:   decimal.RequireFromString("1"),
+		TakeProfit: decimal.RequireFromString("90"),
+		StopLoss:   decimal.RequireFromString("80"),
+	})
+	r := s.r()
+	r.Error(err)
+	r.Nil(b)
+	cancels := s.calls(http.MethodDelete, "/fapi/v1/order")
+	r.Len(cancels, 2)
+	r.Equal("1", cancels[0].URL.Query().Get("orderId"))
+	r.Equal("3", cancels[1].URL.Query().Get("orderId"))
+	r.Empty(m.Brackets())
+}
+
+func (s *bracketTestSuite) TestSiblingCancel() {
+	m, _, server := s.newManager(s.defaultRoutes())
+	defer server.Close()
+	r := s.r()
+	r.NoError(m.Handle(context.Background(), s.orderUpdate("bk1-en", 1, "FILLED", "2")))
+	r.NoError(m.Handle(context.Background(), s.orderUpdate("bk1-tp0", 2, "FILLED", "2")))
+	cancels := s.calls(http.MethodDelete, "/fapi/v1/order")
+	r.Len(cancels, 1)
+	r.Equal("3", cancels[0].URL.Query().Get("orderId"))
+	b, ok := m.Get("bk1")
+	r.True(ok)
+	r.Equal(BracketStatusCLOSED, b.Status)
+	r.Empty(m.Brackets())
+}
+
+func (s *bracketTestSuite) TestPartialFillResize() {
+	m, _, server := s.newManager(s.defaultRoutes())
+	defer server.Close()
+	r := s.r()
+	r.NoError(m.Handle(context.Background(), s.orderUpdate("bk1-en", 1, "PARTIALLY_FILLED", "0.5")))
+	r.Len(s.calls(http.MethodDelete, "/fapi/v1/order"), 2)
+	creates := s.calls(http.MethodPost, "/fapi/v1/order")
+	r.Len(creates, 2)
+	r.Equal("bk1-tp1", creates[0].URL.Query().Get("newClientOrderId"))
+	r.Equal("0.5", creates[0].URL.Query().Get("quantity"))
+	r.Equal("bk1-sl1", creates[1].URL.Query().Get("newClientOrderId"))
+	b, _ := m.Get("bk1")
+	r.Equal("0.5", b.TakeProfit.Quantity.String())
+	r.Equal("0.5", b.StopLoss.Quantity.String())
+
+	// Events of the replaced revision are stale and must not cancel anything.
+	r.NoError(m.Handle(context.Background(), s.orderUpdate("bk1-tp0", 2, "CANCELED", "0")))
+	r.Len(s.calls(http.MethodDelete, "/fapi/v1/order"), 2)
+}
+
+func (s *bracketTestSuite) TestStaleLegFill() {
+	m, _, server := s.newManager(s.defaultRoutes())
+	defer server.Close()
+	r := s.r()
+	r.NoError(m.Handle(context.Background(), s.orderUpdate("bk1-en", 1, "PARTIALLY_FILLED", "0.5")))
+	r.Len(s.calls(http.MethodDelete, "/fapi/v1/order"), 2)
+
+	// The replaced take-profit filled before its cancel landed, the new legs on both sides are canceled.
+	r.NoError(m.Handle(context.Background(), s.orderUpdate("bk1-tp0", 2, "FILLED", "2")))
+	cancels := s.calls(http.MethodDelete, "/fapi/v1/order")
+	r.Len(cancels, 5)
+	r.Equal("1", cancels[2].URL.Query().Get("orderId"))
+	r.Equal("9", cancels[3].URL.Query().Get("orderId"))
+	r.Equal("9", cancels[4].URL.Query().Get("orderId"))
+	b, _ := m.Get("bk1")
+	r.Equal(BracketStatusCLOSED, b.Status)
+	r.Equal("bk1-tp1", b.TakeProfit.ClientOrderId)
+	r.Equal("CANCELED", b.TakeProfit.Status)
+	r.Equal("CANCELED", b.StopLoss.Status)
+}
+
+func (s *bracketTestSuite) TestSlowRequestDoesNotBlockReaders() {
+	m, _, server := s.newManager(s.defaultRoutes())
+	defer server.Close()
+	r := s.r()
+	gate := make(chan struct{})
+	s.mu.Lock()
+	s.gate = gate
+	s.mu.Unlock()
+	done := make(chan error)
+	go func() {
+		done <- m.Handle(context.Background(), s.orderUpdate("bk1-en", 1, "PARTIALLY_FILLED", "0.5"))
+	}()
+	r.Eventually(func() bool { return len(s.calls(http.MethodPost, "/fapi/v1/order")) == 1 }, time.Second, time.Millisecond)
+
+	// The resize is stuck on the exchange, readers still see the last published state.
+	b, ok := m.Get("bk1")
+	r.True(ok)
+	r.Equal("bk1-tp0", b.TakeProfit.ClientOrderId)
+	r.Len(m.Brackets(), 1)
+	close(gate)
+	r.NoError(<-done)
+	b, _ = m.Get("bk1")
+	r.Equal("bk1-tp1", b.TakeProfit.ClientOrderId)
+	r.Equal("0.5", b.Filled.String())
+}
+
+func (s *bracketTestSuite) TestEntryCanceledWithoutFill() {
+	m, _, server := s.newManager(s.defaultRoutes())
+	defer server.Close()
+	r := s.r()
+	r.NoError(m.Handle(context.Background(), s.orderUpdate("bk1-en", 1, "CANCELED", "0")))
+	r.Len(s.calls(http.MethodDelete, "/fapi/v1/order"), 2)
+	b, _ := m.Get("bk1")
+	r.Equal(BracketStatusCANCELED, b.Status)
+}
+
+func (s *bracketTestSuite) TestRecover() {
+	server := s.route(map[string][]byte{
+		"GET /fapi/v1/openOrders": []byte(`[
+			{"orderId": 2, "clientOrderId": "bk1-tp1", "symbol": "BTCUSDT", "side": "SELL", "status": "NEW", "origQty": "0.5", "stopPrice": "110"},
+			{"orderId": 3, "clientOrderId": "bk1-sl1", "symbol": "BTCUSDT", "side": "SELL", "status": "NEW", "origQty": "0.5", "stopPrice": "95"},
+			{"orderId": 7, "clientOrderId": "bk2-tp0", "symbol": "BTCUSDT", "side": "BUY", "status": "NEW", "origQty": "1", "stopPrice": "80"},
+			{"orderId": 8, "clientOrderId": "manual", "symbol": "BTCUSDT", "side": "BUY", "status": "NEW", "origQty": "1"}
+		]`),
+		"GET /fapi/v1/order bk1-en": []byte(`{"orderId": 1, "clientOrderId": "bk1-en", "symbol": "BTCUSDT", "side": "BUY", "status": "CANCELED", "origQty": "2", "executedQty": "0.5"}`),
+		"GET /fapi/v1/order bk2-en": []byte(`{"orderId": 5, "clientOrderId": "bk2-en", "symbol": "BTCUSDT", "side": "SELL", "status": "FILLED", "origQty": "1", "executedQty": "1"}`),
+		"GET /fapi/v1/order":        []byte(`{"orderId": 6, "clientOrderId": "bk2-sl0", "symbol": "BTCUSDT", "side": "BUY", "status": "FILLED", "origQty": "1", "stopPrice": "120"}`),
+		"DELETE /fapi/v1/order":     []byte(`{"orderId": 7, "status": "CANCELED"}`),
+	})
+	defer server.Close()
+	m := s.client.NewBracketManager()
+	brackets, err := m.Recover(context.Background(), "BTCUSDT")
+	r := s.r()
+	r.NoError(err)
+	r.Len(brackets, 1)
+	r.Equal("bk1", brackets[0].Id)
+	r.Equal("2", brackets[0].Quantity.String())
+	r.Equal("CANCELED", brackets[0].Entry.Status)
+	r.Equal(core.OrderSideEnum(core.OrderSideBUY), brackets[0].Side)
+	r.Equal("0.5", brackets[0].Filled.String())
+	r.Equal(int64(2), brackets[0].TakeProfit.OrderId)
+	cancels := s.calls(http.MethodDelete, "/fapi/v1/order")
+	r.Len(cancels, 1)
+	r.Equal("7", cancels[0].URL.Query().Get("orderId"))
+
+	// Recovered legs keep their revision, the next fill cancels the right sibling.
+	r.NoError(m.Handle(context.Background(), s.orderUpdate("bk1-sl1", 3, "FILLED", "0.5")))
+	cancels = s.calls(http.MethodDelete, "/fapi/v1/order")
+	r.Len(cancels, 2)
+	r.Equal("2", cancels[1].URL.Query().Get("orderId"))
+}
+
+func (s *bracketTestSuite) TestRecoverRestoresLeg() {
+	server := s.route(map[string][]byte{
+		"GET /fapi/v1/openOrders": []byte(`[
+			{"orderId": 7, "clientOrderId": "bk2-tp0", "symbol": "BTCUSDT", "side": "BUY", "status": "NEW", "origQty": "1", "stopPrice": "80"}
+		]`),
+		"GET /fapi/v1/order bk2-en": []byte(`{"orderId": 5, "clientOrderId": "bk2-en", "symbol": "BTCUSDT", "side": "SELL", "status": "FILLED", "origQty": "1", "executedQty": "1"}`),
+		"GET /fapi/v1/order":        []byte(`{"orderId": 6, "clientOrderId": "bk2-sl0", "symbol": "BTCUSDT", "side": "BUY", "status": "CANCELED", "origQty": "1", "stopPrice": "120"}`),
+		"POST /fapi/v1/order":       []byte(`{"orderId": 9, "status": "NEW"}`),
+		"DELETE /fapi/v1/order":     []byte(`{"orderId": 7, "status": "CANCELED"}`),
+	})
+	defer server.Close()
+	m := s.client.NewBracketManager()
+	brackets, err := m.Recover(context.Background(), "BTCUSDT")
+	r := s.r()
+	r.NoError(err)
+	r.Len(brackets, 1)
+	r.Equal(BracketStatusOPEN, brackets[0].Status)
+	r.Empty(s.calls(http.MethodDelete, "/fapi/v1/order"), "the remaining leg is kept")
+	queries := s.calls(http.MethodGet, "/fapi/v1/order")
+	r.Len(queries, 2)
+	r.Equal("bk2-en", queries[0].URL.Query().Get("origClientOrderId"))
+	r.Equal("bk2-sl0", queries[1].URL.Query().Get("origClientOrderId"))
+	creates := s.calls(http.MethodPost, "/fapi/v1/order")
+	r.Len(creates, 1)
+	query := creates[0].URL.Query()
+	r.Equal("bk2-sl1", query.Get("newClientOrderId"))
+	r.Equal("STOP_MARKET", query.Get("type"))
+	r.Equal("BUY", query.Get("side"))
+	r.Equal("1", query.Get("quantity"))
+	r.Equal("120", query.Get("stopPrice"))
+	r.Equal(int64(9), brackets[0].StopLoss.OrderId)
+	r.Equal(int64(7), brackets[0].TakeProfit.OrderId)
+}
+
+func (s *bracketTestSuite) TestRecoverEntryNeverFilled() {
+	server := s.route(map[string][]byte{
+		"GET /fapi/v1/openOrders": []byte(`[
+			{"orderId": 2, "clientOrderId": "bk3-tp0", "symbol": "BTCUSDT", "side": "SELL", "status": "NEW", "origQty": "1", "stopPrice": "110"},
+			{"orderId": 3, "clientOrderId": "bk3-sl0", "symbol": "BTCUSDT", "side": "SELL", "status": "NEW", "origQty": "1", "stopPrice": "95"}
+		]`),
+		"GET /fapi/v1/order":    []byte(`{"orderId": 1, "clientOrderId": "bk3-en", "symbol": "BTCUSDT", "side": "BUY", "status": "EXPIRED", "origQty": "1", "executedQty": "0"}`),
+		"DELETE /fapi/v1/order": []byte(`{"orderId": 2, "status": "CANCELED"}`),
+	})
+	defer server.Close()
+	m := s.client.NewBracketManager()
+	brackets, err := m.Recover(context.Background(), "BTCUSDT")
+	r := s.r()
+	r.NoError(err)
+	r.Empty(brackets)
+	queries := s.calls(http.MethodGet, "/fapi/v1/order")
+	r.Len(queries, 1)
+	r.Equal("bk3-en", queries[0].URL.Query().Get("origClientOrderId"))
+	cancels := s.calls(http.MethodDelete, "/fapi/v1/order")
+	r.Len(cancels, 2)
+	r.Empty(s.calls(http.MethodPost, "/fapi/v1/order"))
+}
+
+func (s *bracketTestSuite) TestResizeKeepsLegOnFailure() {
+	routes := s.defaultRoutes()
+	delete(routes, "POST /fapi/v1/order")
+	m, _, server := s.newManager(routes)
+	defer server.Close()
+	r := s.r()
+	r.Error(m.Handle(context.Background(), s.orderUpdate("bk1-en", 1, "PARTIALLY_FILLED", "0.5")))
+	r.Len(s.calls(http.MethodPost, "/fapi/v1/order"), 1)
+	r.Empty(s.calls(http.MethodDelete, "/fapi/v1/order"), "the old leg is only canceled once its replacement is placed")
+	b, _ := m.Get("bk1")
+	r.Equal("bk1-tp0", b.TakeProfit.ClientOrderId)
+	r.Equal("NEW", b.TakeProfit.Status)
+	r.Equal("bk1-sl0", b.StopLoss.ClientOrderId)
+}
+
+func (s *bracketTestSuite) TestParseBracketClientId() {
+	r := s.r()
+	id, leg, rev, ok := parseBracketClientId("bk1-tp12")
+	r.True(ok)
+	r.Equal("bk1", id)
+	r.Equal(bracketTakeProfit, leg)
+	r.Equal(12, rev)
+	_, _, _, ok = parseBracketClientId("web_abcdef")
+	r.False(ok)
+	_, _, _, ok = parseBracketClientId("x-tpz")
+	r.False(ok)
+}
@@ -0,0 +1,330 @@
+package futures
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/shopspring/decimal"
+	"strings"
+)
+
+// maxBracketIterations bounds the liquidation price refinement when the bracket at the
+// liquidation price differs from the bracket at the mark price.
+const maxBracketIterations = 10
+
+// BracketFor Bracket applied to a position of the given notional value.
+func (b *LeverageBracketResponse) BracketFor(notional decimal.Decimal) *Bracket {
+	notional = notional.Abs()
+	var last *Bracket
+	for _, bracket := range b.Brackets {
+		if notional.GreaterThanOrEqual(bracket.NotionalFloor) && notional.LessThan(bracket.NotionalCap) {
+			return bracket
+		}
+		if last == nil || bracket.NotionalCap.GreaterThan(last.NotionalCap) {
+			last = bracket
+		}
+	}
+	return last
+}
+
+// MaxNotional Largest position notional allowed at leverage, zero when no bracket allows it.
+func (b *LeverageBracketResponse) MaxNotional(leverage int) decimal.Decimal {
+	maxNotional := decimal.Zero
+	for _, bracket := range b.Brackets {
+		if bracket.InitialLeverage >= leverage && bracket.NotionalCap.GreaterThan(maxNotional) {
+			maxNotional = bracket.NotionalCap
+		}
+	}
+	return maxNotional
+}
+
+// CalcPosition Position as seen by the MarginCalculator. Amount is signed like positionAmt:
+// positive for long and negative for short, also in hedge mode.
+type CalcPosition struct {
+	Symbol         string
+	PositionSide   core.PositionSideEnum
+	Amount         decimal.Decimal
+	EntryPrice     decimal.Decimal
+	MarkPrice      decimal.Decimal
+	Leverage       int
+	Isolated       bool
+	IsolatedWallet decimal.Decimal // isolated wallet balance, margin added included
+	Brackets       *LeverageBracketResponse
+}
+
+// Notional Position value at the mark price.
+func (p *CalcPosition) Notional() decimal.Decimal {
+	return p.Amount.Abs().Mul(p.MarkPrice)
+}
+
+// UnrealizedProfit Profit at the mark price.
+func (p *CalcPosition) UnrealizedProfit() decimal.Decimal {
+	return p.Amount.Mul(p.MarkPrice.Sub(p.EntryPrice))
+}
+
+// InitialMargin Margin required to open the position at its leverage.
+func (p *CalcPosition) InitialMargin() decimal.Decimal {
+	if p.Leverage <= 0 {
+		return decimal.Zero
+	}
+	return p.Notional().Div(decimal.NewFromInt(int64(p.Leverage)))
+}
+
+// MaintMargin Maintenance margin at the mark price, notional * maintMarginRatio - cum.
+func (p *CalcPosition) MaintMargin() decimal.Decimal {
+	if p.Brackets == nil {
+		return decimal.Zero
+	}
+	return maintMargin(p.Notional(), p.Brackets.BracketFor(p.Notional()))
+}
+
+func maintMargin(notional decimal.Decimal, bracket *Bracket) decimal.Decimal {
+	if bracket == nil {
+		return decimal.Zero
+	}
+	return notional.Mul(bracket.MaintMarginRatio).Sub(bracket.Cum)
+}
+
+func (p *CalcPosition) key() string {
+	return strings.ToUpper(p.Symbol) + ":" + string(p.PositionSide)
+}
+
+// NewCalcPosition Calculator position from a PositionRisk entry. PositionRisk does not report the
+// leverage, and the position is treated as isolated when it has an isolated wallet.
+func NewCalcPosition(p *PositionRiskResponse, leverage int, brackets *LeverageBracketResponse) *CalcPosition {
+	side := core.PositionSideEnum(p.PositionSide)
+	if side == "" {
+		side = core.PositionSide_BOTH
+	}
+	return &CalcPosition{
+		Symbol:         p.Symbol,
+		PositionSide:   side,
+		Amount:         p.PositionAmt,
+		EntryPrice:     p.EntryPrice,
+		MarkPrice:      p.MarkPrice,
+		Leverage:       leverage,
+		Isolated:       !p.IsolatedWallet.IsZero(),
+		IsolatedWallet: p.IsolatedWallet,
+		Brackets:       brackets,
+	}
+}
+
+// CollateralAsset Wallet asset counted as margin in Multi-Assets mode.
+type CollateralAsset struct {
+	Asset          string
+	Balance        decimal.Decimal
+	IndexPrice     decimal.Decimal // USD index price of the asset, 1 for USDT
+	CollateralRate decimal.Decimal // 1 - haircut, as returned by AssetIndex
+}
+
+// MultiAssetsWalletBalance Cross wallet balance in USD for Multi-Assets mode. Positive balances are
+// discounted by their collateral rate, negative balances count at full value.
+func MultiAssetsWalletBalance(assets []*CollateralAsset) decimal.Decimal {
+	total := decimal.Zero
+	for _, asset := range assets {
+		value := asset.Balance.Mul(asset.IndexPrice)
+		if value.IsPositive() {
+			value = value.Mul(asset.CollateralRate)
+		}
+		total = total.Add(value)
+	}
+	return total
+}
+
+// MarginCalculator Offline margin and liquidation price calculator following the formulas of the
+// Binance USDⓈ-M futures documentation. WalletBalance is the cross wallet balance of the margin
+// asset, or the result of MultiAssetsWalletBalance in Multi-Assets mode.
+type MarginCalculator struct {
+	WalletBalance decimal.Decimal
+	positions     map[string]*CalcPosition
+}
+
+// NewMarginCalculator Calculator for a cross wallet balance.
+func NewMarginCalculator(walletBalance decimal.Decimal) *MarginCalculator {
+	return &MarginCalculator{WalletBalance: walletBalance, positions: make(map[string]*CalcPosition)}
+}
+
+// SetPosition Add or replace the position of its symbol and position side.
+func (m *MarginCalculator) SetPosition(p *CalcPosition) *MarginCalculator {
+	m.positions[p.key()] = p
+	return m
+}
+
+// Position Position of symbol and side, nil when unknown.
+func (m *MarginCalculator) Position(symbol string, side core.PositionSideEnum) *CalcPosition {
+	return m.positions[strings.ToUpper(symbol)+":"+string(side)]
+}
+
+// CrossMaintMargin Maintenance margin of every cross position.
+func (m *MarginCalculator) CrossMaintMargin() decimal.Decimal {
+	total := decimal.Zero
+	for _, p := range m.positions {
+		if !p.Isolated {
+			total = total.Add(p.MaintMargin())
+		}
+	}
+	return total
+}
+
+// LiquidationPrice Liquidation price of the position of symbol and side. The positions liquidated
+// together are priced as one: both sides of a cross symbol in hedge mode, a single position when isolated.
+// Zero is returned when the position cannot be liquidated.
+func (m *MarginCalculator) LiquidationPrice(symbol string, side core.PositionSideEnum) (decimal.Decimal, error) {
+	p := m.Position(symbol, side)
+	if p == nil {
+		return decimal.Zero, fmt.Errorf("calculator: no %s position for %s", side, symbol)
+	}
+	return m.liquidationPrice(p, m.positions)
+}
+
+func (m *MarginCalculator) liquidationPrice(p *CalcPosition, positions map[string]*CalcPosition) (decimal.Decimal, error) {
+	if p.Brackets == nil || len(p.Brackets.Brackets) == 0 {
+		return decimal.Zero, fmt.Errorf("calculator: no leverage brackets for %s", p.Symbol)
+	}
+	if p.Amount.IsZero() {
+		return decimal.Zero, nil
+	}
+	group := []*CalcPosition{p}
+	walletBalance := p.IsolatedWallet
+	otherMaint, otherProfit := decimal.Zero, decimal.Zero
+	if !p.Isolated {
+		group = group[:0]
+		walletBalance = m.WalletBalance
+		for _, other := range positions {
+			switch {
+			case other.Isolated || other.Amount.IsZero():
+			case strings.EqualFold(other.Symbol, p.Symbol):
+				if other.Brackets == nil || len(other.Brackets.Brackets) == 0 {
+					return decimal.Zero, fmt.Errorf("calculator: no leverage brackets for %s", other.Symbol)
+				}
+				group = append(group, other)
+			default:
+				otherMaint = otherMaint.Add(other.MaintMargin())
+				otherProfit = otherProfit.Add(other.UnrealizedProfit())
+			}
+		}
+	}
+	brackets := make([]*Bracket, len(group))
+	for i, q := range group {
+		brackets[i] = q.Brackets.BracketFor(q.Notional())
+	}
+	var price decimal.Decimal
+	for range maxBracketIterations {
+		numerator := walletBalance.Sub(otherMaint).Add(otherProfit)
+		denominator := decimal.Zero
+		for i, q := range group {
+			size := q.Amount.Abs()
+			direction := decimal.NewFromInt(int64(q.Amount.Sign()))
+			numerator = numerator.Add(brackets[i].Cum).Sub(direction.Mul(size).Mul(q.EntryPrice))
+			denominator = denominator.Add(size.Mul(brackets[i].MaintMarginRatio)).Sub(direction.Mul(size))
+		}
+		if denominator.IsZero() {
+			return decimal.Zero, nil
+		}
+		price = numerator.Div(denominator)
+		if !price.IsPositive() {
+			return decimal.Zero, nil
+		}
+		// the brackets apply to the notional at the liquidation price, not at the mark price
+		stable := true
+		for i, q := range group {
+			if bracket := q.Brackets.BracketFor(q.Amount.Abs().Mul(price)); bracket != brackets[i] {
+				brackets[i] = bracket
+				stable = false
+			}
+		}
+		if stable {
+			break
+		}
+	}
+	return price, nil
+}
+
+// OrderEstimate Position of symbol and side after a hypothetical order has been filled.
+type OrderEstimate struct {
+	Position         *CalcPosition
+	InitialMargin    decimal.Decimal
+	MaintMargin      decimal.Decimal
+	LiquidationPrice decimal.Decimal
+}
+
+// EstimateOrder Fill a hypothetical order of quantity at price into the position of symbol and side.
+// For a new position template provides the leverage, margin type and brackets.
+func (m *MarginCalculator) EstimateOrder(template *CalcPosition, side core.OrderSideEnum, quantity, price decimal.Decimal) (*OrderEstimate, error) {
+	if !quantity.IsPositive() || !price.IsPositive() {
+		return nil, errors.New("calculator: quantity and price must be positive")
+	}
+	p := *template
+	if current := m.Position(template.Symbol, template.PositionSide); current != nil {
+		p = *current
+	}
+	if p.MarkPrice.IsZero() {
+		p.MarkPrice = price
+	}
+	fill := quantity
+	if side == core.OrderSideSELL {
+		fill = fill.Neg()
+	}
+	amount := p.Amount.Add(fill)
+	switch {
+	case p.Amount.IsZero() || p.Amount.Sign() == fill.Sign():
+		// increasing, the entry price is the size weighted average
+		p.EntryPrice = p.Amount.Abs().Mul(p.EntryPrice).Add(quantity.Mul(price)).Div(amount.Abs())
+		if p.Isolated {
+			p.IsolatedWallet = p.IsolatedWallet.Add(quantity.Mul(price).Div(decimal.NewFromInt(int64(max(p.Leverage, 1)))))
+		}
+	case amount.Sign() != p.Amount.Sign() && !amount.IsZero():
+		// flipped, the remainder opens at price
+		p.EntryPrice = price
+		if p.Isolated {
+			p.IsolatedWallet = amount.Abs().Mul(price).Div(decimal.NewFromInt(int64(max(p.Leverage, 1))))
+		}
+	case p.Isolated:
+		// reducing releases margin pro rata and realizes the profit into the isolated wallet
+		realized := fill.Neg().Mul(price.Sub(p.EntryPrice))
+		p.IsolatedWallet = p.IsolatedWallet.Mul(amount.Abs()).Div(p.Amount.Abs()).Add(realized)
+	}
+	p.Amount = amount
+	positions := make(map[string]*CalcPosition, len(m.positions)+1)
+	for key, q := range m.positions {
+		positions[key] = q
+	}
+	positions[p.key()] = &p
+	liquidationPrice, err := m.liquidationPrice(&p, positions)
+	if err != nil {
+		return nil, err
+	}
+	return &OrderEstimate{
+		Position:         &p,
+		InitialMargin:    p.InitialMargin(),
+		MaintMargin:      p.MaintMargin(),
+		LiquidationPrice: liquidationPrice,
+	}, nil
+}
+
+// MaxOpenSize Largest quantity an order of side at price may open in the position of template,
+// limited by availableBalance at the position leverage and by the notional cap of the brackets.
+func (m *MarginCalculator) MaxOpenSize(template *CalcPosition, side core.OrderSideEnum, price, availableBalance decimal.Decimal) decimal.Decimal {
+	if !price.IsPositive() || template.Leverage <= 0 || template.Brackets == nil {
+		return decimal.Zero
+	}
+	current := decimal.Zero
+	if p := m.Position(template.Symbol, template.PositionSide); p != nil {
+		current = p.Amount
+	}
+	leverage := decimal.NewFromInt(int64(template.Leverage))
+	bySize := availableBalance.Mul(leverage).Div(price)
+	byCap := template.Brackets.MaxNotional(template.Leverage).Div(price)
+	// closing the opposite position first neither needs margin nor counts against the cap
+	opposite := decimal.Zero
+	if (side == core.OrderSideBUY && current.IsNegative()) || (side == core.OrderSideSELL && current.IsPositive()) {
+		opposite = current.Abs()
+	} else {
+		byCap = byCap.Sub(current.Abs())
+	}
+	size := decimal.Min(bySize, byCap)
+	if size.IsNegative() {
+		size = decimal.Zero
+	}
+	return size.Add(opposite)
+}
@@ -0,0 +1,173 @@
+package futures
+
+import (
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type calculatorTestSuite struct {
+	suite.Suite
+	brackets *LeverageBracketResponse
+}
+
+func TestCalculator(t *testing.T) {
+	suite.Run(t, new(calculatorTestSuite))
+}
+
+func (s *calculatorTestSuite) SetupTest() {
+	s.brackets = new(LeverageBracketResponse)
+	s.r().NoError(json.Unmarshal([]byte(`{
+		"symbol": "BTCUSDT",
+		"notionalCoef": 1.0,
+		"brackets": [
+			{"bracket": 1, "initialLeverage": 125, "notionalCap": 50000, "notionalFloor": 0, "maintMarginRatio": 0.004, "cum": 0.0},
+			{"bracket": 2, "initialLeverage": 100, "notionalCap": 250000, "notionalFloor": 50000, "maintMarginRatio": 0.005, "cum": 50.0},
+			{"bracket": 3, "initialLeverage": 50, "notionalCap": 3000000, "notionalFloor": 250000, "maintMarginRatio": 0.01, "cum": 1300.0}
+		]
+	}`), s.brackets))
+}
+
+func (s *calculatorTestSuite) r() *require.Assertions {
+	return s.Require()
+}
+
+func (s *calculatorTestSuite) d(value string) decimal.Decimal {
+	return decimal.RequireFromString(value)
+}
+
+func (s *calculatorTestSuite) assertPrice(expected string, actual decimal.Decimal) {
+	s.r().Equal(expected, actual.StringFixed(4))
+}
+
+func (s *calculatorTestSuite) TestBrackets() {
+	r := s.r()
+	r.Equal(1, s.brackets.BracketFor(s.d("49999")).Bracket)
+	r.Equal(2, s.brackets.BracketFor(s.d("-50000")).Bracket)
+	r.Equal(3, s.brackets.BracketFor(s.d("9000000")).Bracket)
+	r.Equal("250000", s.brackets.MaxNotional(100).String())
+	r.Equal("3000000", s.brackets.MaxNotional(20).String())
+	r.True(s.brackets.MaxNotional(126).IsZero())
+	p := &CalcPosition{Symbol: "BTCUSDT", Amount: s.d("-10"), EntryPrice: s.d("10000"), MarkPrice: s.d("9000"), Leverage: 10, Brackets: s.brackets}
+	r.Equal("90000", p.Notional().String())
+	r.Equal("10000", p.UnrealizedProfit().String())
+	r.Equal("9000", p.InitialMargin().String())
+	r.Equal("400", p.MaintMargin().String())
+}
+
+func (s *calculatorTestSuite) TestIsolated() {
+	m := NewMarginCalculator(decimal.Zero).
+		SetPosition(&CalcPosition{Symbol: "BTCUSDT", PositionSide: core.PositionSide_LONG, Amount: s.d("1"), EntryPrice: s.d("10000"),
+			MarkPrice: s.d("10000"), Leverage: 10, Isolated: true, IsolatedWallet: s.d("1000"), Brackets: s.brackets}).
+		SetPosition(&CalcPosition{Symbol: "BTCUSDT", PositionSide: core.PositionSide_SHORT, Amount: s.d("-1"), EntryPrice: s.d("10000"),
+			MarkPrice: s.d("10000"), Leverage: 10, Isolated: true, IsolatedWallet: s.d("1000"), Brackets: s.brackets})
+	r := s.r()
+	long, err := m.LiquidationPrice("BTCUSDT", core.PositionSide_LONG)
+	r.NoError(err)
+	s.assertPrice("9036.1446", long)
+	short, err := m.LiquidationPrice("btcusdt", core.PositionSide_SHORT)
+	r.NoError(err)
+	s.assertPrice("10956.1753", short)
+	_, err = m.LiquidationPrice("BTCUSDT", core.PositionSide_BOTH)
+	r.Error(err)
+}
+
+func (s *calculatorTestSuite) TestBracketAtLiquidationPrice() {
+	// 50500 notional at the mark price, but liquidation happens in the first bracket
+	m := NewMarginCalculator(decimal.Zero).
+		SetPosition(&CalcPosition{Symbol: "BTCUSDT", PositionSide: core.PositionSide_BOTH, Amount: s.d("5"), EntryPrice: s.d("10100"),
+			MarkPrice: s.d("10100"), Leverage: 1, Isolated: true, IsolatedWallet: s.d("45000"), Brackets: s.brackets})
+	price, err := m.LiquidationPrice("BTCUSDT", core.PositionSide_BOTH)
+	s.r().NoError(err)
+	s.assertPrice("1104.4177", price)
+}
+
+func (s *calculatorTestSuite) TestCross() {
+	eth := &LeverageBracketResponse{Symbol: "ETHUSDT", Brackets: []*Bracket{
+		{Bracket: 1, InitialLeverage: 100, NotionalCap: s.d("100000"), MaintMarginRatio: s.d("0.005")},
+	}}
+	m := NewMarginCalculator(s.d("5000")).
+		SetPosition(&CalcPosition{Symbol: "BTCUSDT", PositionSide: core.PositionSide_BOTH, Amount: s.d("1"), EntryPrice: s.d("10000"),
+			MarkPrice: s.d("10000"), Leverage: 20, Brackets: s.brackets}).
+		SetPosition(&CalcPosition{Symbol: "ETHUSDT", PositionSide: core.PositionSide_BOTH, Amount: s.d("-10"), EntryPrice: s.d("1000"),
+			MarkPrice: s.d("1100"), Leverage: 20, Brackets: eth})
+	r := s.r()
+	r.Equal("95", m.CrossMaintMargin().String())
+	price, err := m.LiquidationPrice("BTCUSDT", core.PositionSide_BOTH)
+	r.NoError(err)
+	s.assertPrice("6079.3173", price)
+	// hedged both ways on cross, only the growing maintenance margin can liquidate
+	m = NewMarginCalculator(s.d("5000")).
+		SetPosition(&CalcPosition{Symbol: "BTCUSDT", PositionSide: core.PositionSide_LONG, Amount: s.d("1"), EntryPrice: s.d("10000"),
+			MarkPrice: s.d("10000"), Leverage: 20, Brackets: s.brackets}).
+		SetPosition(&CalcPosition{Symbol: "BTCUSDT", PositionSide: core.PositionSide_SHORT, Amount: s.d("-1"), EntryPrice: s.d("10000"),
+			MarkPrice: s.d("10000"), Leverage: 20, Brackets: s.brackets})
+	price, err = m.LiquidationPrice("BTCUSDT", core.PositionSide_LONG)
+	r.NoError(err)
+	s.assertPrice("380000.0000", price)
+}
+
+func (s *calculatorTestSuite) TestMultiAssets() {
+	wallet := MultiAssetsWalletBalance([]*CollateralAsset{
+		{Asset: "USDT", Balance: s.d("1000"), IndexPrice: s.d("1"), CollateralRate: s.d("1")},
+		{Asset: "BTC", Balance: s.d("0.1"), IndexPrice: s.d("40000"), CollateralRate: s.d("0.95")},
+		{Asset: "BNB", Balance: s.d("-1"), IndexPrice: s.d("300"), CollateralRate: s.d("0.9")},
+	})
+	s.r().Equal("4500", wallet.String())
+}
+
+func (s *calculatorTestSuite) TestEstimateOrder() {
+	template := &CalcPosition{Symbol: "BTCUSDT", PositionSide: core.PositionSide_BOTH, Leverage: 10, Isolated: true, Brackets: s.brackets}
+	m := NewMarginCalculator(decimal.Zero)
+	r := s.r()
+	estimate, err := m.EstimateOrder(template, core.OrderSideBUY, s.d("1"), s.d("10000"))
+	r.NoError(err)
+	r.Equal("1000", estimate.InitialMargin.String())
+	r.Equal("40", estimate.MaintMargin.String())
+	s.assertPrice("9036.1446", estimate.LiquidationPrice)
+	m.SetPosition(estimate.Position)
+	estimate, err = m.EstimateOrder(template, core.OrderSideBUY, s.d("1"), s.d("12000"))
+	r.NoError(err)
+	r.Equal("2", estimate.Position.Amount.String())
+	r.Equal("11000", estimate.Position.EntryPrice.String())
+	r.Equal("2200", estimate.Position.IsolatedWallet.String())
+	_, err = m.EstimateOrder(template, core.OrderSideBUY, decimal.Zero, s.d("12000"))
+	r.Error(err)
+}
+
+func (s *calculatorTestSuite) TestMaxOpenSize() {
+	template := &CalcPosition{Symbol: "BTCUSDT", PositionSide: core.PositionSide_BOTH, Leverage: 100, Brackets: s.brackets}
+	m := NewMarginCalculator(decimal.Zero)
+	r := s.r()
+	// limited by balance
+	r.Equal("1", m.MaxOpenSize(template, core.OrderSideBUY, s.d("10000"), s.d("100")).String())
+	// limited by the 250000 cap of 100x
+	r.Equal("25", m.MaxOpenSize(template, core.OrderSideBUY, s.d("10000"), s.d("100000")).String())
+	m.SetPosition(&CalcPosition{Symbol: "BTCUSDT", PositionSide: core.PositionSide_BOTH, Amount: s.d("-5"), EntryPrice: s.d("10000"),
+		MarkPrice: s.d("10000"), Leverage: 100, Brackets: s.brackets})
+	r.Equal("30", m.MaxOpenSize(template, core.OrderSideBUY, s.d("10000"), s.d("100000")).String())
+	r.Equal("20", m.MaxOpenSize(template, core.OrderSideSELL, s.d("10000"), s.d("100000")).String())
+}
+
+func (s *calculatorTestSuite) TestPositionRisk() {
+	var risk PositionRiskResponse
+	s.r().NoError(json.Unmarshal([]byte(`{
+		"symbol": "BTCUSDT",
+		"positionSide": "BOTH",
+		"positionAmt": "1.000",
+		"entryPrice": "10000.0",
+		"markPrice": "10000.00000000",
+		"liquidationPrice": "9036.14457831",
+		"isolatedMargin": "1000.00000000",
+		"isolatedWallet": "1000.00000000"
+	}`), &risk))
+	position := NewCalcPosition(&risk, 10, s.brackets)
+	r := s.r()
+	r.True(position.Isolated)
+	price, err := NewMarginCalculator(decimal.Zero).SetPosition(position).LiquidationPrice("BTCUSDT", core.PositionSide_BOTH)
+	r.NoError(err)
+	r.Equal(risk.LiquidationPrice.StringFixed(4), price.StringFixed(4))
+}
@@ -0,0 +1,156 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// HeartbeatError A failed countdown refresh. Expired is set once the last armed countdown has elapsed,
+// at which point Binance has already canceled the open orders of the symbol.
+type HeartbeatError struct {
+	Symbol      string
+	Err         error
+	LastSuccess time.Time
+	Expired     bool
+}
+
+func (e *HeartbeatError) Error() string {
+	if e.Expired {
+		return fmt.Sprintf("heartbeat: %s countdown expired, last refresh at %s: %v", e.Symbol, e.LastSuccess.Format(time.RFC3339), e.Err)
+	}
+	return fmt.Sprintf("heartbeat: %s refresh failed: %v", e.Symbol, e.Err)
+}
+
+func (e *HeartbeatError) Unwrap() error {
+	return e.Err
+}
+
+// Heartbeat Dead-man's switch on top of CountdownCancelAll. Every symbol gets its own goroutine that
+// re-arms the countdown before it elapses, so open orders are canceled by Binance if the process dies
+// or loses connectivity.
+type Heartbeat struct {
+	c         *Client
+	symbols   []string
+	countdown time.Duration
+	ratio     float64
+	onFailure func(err *HeartbeatError)
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewHeartbeat Managed CountdownCancelAll heartbeat for symbols. The countdown must be at least one second.
+func (c *Client) NewHeartbeat(countdown time.Duration, symbols ...string) *Heartbeat {
+	return &Heartbeat{c: c, symbols: symbols, countdown: countdown, ratio: 1.0 / 3}
+}
+
+// RefreshRatio Fraction of the countdown after which it is refreshed, default 1/3 which leaves two retries before expiry.
+func (h *Heartbeat) RefreshRatio(ratio float64) *Heartbeat {
+	h.ratio = ratio
+	return h
+}
+
+// OnFailure Callback for failed refreshes, called from the symbol goroutine.
+func (h *Heartbeat) OnFailure(fn func(err *HeartbeatError)) *Heartbeat {
+	h.onFailure = fn
+	return h
+}
+
+func (h *Heartbeat) interval() time.Duration {
+	return time.Duration(float64(h.countdown) * h.ratio)
+}
+
+func (h *Heartbeat) arm(ctx context.Context, c *Client, symbol string, countdown time.Duration) error {
+	_, err := c.NewCountdownCancelAll().Symbol(symbol).CountdownTime(countdown.Milliseconds()).Do(ctx)
+	return err
+}
+
+// Start Arm the countdown of every symbol and keep refreshing it until Stop is called or ctx is done.
+// The first arm is synchronous, so an error means no symbol is protected and nothing is left running.
+func (h *Heartbeat) Start(ctx context.Context) error {
+	if h.countdown < time.Second {
+		return errors.New("heartbeat: countdown must be at least one second")
+	}
+	if h.ratio <= 0 || h.ratio >= 1 {
+		return errors.New("heartbeat: refresh ratio must be between 0 and 1")
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.cancel != nil {
+		return errors.New("heartbeat: already started")
+	}
+	armed := make([]string, 0, len(h.symbols))
+	for _, symbol := range h.symbols {
+		if err := h.arm(ctx, h.c, symbol, h.countdown); err != nil {
+			for _, s := range armed {
+				if disarmErr := h.arm(ctx, h.c, s, 0); disarmErr != nil {
+					h.c.Opt.Logger.Debug("heartbeat disarm failed", "symbol", s, "error", disarmErr)
+				}
+			}
+			return &HeartbeatError{Symbol: symbol, Err: err}
+		}
+		armed = append(armed, symbol)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	h.cancel = cancel
+	now := time.Now()
+	for _, symbol := range h.symbols {
+		h.wg.Add(1)
+		go h.run(ctx, symbol, now)
+	}
+	return nil
+}
+
+func (h *Heartbeat) run(ctx context.Context, symbol string, lastSuccess time.Time) {
+	defer h.wg.Done()
+	c := &Client{h.c.Clone()}
+	ticker := time.NewTicker(h.interval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := h.arm(ctx, c, symbol, h.countdown)
+			if err == nil {
+				lastSuccess = time.Now()
+				continue
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			h.c.Opt.Logger.Debug("heartbeat refresh failed", "symbol", symbol, "error", err)
+			if h.onFailure != nil {
+				h.onFailure(&HeartbeatError{
+					Symbol:      symbol,
+					Err:         err,
+					LastSuccess: lastSuccess,
+					Expired:     time.Since(lastSuccess) >= h.countdown,
+				})
+			}
+		}
+	}
+}
+
+// Stop Stop refreshing and disarm every countdown, leaving open orders in place.
+func (h *Heartbeat) Stop(ctx context.Context) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.cancel == nil {
+		return nil
+	}
+	h.cancel()
+	h.wg.Wait()
+	h.cancel = nil
+	var errs []error
+	for _, symbol := range h.symbols {
+		if err := h.arm(ctx, h.c, symbol, 0); err != nil {
+			errs = append(errs, &HeartbeatError{Symbol: symbol, Err: err})
+		}
+	}
+	return errors.Join(errs...)
+}
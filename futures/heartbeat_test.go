@@ -0,0 +1,103 @@
+package futures
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+type heartbeatTestSuite struct {
+	baseHttpTestSuite
+	mu       sync.Mutex
+	requests map[string][]string
+	fail     bool
+}
+
+func TestHeartbeat(t *testing.T) {
+	suite.Run(t, new(heartbeatTestSuite))
+}
+
+func (s *heartbeatTestSuite) serve() *httptest.Server {
+	s.requests = make(map[string][]string)
+	s.fail = false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		symbol := r.URL.Query().Get("symbol")
+		s.requests[symbol] = append(s.requests[symbol], r.URL.Query().Get("countdownTime"))
+		if s.fail {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code":-1000,"msg":"unknown error"}`))
+			return
+		}
+		w.Write([]byte(`{"symbol":"` + symbol + `","countdownTime":"` + r.URL.Query().Get("countdownTime") + `"}`))
+	}))
+	s.client.Opt.Endpoint = server.URL
+	return server
+}
+
+func (s *heartbeatTestSuite) countdowns(symbol string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests[symbol]...)
+}
+
+func (s *heartbeatTestSuite) TestRefreshAndStop() {
+	server := s.serve()
+	defer server.Close()
+	hb := s.client.NewHeartbeat(time.Second, "BTCUSDT", "ETHUSDT").RefreshRatio(0.05)
+	r := s.r()
+	r.NoError(hb.Start(context.Background()))
+	r.Error(hb.Start(context.Background()))
+	r.Eventually(func() bool {
+		return len(s.countdowns("BTCUSDT")) >= 3 && len(s.countdowns("ETHUSDT")) >= 3
+	}, time.Second, 10*time.Millisecond)
+	r.NoError(hb.Stop(context.Background()))
+	for _, symbol := range []string{"BTCUSDT", "ETHUSDT"} {
+		countdowns := s.countdowns(symbol)
+		r.Equal("1000", countdowns[0])
+		r.Equal("0", countdowns[len(countdowns)-1], "disarmed on stop")
+	}
+	n := len(s.countdowns("BTCUSDT"))
+	time.Sleep(100 * time.Millisecond)
+	r.Len(s.countdowns("BTCUSDT"), n, "no refresh after stop")
+}
+
+func (s *heartbeatTestSuite) TestFailure() {
+	server := s.serve()
+	defer server.Close()
+	failures := make(chan *HeartbeatError, 16)
+	hb := s.client.NewHeartbeat(time.Second, "BTCUSDT").RefreshRatio(0.05).OnFailure(func(err *HeartbeatError) {
+		failures <- err
+	})
+	r := s.r()
+	r.NoError(hb.Start(context.Background()))
+	s.mu.Lock()
+	s.fail = true
+	s.mu.Unlock()
+	select {
+	case err := <-failures:
+		r.Equal("BTCUSDT", err.Symbol)
+		r.False(err.Expired)
+		r.Error(errors.Unwrap(err))
+	case <-time.After(time.Second):
+		r.Fail("expected a refresh failure")
+	}
+	r.Error(hb.Stop(context.Background()))
+}
+
+func (s *heartbeatTestSuite) TestStartFailure() {
+	server := s.serve()
+	defer server.Close()
+	s.fail = true
+	r := s.r()
+	var hbErr *HeartbeatError
+	r.ErrorAs(s.client.NewHeartbeat(time.Second, "BTCUSDT").Start(context.Background()), &hbErr)
+	r.Equal("BTCUSDT", hbErr.Symbol)
+	r.Error(s.client.NewHeartbeat(time.Millisecond, "BTCUSDT").Start(context.Background()))
+}
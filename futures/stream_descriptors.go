@@ -0,0 +1,119 @@
+package futures
+
+import (
+	"fmt"
+	"github.com/jekaxv/go-binance/core"
+	"strings"
+	"time"
+)
+
+// StreamDescriptors Builds descriptors of the USDⓈ-M futures market and user data streams, use it through Streams.
+type StreamDescriptors struct{}
+
+// Streams Describes USDⓈ-M futures streams, e.g. futures.Streams.MarkPrice("BTCUSDT", 3*time.Second).
+// Subscribe a descriptor with core.Subscribe or mix several into one connection with core.SubscribeCombined.
+var Streams StreamDescriptors
+
+// AggTrade Stream Name: <symbol>@aggTrade
+func (StreamDescriptors) AggTrade(symbol string) core.StreamDescriptor[*AggTradeEvent] {
+	return core.NewStreamDescriptor[*AggTradeEvent](strings.ToLower(symbol) + "@aggTrade")
+}
+
+// MarkPrice Stream Name: <symbol>@markPrice OR <symbol>@markPrice@1s
+// Update Speed: 3000ms (the default) or 1000ms.
+func (StreamDescriptors) MarkPrice(symbol string, interval ...time.Duration) core.StreamDescriptor[*MarkPriceEvent] {
+	return core.NewStreamDescriptor[*MarkPriceEvent](fmt.Sprintf("%s@markPrice%s", strings.ToLower(symbol), core.UpdateSpeed(3*time.Second, interval...)))
+}
+
+// MarkPriceArr Stream Name: !markPrice@arr OR !markPrice@arr@1s
+// Update Speed: 3000ms (the default) or 1000ms.
+func (StreamDescriptors) MarkPriceArr(interval ...time.Duration) core.StreamDescriptor[[]*MarkPriceEvent] {
+	return core.NewStreamDescriptor[[]*MarkPriceEvent]("!markPrice@arr" + core.UpdateSpeed(3*time.Second, interval...))
+}
+
+// Kline Stream Name: <symbol>@kline_<interval>
+func (StreamDescriptors) Kline(symbol string, interval core.IntervalEnum) core.StreamDescriptor[*KlineEvent] {
+	return core.NewStreamDescriptor[*KlineEvent](fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval))
+}
+
+// ContractKline Stream Name: <pair>_<contractType>@continuousKline_<interval>
+func (StreamDescriptors) ContractKline(pair string, contractType core.ContractType, interval core.IntervalEnum) core.StreamDescriptor[*ContractKlineEvent] {
+	return core.NewStreamDescriptor[*ContractKlineEvent](fmt.Sprintf("%s_%s@continuousKline_%s", strings.ToLower(pair), strings.ToLower(string(contractType)), interval))
+}
+
+// MiniTicker Stream Name: <symbol>@miniTicker
+func (StreamDescriptors) MiniTicker(symbol string) core.StreamDescriptor[*MiniTickerEvent] {
+	return core.NewStreamDescriptor[*MiniTickerEvent](strings.ToLower(symbol) + "@miniTicker")
+}
+
+// MiniTickerArr Stream Name: !miniTicker@arr
+func (StreamDescriptors) MiniTickerArr() core.StreamDescriptor[[]*MiniTickerEvent] {
+	return core.NewStreamDescriptor[[]*MiniTickerEvent]("!miniTicker@arr")
+}
+
+// Ticker Stream Name: <symbol>@ticker
+func (StreamDescriptors) Ticker(symbol string) core.StreamDescriptor[*TickerEvent] {
+	return core.NewStreamDescriptor[*TickerEvent](strings.ToLower(symbol) + "@ticker")
+}
+
+// TickerArr Stream Name: !ticker@arr
+func (StreamDescriptors) TickerArr() core.StreamDescriptor[[]*TickerEvent] {
+	return core.NewStreamDescriptor[[]*TickerEvent]("!ticker@arr")
+}
+
+// BookTicker Stream Name: <symbol>@bookTicker
+func (StreamDescriptors) BookTicker(symbol string) core.StreamDescriptor[*BookTickerEvent] {
+	return core.NewStreamDescriptor[*BookTickerEvent](strings.ToLower(symbol) + "@bookTicker")
+}
+
+// BestBookTicker Stream Name: !bookTicker
+func (StreamDescriptors) BestBookTicker() core.StreamDescriptor[*BookTickerEvent] {
+	return core.NewStreamDescriptor[*BookTickerEvent]("!bookTicker")
+}
+
+// ForceOrder Stream Name: <symbol>@forceOrder
+func (StreamDescriptors) ForceOrder(symbol string) core.StreamDescriptor[*ForceOrderEvent] {
+	return core.NewStreamDescriptor[*ForceOrderEvent](strings.ToLower(symbol) + "@forceOrder")
+}
+
+// AllForceOrder Stream Name: !forceOrder@arr
+func (StreamDescriptors) AllForceOrder() core.StreamDescriptor[*ForceOrderEvent] {
+	return core.NewStreamDescriptor[*ForceOrderEvent]("!forceOrder@arr")
+}
+
+// Depth Stream Name: <symbol>@depth OR <symbol>@depth@500ms OR <symbol>@depth@100ms
+// Update Speed: 250ms (the default), 500ms or 100ms.
+func (StreamDescriptors) Depth(symbol string, interval ...time.Duration) core.StreamDescriptor[*DepthEvent] {
+	return core.NewStreamDescriptor[*DepthEvent](fmt.Sprintf("%s@depth%s", strings.ToLower(symbol), core.UpdateSpeed(250*time.Millisecond, interval...)))
+}
+
+// DepthLevel Stream Name: <symbol>@depth<levels> OR <symbol>@depth<levels>@500ms OR <symbol>@depth<levels>@100ms, levels 5, 10 or 20.
+// Update Speed: 250ms (the default), 500ms or 100ms.
+func (StreamDescriptors) DepthLevel(symbol string, level int, interval ...time.Duration) core.StreamDescriptor[*DepthEvent] {
+	return core.NewStreamDescriptor[*DepthEvent](fmt.Sprintf("%s@depth%d%s", strings.ToLower(symbol), level, core.UpdateSpeed(250*time.Millisecond, interval...)))
+}
+
+// CompositeIndex Stream Name: <symbol>@compositeIndex
+func (StreamDescriptors) CompositeIndex(symbol string) core.StreamDescriptor[*CompositeIndexEvent] {
+	return core.NewStreamDescriptor[*CompositeIndexEvent](strings.ToLower(symbol) + "@compositeIndex")
+}
+
+// ContractInfo Stream Name: !contractInfo
+func (StreamDescriptors) ContractInfo() core.StreamDescriptor[*ContractInfoEvent] {
+	return core.NewStreamDescriptor[*ContractInfoEvent]("!contractInfo")
+}
+
+// AssetIndexArr Stream Name: !assetIndex@arr
+func (StreamDescriptors) AssetIndexArr() core.StreamDescriptor[[]*AssetIndexArr] {
+	return core.NewStreamDescriptor[[]*AssetIndexArr]("!assetIndex@arr")
+}
+
+// AssetIndex Stream Name: <assetSymbol>@assetIndex
+func (StreamDescriptors) AssetIndex(symbol string) core.StreamDescriptor[*AssetIndexArr] {
+	return core.NewStreamDescriptor[*AssetIndexArr](strings.ToLower(symbol) + "@assetIndex")
+}
+
+// UserData Stream Name: <listenKey>
+func (StreamDescriptors) UserData(listenKey string) core.StreamDescriptor[*UserDataEvent] {
+	return core.NewStreamDescriptorFunc(listenKey, parseUserEvent)
+}
@@ -1,12 +1,9 @@
 package futures
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/jekaxv/go-binance/core"
 	"github.com/shopspring/decimal"
-	"strings"
+	"time"
 )
 
 type WebsocketStreams struct {
@@ -16,7 +13,7 @@ type WebsocketStreams struct {
 // AggTradeService The Aggregate Trade Streams push market trade information that is aggregated for fills with same price and taking side every 100 milliseconds.
 // Only market trades will be aggregated, which means the insurance fund trades and ADL trades won't be aggregated.
 type AggTradeService struct {
-	*WebsocketStreams
+	*core.Stream[*AggTradeEvent]
 }
 
 type AggTradeEvent struct {
@@ -35,41 +32,11 @@ type AggTradeEvent struct {
 
 // SubscribeAggTrade Stream Name: <symbol>@aggTrade
 func (s *WebsocketStreams) SubscribeAggTrade(symbol string) *AggTradeService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s@aggTrade", s.c.getEndpoint(), strings.ToLower(symbol)))
-	return &AggTradeService{s}
-}
-
-func (e *AggTradeService) Do(ctx context.Context) (<-chan *AggTradeEvent, <-chan error) {
-	messageCh := make(chan *AggTradeEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *AggTradeEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &AggTradeService{core.NewRawStream(s.c.WsClient, Streams.AggTrade(symbol))}
 }
 
 type CombinedAggTradeService struct {
-	*WebsocketStreams
+	*core.Stream[*CombinedAggTradeEvent]
 }
 
 type CombinedAggTradeEvent struct {
@@ -78,46 +45,16 @@ type CombinedAggTradeEvent struct {
 }
 
 func (s *WebsocketStreams) SubscribeCombinedAggTrade(symbols []string) *CombinedAggTradeService {
-	s.c.combined(true)
-	endpoint := s.c.getEndpoint()
+	streams := make([]core.Descriptor, 0, len(symbols))
 	for _, symbol := range symbols {
-		endpoint += fmt.Sprintf("%s@aggTrade", strings.ToLower(symbol)) + "/"
+		streams = append(streams, Streams.AggTrade(symbol))
 	}
-	s.c.setEndpoint(endpoint[:len(endpoint)-1])
-	return &CombinedAggTradeService{s}
-}
-
-func (e *CombinedAggTradeService) Do(ctx context.Context) (<-chan *CombinedAggTradeEvent, <-chan error) {
-	messageCh := make(chan *CombinedAggTradeEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CombinedAggTradeEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CombinedAggTradeService{core.NewCombinedStream[*CombinedAggTradeEvent](s.c.WsClient, streams...)}
 }
 
 // MarkPriceService Mark price and funding rate for a single symbol pushed every 3 seconds or every second.
 type MarkPriceService struct {
-	*WebsocketStreams
+	*core.Stream[*MarkPriceEvent]
 }
 
 type MarkPriceEvent struct {
@@ -131,43 +68,22 @@ type MarkPriceEvent struct {
 	NextFundingTime int64           `json:"T"`
 }
 
-// SubscribeMarkPrice Stream Name: <symbol>@markPrice
-func (s *WebsocketStreams) SubscribeMarkPrice(symbol string) *MarkPriceService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s@markPrice@1s", s.c.getEndpoint(), strings.ToLower(symbol)))
-	return &MarkPriceService{s}
-}
-
-func (e *MarkPriceService) Do(ctx context.Context) (<-chan *MarkPriceEvent, <-chan error) {
-	messageCh := make(chan *MarkPriceEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *MarkPriceEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+// markPriceInterval The update speed of the mark price Subscribe* methods, which push every second unless told otherwise.
+func markPriceInterval(interval []time.Duration) time.Duration {
+	if len(interval) != 0 {
+		return interval[0]
+	}
+	return time.Second
+}
+
+// SubscribeMarkPrice Stream Name: <symbol>@markPrice OR <symbol>@markPrice@1s
+// Update Speed: 3000ms or 1000ms, 1000ms when interval is not given.
+func (s *WebsocketStreams) SubscribeMarkPrice(symbol string, interval ...time.Duration) *MarkPriceService {
+	return &MarkPriceService{core.NewRawStream(s.c.WsClient, Streams.MarkPrice(symbol, markPriceInterval(interval)))}
 }
 
 type CombinedMarkPriceService struct {
-	*WebsocketStreams
+	*core.Stream[*CombinedMarkPriceEvent]
 }
 
 type CombinedMarkPriceEvent struct {
@@ -175,87 +91,29 @@ type CombinedMarkPriceEvent struct {
 	Data   *MarkPriceEvent `json:"data"`
 }
 
-func (s *WebsocketStreams) SubscribeCombinedMarkPrice(symbols []string) *CombinedMarkPriceService {
-	s.c.combined(true)
-	endpoint := s.c.getEndpoint()
+// SubscribeCombinedMarkPrice Stream Name: <symbol>@markPrice OR <symbol>@markPrice@1s, 1000ms when interval is not given.
+func (s *WebsocketStreams) SubscribeCombinedMarkPrice(symbols []string, interval ...time.Duration) *CombinedMarkPriceService {
+	streams := make([]core.Descriptor, 0, len(symbols))
 	for _, symbol := range symbols {
-		endpoint += fmt.Sprintf("%s@aggTrade", strings.ToLower(symbol)) + "/"
+		streams = append(streams, Streams.MarkPrice(symbol, markPriceInterval(interval)))
 	}
-	s.c.setEndpoint(endpoint[:len(endpoint)-1])
-	return &CombinedMarkPriceService{s}
-}
-
-func (e *CombinedMarkPriceService) Do(ctx context.Context) (<-chan *CombinedMarkPriceEvent, <-chan error) {
-	messageCh := make(chan *CombinedMarkPriceEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CombinedMarkPriceEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CombinedMarkPriceService{core.NewCombinedStream[*CombinedMarkPriceEvent](s.c.WsClient, streams...)}
 }
 
 // MarkPriceArrService Mark price and funding rate for all symbols pushed every 3 seconds or every second.
 type MarkPriceArrService struct {
-	*WebsocketStreams
-}
-
-// SubscribeMarkPriceArr Stream Name: !markPrice@arr
-func (s *WebsocketStreams) SubscribeMarkPriceArr() *MarkPriceArrService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/!markPrice@arr@1s", s.c.getEndpoint()))
-	return &MarkPriceArrService{s}
-}
-
-func (e *MarkPriceArrService) Do(ctx context.Context) (<-chan []*MarkPriceEvent, <-chan error) {
-	messageCh := make(chan []*MarkPriceEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event []*MarkPriceEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	*core.Stream[[]*MarkPriceEvent]
+}
+
+// SubscribeMarkPriceArr Stream Name: !markPrice@arr OR !markPrice@arr@1s
+// Update Speed: 3000ms or 1000ms, 1000ms when interval is not given.
+func (s *WebsocketStreams) SubscribeMarkPriceArr(interval ...time.Duration) *MarkPriceArrService {
+	return &MarkPriceArrService{core.NewRawStream(s.c.WsClient, Streams.MarkPriceArr(markPriceInterval(interval)))}
 }
 
 // KlineService The Kline/Candlestick Stream push updates to the current klines/candlestick every 250 milliseconds (if existing).
 type KlineService struct {
-	*WebsocketStreams
+	*core.Stream[*KlineEvent]
 }
 
 type KlineResult struct {
@@ -287,41 +145,11 @@ type KlineEvent struct {
 
 // SubscribeKline Stream Name: <symbol>@kline_<interval>
 func (s *WebsocketStreams) SubscribeKline(symbol string, interval core.IntervalEnum) *KlineService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s@kline_%s", s.c.getEndpoint(), strings.ToLower(symbol), interval))
-	return &KlineService{s}
-}
-
-func (e *KlineService) Do(ctx context.Context) (<-chan *KlineEvent, <-chan error) {
-	messageCh := make(chan *KlineEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *KlineEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &KlineService{core.NewRawStream(s.c.WsClient, Streams.Kline(symbol, interval))}
 }
 
 type CombinedKlineService struct {
-	*WebsocketStreams
+	*core.Stream[*CombinedKlineEvent]
 }
 
 type CombinedKlineEvent struct {
@@ -331,46 +159,16 @@ type CombinedKlineEvent struct {
 
 // SubscribeCombinedKline Stream Name: <symbol>@kline_<interval>
 func (s *WebsocketStreams) SubscribeCombinedKline(symbols map[string]string) *CombinedKlineService {
-	s.c.combined(true)
-	endpoint := s.c.getEndpoint()
+	streams := make([]core.Descriptor, 0, len(symbols))
 	for symbol, interval := range symbols {
-		endpoint += fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval) + "/"
+		streams = append(streams, Streams.Kline(symbol, core.IntervalEnum(interval)))
 	}
-	s.c.setEndpoint(endpoint[:len(endpoint)-1])
-	return &CombinedKlineService{s}
-}
-
-func (e *CombinedKlineService) Do(ctx context.Context) (<-chan *CombinedKlineEvent, <-chan error) {
-	messageCh := make(chan *CombinedKlineEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CombinedKlineEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CombinedKlineService{core.NewCombinedStream[*CombinedKlineEvent](s.c.WsClient, streams...)}
 }
 
 // ContractKlineService Continuous Contract Kline/Candlestick Streams
 type ContractKlineService struct {
-	*WebsocketStreams
+	*core.Stream[*ContractKlineEvent]
 }
 
 type ContractKlineEvent struct {
@@ -383,43 +181,13 @@ type ContractKlineEvent struct {
 
 // SubscribeContractKline Stream Name: <pair>_<contractType>@continuousKline_<interval>
 func (s *WebsocketStreams) SubscribeContractKline(symbol string, contractType core.ContractType, interval core.IntervalEnum) *ContractKlineService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s_%s@continuousKline_%s", s.c.getEndpoint(), strings.ToLower(symbol), strings.ToLower(string(contractType)), interval))
-	return &ContractKlineService{s}
-}
-
-func (e *ContractKlineService) Do(ctx context.Context) (<-chan *ContractKlineEvent, <-chan error) {
-	messageCh := make(chan *ContractKlineEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *ContractKlineEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &ContractKlineService{core.NewRawStream(s.c.WsClient, Streams.ContractKline(symbol, contractType, interval))}
 }
 
 // MiniTickerService 24hr rolling window mini-ticker statistics for a single symbol.
 // These are NOT the statistics of the UTC day, but a 24hr rolling window from requestTime to 24hrs before.
 type MiniTickerService struct {
-	*WebsocketStreams
+	*core.Stream[*MiniTickerEvent]
 }
 
 type MiniTickerEvent struct {
@@ -436,41 +204,11 @@ type MiniTickerEvent struct {
 
 // SubscribeMiniTicker Stream Name: <symbol>@miniTicker
 func (s *WebsocketStreams) SubscribeMiniTicker(symbol string) *MiniTickerService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s@miniTicker", s.c.getEndpoint(), strings.ToLower(symbol)))
-	return &MiniTickerService{s}
-}
-
-func (e *MiniTickerService) Do(ctx context.Context) (<-chan *MiniTickerEvent, <-chan error) {
-	messageCh := make(chan *MiniTickerEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *MiniTickerEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &MiniTickerService{core.NewRawStream(s.c.WsClient, Streams.MiniTicker(symbol))}
 }
 
 type CombinedMiniTickerService struct {
-	*WebsocketStreams
+	*core.Stream[*CombinedMiniTickerEvent]
 }
 
 type CombinedMiniTickerEvent struct {
@@ -480,47 +218,17 @@ type CombinedMiniTickerEvent struct {
 
 // SubscribeCombinedMiniTicker Stream Name: <symbol>@miniTicker
 func (s *WebsocketStreams) SubscribeCombinedMiniTicker(symbols []string) *CombinedMiniTickerService {
-	s.c.combined(true)
-	endpoint := s.c.getEndpoint()
+	streams := make([]core.Descriptor, 0, len(symbols))
 	for _, symbol := range symbols {
-		endpoint += fmt.Sprintf("%s@miniTicker", strings.ToLower(symbol)) + "/"
+		streams = append(streams, Streams.MiniTicker(symbol))
 	}
-	s.c.setEndpoint(endpoint[:len(endpoint)-1])
-	return &CombinedMiniTickerService{s}
-}
-
-func (e *CombinedMiniTickerService) Do(ctx context.Context) (<-chan *CombinedMiniTickerEvent, <-chan error) {
-	messageCh := make(chan *CombinedMiniTickerEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CombinedMiniTickerEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CombinedMiniTickerService{core.NewCombinedStream[*CombinedMiniTickerEvent](s.c.WsClient, streams...)}
 }
 
 // TickerService 24hr rolling window ticker statistics for a single symbol.
 // These are NOT the statistics of the UTC day, but a 24hr rolling window from requestTime to 24hrs before.
 type TickerService struct {
-	*WebsocketStreams
+	*core.Stream[*TickerEvent]
 }
 
 type TickerEvent struct {
@@ -546,41 +254,11 @@ type TickerEvent struct {
 
 // SubscribeTicker Stream Name: <symbol>@ticker
 func (s *WebsocketStreams) SubscribeTicker(symbol string) *TickerService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s@ticker", s.c.getEndpoint(), strings.ToLower(symbol)))
-	return &TickerService{s}
-}
-
-func (e *TickerService) Do(ctx context.Context) (<-chan *TickerEvent, <-chan error) {
-	messageCh := make(chan *TickerEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *TickerEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &TickerService{core.NewRawStream(s.c.WsClient, Streams.Ticker(symbol))}
 }
 
 type CombinedTickerService struct {
-	*WebsocketStreams
+	*core.Stream[*CombinedTickerEvent]
 }
 
 type CombinedTickerEvent struct {
@@ -590,131 +268,41 @@ type CombinedTickerEvent struct {
 
 // SubscribeCombinedTicker Stream Name: <symbol>@ticker
 func (s *WebsocketStreams) SubscribeCombinedTicker(symbols []string) *CombinedTickerService {
-	s.c.combined(true)
-	endpoint := s.c.getEndpoint()
+	streams := make([]core.Descriptor, 0, len(symbols))
 	for _, symbol := range symbols {
-		endpoint += fmt.Sprintf("%s@ticker", strings.ToLower(symbol)) + "/"
+		streams = append(streams, Streams.Ticker(symbol))
 	}
-	s.c.setEndpoint(endpoint[:len(endpoint)-1])
-	return &CombinedTickerService{s}
-}
-
-func (e *CombinedTickerService) Do(ctx context.Context) (<-chan *CombinedTickerEvent, <-chan error) {
-	messageCh := make(chan *CombinedTickerEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CombinedTickerEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CombinedTickerService{core.NewCombinedStream[*CombinedTickerEvent](s.c.WsClient, streams...)}
 }
 
 // TickerArrService 24hr rolling window ticker statistics for all symbols that changed in an array.
 // These are NOT the statistics of the UTC day, but a 24hr rolling window for the previous 24hrs.
 // Note that only tickers that have changed will be present in the array.
 type TickerArrService struct {
-	*WebsocketStreams
+	*core.Stream[[]*TickerEvent]
 }
 
 // SubscribeTickerArr Stream Name: !ticker@arr
 func (s *WebsocketStreams) SubscribeTickerArr() *TickerArrService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/!ticker@arr", s.c.getEndpoint()))
-	return &TickerArrService{s}
-}
-
-func (e *TickerArrService) Do(ctx context.Context) (<-chan []*TickerEvent, <-chan error) {
-	messageCh := make(chan []*TickerEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event []*TickerEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &TickerArrService{core.NewRawStream(s.c.WsClient, Streams.TickerArr())}
 }
 
 // MiniTickerArrService 24hr rolling window mini-ticker statistics for all symbols that changed in an array.
 // These are NOT the statistics of the UTC day, but a 24hr rolling window for the previous 24hrs.
 // Note that only tickers that have changed will be present in the array.
 type MiniTickerArrService struct {
-	*WebsocketStreams
+	*core.Stream[[]*MiniTickerEvent]
 }
 
 // SubscribeMiniTickerArr Stream Name: !miniTicker@arr
 func (s *WebsocketStreams) SubscribeMiniTickerArr() *MiniTickerArrService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/!miniTicker@arr", s.c.getEndpoint()))
-	return &MiniTickerArrService{s}
-}
-
-func (e *MiniTickerArrService) Do(ctx context.Context) (<-chan []*MiniTickerEvent, <-chan error) {
-	messageCh := make(chan []*MiniTickerEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event []*MiniTickerEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &MiniTickerArrService{core.NewRawStream(s.c.WsClient, Streams.MiniTickerArr())}
 }
 
 // BookTickerService Pushes any update to the best bid or ask's price or quantity in real-time for a specified symbol.
 // Multiple <symbol>@bookTicker streams can be subscribed to over one connection.
 type BookTickerService struct {
-	*WebsocketStreams
+	*core.Stream[*BookTickerEvent]
 }
 
 type BookTickerEvent struct {
@@ -731,41 +319,11 @@ type BookTickerEvent struct {
 
 // SubscribeBookTicker Stream Name: <symbol>@bookTicker
 func (s *WebsocketStreams) SubscribeBookTicker(symbol string) *BookTickerService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s@bookTicker", s.c.getEndpoint(), strings.ToLower(symbol)))
-	return &BookTickerService{s}
-}
-
-func (e *BookTickerService) Do(ctx context.Context) (<-chan *BookTickerEvent, <-chan error) {
-	messageCh := make(chan *BookTickerEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *BookTickerEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &BookTickerService{core.NewRawStream(s.c.WsClient, Streams.BookTicker(symbol))}
 }
 
 type CombinedBookTickerService struct {
-	*WebsocketStreams
+	*core.Stream[*CombinedBookTickerEvent]
 }
 
 type CombinedBookTickerEvent struct {
@@ -775,88 +333,28 @@ type CombinedBookTickerEvent struct {
 
 // SubscribeCombinedBookTicker Stream Name: <symbol>@bookTicker
 func (s *WebsocketStreams) SubscribeCombinedBookTicker(symbols []string) *CombinedBookTickerService {
-	s.c.combined(true)
-	endpoint := s.c.getEndpoint()
+	streams := make([]core.Descriptor, 0, len(symbols))
 	for _, symbol := range symbols {
-		endpoint += fmt.Sprintf("%s@bookTicker", strings.ToLower(symbol)) + "/"
+		streams = append(streams, Streams.BookTicker(symbol))
 	}
-	s.c.setEndpoint(endpoint[:len(endpoint)-1])
-	return &CombinedBookTickerService{s}
-}
-
-func (e *CombinedBookTickerService) Do(ctx context.Context) (<-chan *CombinedBookTickerEvent, <-chan error) {
-	messageCh := make(chan *CombinedBookTickerEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CombinedBookTickerEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CombinedBookTickerService{core.NewCombinedStream[*CombinedBookTickerEvent](s.c.WsClient, streams...)}
 }
 
 // BestBookTickerService Pushes any update to the best bid or ask's price or quantity in real-time for all symbols.
 type BestBookTickerService struct {
-	*WebsocketStreams
+	*core.Stream[*BookTickerEvent]
 }
 
 // SubscribeBestBookTicker Stream Name: !bookTicker
 func (s *WebsocketStreams) SubscribeBestBookTicker() *BestBookTickerService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/!bookTicker", s.c.getEndpoint()))
-	return &BestBookTickerService{s}
-}
-
-func (e *BestBookTickerService) Do(ctx context.Context) (<-chan *BookTickerEvent, <-chan error) {
-	messageCh := make(chan *BookTickerEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *BookTickerEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &BestBookTickerService{core.NewRawStream(s.c.WsClient, Streams.BestBookTicker())}
 }
 
 // ForceOrderService The Liquidation Order Snapshot Streams push force liquidation order information for specific symbol.
 // For each symbol，only the latest one liquidation order within 1000ms will be pushed as the snapshot.
 // If no liquidation happens in the interval of 1000ms, no stream will be pushed.
 type ForceOrderService struct {
-	*WebsocketStreams
+	*core.Stream[*ForceOrderEvent]
 }
 
 type ForceOrderEvent struct {
@@ -879,41 +377,11 @@ type ForceOrderEvent struct {
 
 // SubscribeForceOrder Stream Name: <symbol>@forceOrder
 func (s *WebsocketStreams) SubscribeForceOrder(symbol string) *ForceOrderService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s@forceOrder", s.c.getEndpoint(), strings.ToLower(symbol)))
-	return &ForceOrderService{s}
-}
-
-func (e *ForceOrderService) Do(ctx context.Context) (<-chan *ForceOrderEvent, <-chan error) {
-	messageCh := make(chan *ForceOrderEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *ForceOrderEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &ForceOrderService{core.NewRawStream(s.c.WsClient, Streams.ForceOrder(symbol))}
 }
 
 type CombinedForceOrderService struct {
-	*WebsocketStreams
+	*core.Stream[*CombinedForceOrderEvent]
 }
 
 type CombinedForceOrderEvent struct {
@@ -923,88 +391,28 @@ type CombinedForceOrderEvent struct {
 
 // SubscribeCombinedForceOrder Stream Name: <symbol>@forceOrder
 func (s *WebsocketStreams) SubscribeCombinedForceOrder(symbols []string) *CombinedForceOrderService {
-	s.c.combined(true)
-	endpoint := s.c.getEndpoint()
+	streams := make([]core.Descriptor, 0, len(symbols))
 	for _, symbol := range symbols {
-		endpoint += fmt.Sprintf("%s@forceOrder", strings.ToLower(symbol)) + "/"
+		streams = append(streams, Streams.ForceOrder(symbol))
 	}
-	s.c.setEndpoint(endpoint[:len(endpoint)-1])
-	return &CombinedForceOrderService{s}
-}
-
-func (e *CombinedForceOrderService) Do(ctx context.Context) (<-chan *CombinedForceOrderEvent, <-chan error) {
-	messageCh := make(chan *CombinedForceOrderEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CombinedForceOrderEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CombinedForceOrderService{core.NewCombinedStream[*CombinedForceOrderEvent](s.c.WsClient, streams...)}
 }
 
 // AllForceOrderService The All Liquidation Order Snapshot Streams push force liquidation order information for all symbols in the market.
 // For each symbol，only the latest one liquidation order within 1000ms will be pushed as the snapshot.
 // If no liquidation happens in the interval of 1000ms, no stream will be pushed.
 type AllForceOrderService struct {
-	*WebsocketStreams
+	*core.Stream[*ForceOrderEvent]
 }
 
 // SubscribeAllForceOrder Stream Name: !forceOrder@arr
 func (s *WebsocketStreams) SubscribeAllForceOrder() *AllForceOrderService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/!forceOrder@arr", s.c.getEndpoint()))
-	return &AllForceOrderService{s}
-}
-
-func (e *AllForceOrderService) Do(ctx context.Context) (<-chan *ForceOrderEvent, <-chan error) {
-	messageCh := make(chan *ForceOrderEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *ForceOrderEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &AllForceOrderService{core.NewRawStream(s.c.WsClient, Streams.AllForceOrder())}
 }
 
 // DepthService Diff. Book WsDepth Streams
 type DepthService struct {
-	*WebsocketStreams
+	*core.Stream[*DepthEvent]
 }
 
 type DepthEvent struct {
@@ -1022,44 +430,11 @@ type DepthEvent struct {
 // SubscribeDepth Stream Names: <symbol>@depth OR <symbol>@depth@100ms
 // Update Speed: 250ms, 500ms, 100ms
 func (s *WebsocketStreams) SubscribeDepth(symbol string, interval ...string) *DepthService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s@depth", s.c.getEndpoint(), strings.ToLower(symbol)))
-	if len(interval) != 0 {
-		s.c.setEndpoint(fmt.Sprintf("%s@%s", s.c.getEndpoint(), interval[0]))
-	}
-	return &DepthService{s}
-}
-
-func (e *DepthService) Do(ctx context.Context) (<-chan *DepthEvent, <-chan error) {
-	messageCh := make(chan *DepthEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *DepthEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &DepthService{core.NewRawStream(s.c.WsClient, Streams.Depth(symbol, core.ParseUpdateSpeed(interval...)...))}
 }
 
 type CombinedDepthService struct {
-	*WebsocketStreams
+	*core.Stream[*CombinedDepthEvent]
 }
 
 type CombinedDepthEvent struct {
@@ -1070,94 +445,27 @@ type CombinedDepthEvent struct {
 // SubscribeCombinedDepth Stream Names: <symbol>@depth OR <symbol>@depth@100ms
 // Update Speed: 1000ms or 100ms
 func (s *WebsocketStreams) SubscribeCombinedDepth(symbols []string, interval ...string) *CombinedDepthService {
-	s.c.combined(true)
-	endpoint := s.c.getEndpoint()
+	streams := make([]core.Descriptor, 0, len(symbols))
 	for _, symbol := range symbols {
-		endpoint += fmt.Sprintf("%s@depth", strings.ToLower(symbol))
-		if len(interval) != 0 {
-			endpoint += "@" + interval[0]
-		}
-		endpoint += "/"
+		streams = append(streams, Streams.Depth(symbol, core.ParseUpdateSpeed(interval...)...))
 	}
-	s.c.setEndpoint(endpoint[:len(endpoint)-1])
-	return &CombinedDepthService{s}
-}
-
-func (e *CombinedDepthService) Do(ctx context.Context) (<-chan *CombinedDepthEvent, <-chan error) {
-	messageCh := make(chan *CombinedDepthEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CombinedDepthEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CombinedDepthService{core.NewCombinedStream[*CombinedDepthEvent](s.c.WsClient, streams...)}
 }
 
 // DepthLevelService Top <levels> bids and asks, pushed every second. Valid <levels> are 5, 10, or 20.
 type DepthLevelService struct {
-	*WebsocketStreams
+	*core.Stream[*DepthEvent]
 }
 
 // SubscribeDepthLevel Stream Names: <symbol>@depth<levels> OR <symbol>@depth<levels>@100ms
 // Top <levels> bids and asks, pushed every second. Valid <levels> are 5, 10, or 20.
 // Update Speed: 1000ms or 100ms
 func (s *WebsocketStreams) SubscribeDepthLevel(symbol string, level int, interval ...string) *DepthLevelService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s@depth%d", s.c.getEndpoint(), strings.ToLower(symbol), level))
-	if len(interval) != 0 {
-		s.c.setEndpoint(fmt.Sprintf("%s@%s", s.c.getEndpoint(), interval[0]))
-	}
-	return &DepthLevelService{s}
-}
-
-func (e *DepthLevelService) Do(ctx context.Context) (<-chan *DepthEvent, <-chan error) {
-	messageCh := make(chan *DepthEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *DepthEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &DepthLevelService{core.NewRawStream(s.c.WsClient, Streams.DepthLevel(symbol, level, core.ParseUpdateSpeed(interval...)...))}
 }
 
 type CombinedDepthLevelService struct {
-	*WebsocketStreams
+	*core.Stream[*CombinedDepthLevelEvent]
 }
 
 type CombinedDepthLevelEvent struct {
@@ -1169,50 +477,16 @@ type CombinedDepthLevelEvent struct {
 // Top <levels> bids and asks, pushed every second. Valid <levels> are 5, 10, or 20.
 // Update Speed: 1000ms or 100ms
 func (s *WebsocketStreams) SubscribeCombinedDepthLevel(symbols map[string]int, interval ...string) *CombinedDepthLevelService {
-	s.c.combined(true)
-	endpoint := s.c.getEndpoint()
+	streams := make([]core.Descriptor, 0, len(symbols))
 	for symbol, level := range symbols {
-		endpoint += fmt.Sprintf("%s@depth%d", strings.ToLower(symbol), level)
-		if len(interval) != 0 {
-			endpoint += "@" + interval[0]
-		}
-		endpoint += "/"
+		streams = append(streams, Streams.DepthLevel(symbol, level, core.ParseUpdateSpeed(interval...)...))
 	}
-	s.c.setEndpoint(endpoint[:len(endpoint)-1])
-	return &CombinedDepthLevelService{s}
-}
-
-func (e *CombinedDepthLevelService) Do(ctx context.Context) (<-chan *CombinedDepthLevelEvent, <-chan error) {
-	messageCh := make(chan *CombinedDepthLevelEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CombinedDepthLevelEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CombinedDepthLevelService{core.NewCombinedStream[*CombinedDepthLevelEvent](s.c.WsClient, streams...)}
 }
 
 // CompositeIndexService Composite index information for index symbols pushed every second.
 type CompositeIndexService struct {
-	*WebsocketStreams
+	*core.Stream[*CompositeIndexEvent]
 }
 
 type CompositeIndexEvent struct {
@@ -1232,41 +506,11 @@ type CompositeIndexEvent struct {
 
 // SubscribeCompositeIndex Stream Name: <symbol>@compositeIndex
 func (s *WebsocketStreams) SubscribeCompositeIndex(symbol string) *CompositeIndexService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s@compositeIndex", s.c.getEndpoint(), strings.ToLower(symbol)))
-	return &CompositeIndexService{s}
-}
-
-func (e *CompositeIndexService) Do(ctx context.Context) (<-chan *CompositeIndexEvent, <-chan error) {
-	messageCh := make(chan *CompositeIndexEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CompositeIndexEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CompositeIndexService{core.NewRawStream(s.c.WsClient, Streams.CompositeIndex(symbol))}
 }
 
 type CombinedCompositeIndexService struct {
-	*WebsocketStreams
+	*core.Stream[*CombinedCompositeIndexEvent]
 }
 
 type CombinedCompositeIndexEvent struct {
@@ -1276,47 +520,17 @@ type CombinedCompositeIndexEvent struct {
 
 // SubscribeCombinedCompositeIndex Stream Name: <symbol>@compositeIndex
 func (s *WebsocketStreams) SubscribeCombinedCompositeIndex(symbols []string) *CombinedCompositeIndexService {
-	s.c.combined(true)
-	endpoint := s.c.getEndpoint()
+	streams := make([]core.Descriptor, 0, len(symbols))
 	for _, symbol := range symbols {
-		endpoint += fmt.Sprintf("%s@compositeIndex", strings.ToLower(symbol)) + "/"
+		streams = append(streams, Streams.CompositeIndex(symbol))
 	}
-	s.c.setEndpoint(endpoint[:len(endpoint)-1])
-	return &CombinedCompositeIndexService{s}
-}
-
-func (e *CombinedCompositeIndexService) Do(ctx context.Context) (<-chan *CombinedCompositeIndexEvent, <-chan error) {
-	messageCh := make(chan *CombinedCompositeIndexEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CombinedCompositeIndexEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CombinedCompositeIndexService{core.NewCombinedStream[*CombinedCompositeIndexEvent](s.c.WsClient, streams...)}
 }
 
 // ContractInfoService ContractInfo stream pushes when contract info updates(listing/settlement/contract bracket update).
 // bks field only shows up when bracket gets updated.
 type ContractInfoService struct {
-	*WebsocketStreams
+	*core.Stream[*ContractInfoEvent]
 }
 type ContractInfoEvent struct {
 	Event          string `json:"e"`
@@ -1340,42 +554,12 @@ type ContractInfoEvent struct {
 
 // SubscribeContractInfo Stream Name: !contractInfo
 func (s *WebsocketStreams) SubscribeContractInfo() *ContractInfoService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/!contractInfo", s.c.getEndpoint()))
-	return &ContractInfoService{s}
-}
-
-func (e *ContractInfoService) Do(ctx context.Context) (<-chan *ContractInfoEvent, <-chan error) {
-	messageCh := make(chan *ContractInfoEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *ContractInfoEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &ContractInfoService{core.NewRawStream(s.c.WsClient, Streams.ContractInfo())}
 }
 
 // AssetIndexArrService Asset index for multi-assets mode user
 type AssetIndexArrService struct {
-	*WebsocketStreams
+	*core.Stream[[]*AssetIndexArr]
 }
 type AssetIndexArr struct {
 	Event             string          `json:"e"`
@@ -1394,81 +578,21 @@ type AssetIndexArr struct {
 
 // SubscribeAssetIndexArr Stream Name: !assetIndex@arr
 func (s *WebsocketStreams) SubscribeAssetIndexArr() *AssetIndexArrService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/!assetIndex@arr", s.c.getEndpoint()))
-	return &AssetIndexArrService{s}
-}
-
-func (e *AssetIndexArrService) Do(ctx context.Context) (<-chan []*AssetIndexArr, <-chan error) {
-	messageCh := make(chan []*AssetIndexArr, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event []*AssetIndexArr
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &AssetIndexArrService{core.NewRawStream(s.c.WsClient, Streams.AssetIndexArr())}
 }
 
 // AssetIndexService Asset index for multi-assets mode user
 type AssetIndexService struct {
-	*WebsocketStreams
+	*core.Stream[*AssetIndexArr]
 }
 
 // SubscribeAssetIndex Stream Name: <symbol>@assetIndex
 func (s *WebsocketStreams) SubscribeAssetIndex(symbol string) *AssetIndexService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s@assetIndex", s.c.getEndpoint(), strings.ToLower(symbol)))
-	return &AssetIndexService{s}
-}
-
-func (e *AssetIndexService) Do(ctx context.Context) (<-chan *AssetIndexArr, <-chan error) {
-	messageCh := make(chan *AssetIndexArr, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *AssetIndexArr
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &AssetIndexService{core.NewRawStream(s.c.WsClient, Streams.AssetIndex(symbol))}
 }
 
 type CombinedAssetIndexService struct {
-	*WebsocketStreams
+	*core.Stream[*CombinedAssetIndexEvent]
 }
 
 type CombinedAssetIndexEvent struct {
@@ -1477,39 +601,9 @@ type CombinedAssetIndexEvent struct {
 }
 
 func (s *WebsocketStreams) SubscribeCombinedAssetIndex(symbols []string) *CombinedAssetIndexService {
-	s.c.combined(true)
-	endpoint := s.c.getEndpoint()
+	streams := make([]core.Descriptor, 0, len(symbols))
 	for _, symbol := range symbols {
-		endpoint += fmt.Sprintf("%s@assetIndex", strings.ToLower(symbol)) + "/"
+		streams = append(streams, Streams.AssetIndex(symbol))
 	}
-	s.c.setEndpoint(endpoint[:len(endpoint)-1])
-	return &CombinedAssetIndexService{s}
-}
-
-func (e *CombinedAssetIndexService) Do(ctx context.Context) (<-chan *CombinedAssetIndexEvent, <-chan error) {
-	messageCh := make(chan *CombinedAssetIndexEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CombinedAssetIndexEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CombinedAssetIndexService{core.NewCombinedStream[*CombinedAssetIndexEvent](s.c.WsClient, streams...)}
 }
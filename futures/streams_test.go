@@ -6,6 +6,7 @@ import (
 	"github.com/jekaxv/go-binance/core"
 	"github.com/stretchr/testify/suite"
 	"testing"
+	"time"
 )
 
 type websocketStreamsTestSuite struct {
@@ -16,6 +17,16 @@ func TestWebsocketStreams(t *testing.T) {
 	suite.Run(t, new(websocketStreamsTestSuite))
 }
 
+func (s *websocketStreamsTestSuite) TestStreamDescriptors() {
+	r := s.r()
+	r.Equal("btcusdt@markPrice", Streams.MarkPrice("BTCUSDT").Name())
+	r.Equal("btcusdt@markPrice", Streams.MarkPrice("BTCUSDT", 3*time.Second).Name())
+	r.Equal("btcusdt@markPrice@1s", Streams.MarkPrice("BTCUSDT", time.Second).Name())
+	r.Equal("!markPrice@arr@1s", Streams.MarkPriceArr(time.Second).Name())
+	r.Equal("btcusdt@depth10@500ms", Streams.DepthLevel("BTCUSDT", 10, 500*time.Millisecond).Name())
+	r.Equal("btcusdt_perpetual@continuousKline_1h", Streams.ContractKline("BTCUSDT", core.ContractTypePERPETUAL, core.Interval1h).Name())
+}
+
 func (s *websocketStreamsTestSuite) TestWebSocketSubscribeAggTrade() {
 	msg := []byte(`{"e":"aggTrade","E":1737443769749,"s":"BTCUSDT","a":1019485,"p":"102342.24000000","q":"0.00254000","f":1071934,"l":1071934,"T":1737443769749,"m":false,"M":true}`)
 	server := s.setup(msg)
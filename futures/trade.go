@@ -3,6 +3,7 @@ package futures
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/jekaxv/go-binance/core"
 	"github.com/shopspring/decimal"
@@ -14,7 +15,7 @@ type OrderReq struct {
 	Symbol                  string                     `json:"symbol,omitempty"`
 	Side                    core.OrderSideEnum         `json:"side,omitempty"`
 	PositionSide            core.PositionSideEnum      `json:"positionSide,omitempty"`
-	OrderType               core.OrderTypeEnum         `json:"orderType,omitempty"`
+	OrderType               core.OrderTypeEnum         `json:"type,omitempty"`
 	TimeInForce             core.TimeInForceEnum       `json:"timeInForce,omitempty"`
 	Quantity                string                     `json:"quantity,omitempty"`
 	ReduceOnly              string                     `json:"reduceOnly,omitempty"`
@@ -184,24 +185,31 @@ func (s *CreateOrder) Do(ctx context.Context) (*OrderResponse, error) {
 }
 
 // PlaceBatchOrder Place Multiple Orders
+// Binance accepts at most 5 orders per request, larger slices are split and sent concurrently.
 // https://developers.binance.com/docs/derivatives/usds-margined-futures/trade/rest-api/Place-Multiple-Orders
 type PlaceBatchOrder struct {
-	c *Client
-	r *core.Request
+	c           *Client
+	r           *core.Request
+	orders      []OrderReq
+	concurrency int
 }
 
 type PlaceBatchOrderResponse struct {
 	OrderResponse
-	Code int    `json:"code"`
-	Msg  string `json:"msg"`
+	Code int             `json:"code"`
+	Msg  string          `json:"msg"`
+	Err  *BatchItemError `json:"-"`
 }
 
+// BatchOrders Any number of orders, results are aligned index-by-index with them.
 func (s *PlaceBatchOrder) BatchOrders(batchOrders []OrderReq) *PlaceBatchOrder {
-	orderJson, err := json.Marshal(batchOrders)
-	if err != nil {
-		return s
-	}
-	s.r.Set("batchOrders", string(orderJson))
+	s.orders = batchOrders
+	return s
+}
+
+// Concurrency Max number of batch requests in flight, default 4
+func (s *PlaceBatchOrder) Concurrency(concurrency int) *PlaceBatchOrder {
+	s.concurrency = concurrency
 	return s
 }
 
@@ -211,12 +219,45 @@ func (s *PlaceBatchOrder) RecvWindow(recvWindow int64) *PlaceBatchOrder {
 	return s
 }
 
+// Do The returned slice has one entry per order; rejected orders and orders of a chunk that could not
+// be sent carry Err. An error is returned only when no chunk could be sent at all.
 func (s *PlaceBatchOrder) Do(ctx context.Context) ([]*PlaceBatchOrderResponse, error) {
-	if err := s.c.invoke(s.r, ctx); err != nil {
-		return nil, err
+	if len(s.orders) == 0 {
+		return nil, errors.New("batchOrders is empty")
 	}
-	resp := make([]*PlaceBatchOrderResponse, 0)
-	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+	resp := make([]*PlaceBatchOrderResponse, len(s.orders))
+	err := s.c.dispatchBatch(ctx, splitBatch(0, len(s.orders), maxBatchOrders), s.concurrency,
+		func(ctx context.Context, c *Client, chunk batchChunk) error {
+			orderJson, err := json.Marshal(s.orders[chunk.start:chunk.end])
+			if err != nil {
+				return err
+			}
+			r := s.r.Clone()
+			r.Set("batchOrders", string(orderJson))
+			if err := c.invoke(r, ctx); err != nil {
+				return err
+			}
+			items := make([]*PlaceBatchOrderResponse, 0)
+			if err := json.Unmarshal(c.rawBody(), &items); err != nil {
+				return err
+			}
+			if len(items) != chunk.end-chunk.start {
+				return fmt.Errorf("expected %d batch results, got %d", chunk.end-chunk.start, len(items))
+			}
+			copy(resp[chunk.start:chunk.end], items)
+			return nil
+		},
+		func(chunk batchChunk, err error) {
+			for i := chunk.start; i < chunk.end; i++ {
+				resp[i] = &PlaceBatchOrderResponse{Err: &BatchItemError{Index: i, Err: err}}
+			}
+		})
+	for i, item := range resp {
+		if item.Err == nil && item.Code != 0 {
+			item.Err = &BatchItemError{Index: i, Code: item.Code, Msg: item.Msg}
+		}
+	}
+	return resp, err
 }
 
 type ModifyOrderReq struct {
@@ -314,23 +355,30 @@ func (s *ModifyOrder) Do(ctx context.Context) (*ModifyOrderResponse, error) {
 }
 
 // ModifyMultipleOrder Modify Multiple Orders (TRADE)
+// Binance accepts at most 5 orders per request, larger slices are split and sent concurrently.
 type ModifyMultipleOrder struct {
-	c *Client
-	r *core.Request
+	c           *Client
+	r           *core.Request
+	orders      []ModifyOrderReq
+	concurrency int
 }
 
 type ModifyMultipleOrderResponse struct {
 	ModifyOrderResponse
-	Code int    `json:"code"`
-	Msg  string `json:"msg"`
+	Code int             `json:"code"`
+	Msg  string          `json:"msg"`
+	Err  *BatchItemError `json:"-"`
 }
 
+// BatchOrders Any number of orders, results are aligned index-by-index with them.
 func (s *ModifyMultipleOrder) BatchOrders(batchOrders []ModifyOrderReq) *ModifyMultipleOrder {
-	orderJson, err := json.Marshal(batchOrders)
-	if err != nil {
-		return s
-	}
-	s.r.Set("batchOrders", string(orderJson))
+	s.orders = batchOrders
+	return s
+}
+
+// Concurrency Max number of batch requests in flight, default 4
+func (s *ModifyMultipleOrder) Concurrency(concurrency int) *ModifyMultipleOrder {
+	s.concurrency = concurrency
 	return s
 }
 
@@ -340,12 +388,45 @@ func (s *ModifyMultipleOrder) RecvWindow(recvWindow int64) *ModifyMultipleOrder
 	return s
 }
 
+// Do The returned slice has one entry per order; rejected orders and orders of a chunk that could not
+// be sent carry Err. An error is returned only when no chunk could be sent at all.
 func (s *ModifyMultipleOrder) Do(ctx context.Context) ([]*ModifyMultipleOrderResponse, error) {
-	if err := s.c.invoke(s.r, ctx); err != nil {
-		return nil, err
+	if len(s.orders) == 0 {
+		return nil, errors.New("batchOrders is empty")
 	}
-	resp := make([]*ModifyMultipleOrderResponse, 0)
-	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+	resp := make([]*ModifyMultipleOrderResponse, len(s.orders))
+	err := s.c.dispatchBatch(ctx, splitBatch(0, len(s.orders), maxBatchOrders), s.concurrency,
+		func(ctx context.Context, c *Client, chunk batchChunk) error {
+			orderJson, err := json.Marshal(s.orders[chunk.start:chunk.end])
+			if err != nil {
+				return err
+			}
+			r := s.r.Clone()
+			r.Set("batchOrders", string(orderJson))
+			if err := c.invoke(r, ctx); err != nil {
+				return err
+			}
+			items := make([]*ModifyMultipleOrderResponse, 0)
+			if err := json.Unmarshal(c.rawBody(), &items); err != nil {
+				return err
+			}
+			if len(items) != chunk.end-chunk.start {
+				return fmt.Errorf("expected %d batch results, got %d", chunk.end-chunk.start, len(items))
+			}
+			copy(resp[chunk.start:chunk.end], items)
+			return nil
+		},
+		func(chunk batchChunk, err error) {
+			for i := chunk.start; i < chunk.end; i++ {
+				resp[i] = &ModifyMultipleOrderResponse{Err: &BatchItemError{Index: i, Err: err}}
+			}
+		})
+	for i, item := range resp {
+		if item.Err == nil && item.Code != 0 {
+			item.Err = &BatchItemError{Index: i, Code: item.Code, Msg: item.Msg}
+		}
+	}
+	return resp, err
 }
 
 // OrderAmendment Get order modification history
@@ -446,48 +527,119 @@ func (s *CancelOrder) Do(ctx context.Context) (*OrderResponse, error) {
 }
 
 // CancelMultipleOrder Cancel Multiple Orders
+// Binance accepts at most 10 ids per request, use DoBatch to split larger lists.
 type CancelMultipleOrder struct {
-	c *Client
-	r *core.Request
+	c                     *Client
+	r                     *core.Request
+	orderIdList           []int64
+	origClientOrderIdList []string
+	concurrency           int
+}
+
+type CancelMultipleOrderResponse struct {
+	OrderResponse
+	Code int             `json:"code"`
+	Msg  string          `json:"msg"`
+	Err  *BatchItemError `json:"-"`
 }
 
 func (s *CancelMultipleOrder) Symbol(symbol string) *CancelMultipleOrder {
 	s.r.Set("symbol", symbol)
 	return s
 }
+
+// OrderIdList Order ids to cancel, DoBatch results are aligned index-by-index with them.
 func (s *CancelMultipleOrder) OrderIdList(orderIdList []int64) *CancelMultipleOrder {
-	orderList := "["
-	for _, orderId := range orderIdList {
-		orderList += strconv.FormatInt(orderId, 10) + ","
-	}
-	orderList = strings.TrimRight(orderList, ",")
-	orderList += "]"
-	s.r.Set("orderIdList", orderIdList)
+	s.orderIdList = orderIdList
 	return s
 }
+
+// OrigClientOrderIdList Client order ids to cancel, their DoBatch results follow those of OrderIdList.
 func (s *CancelMultipleOrder) OrigClientOrderIdList(origClientOrderIdList []string) *CancelMultipleOrder {
-	clientOrderIdList := "["
-	for _, clientOrderId := range origClientOrderIdList {
-		clientOrderIdList += fmt.Sprintf(`"%s",`, clientOrderId)
-	}
-	clientOrderIdList = strings.TrimRight(clientOrderIdList, ",")
-	clientOrderIdList += "]"
-	s.r.Set("origClientOrderIdList", origClientOrderIdList)
+	s.origClientOrderIdList = origClientOrderIdList
+	return s
+}
+
+// Concurrency Max number of DoBatch requests in flight, default 4
+func (s *CancelMultipleOrder) Concurrency(concurrency int) *CancelMultipleOrder {
+	s.concurrency = concurrency
 	return s
 }
+
 func (s *CancelMultipleOrder) RecvWindow(recvWindow int64) *CancelMultipleOrder {
 	s.r.Set("recvWindow", recvWindow)
 	return s
 }
 
+// Do Sends every id in a single request, Binance rejects it when either list holds more than 10 ids.
 func (s *CancelMultipleOrder) Do(ctx context.Context) ([]*OrderResponse, error) {
-	if err := s.c.invoke(s.r, ctx); err != nil {
+	r := s.r.Clone()
+	if len(s.orderIdList) > 0 {
+		r.Set("orderIdList", formatOrderIdList(s.orderIdList))
+	}
+	if len(s.origClientOrderIdList) > 0 {
+		r.Set("origClientOrderIdList", s.origClientOrderIdList)
+	}
+	if err := s.c.invoke(r, ctx); err != nil {
 		return nil, err
 	}
 	resp := make([]*OrderResponse, 0)
 	return resp, json.Unmarshal(s.c.rawBody(), &resp)
 }
 
+// DoBatch Splits the ids into requests of at most 10 and sends them concurrently.
+// The returned slice has one entry per id, order ids first; rejected ids and ids of a chunk that
+// could not be sent carry Err. An error is returned only when no chunk could be sent at all.
+func (s *CancelMultipleOrder) DoBatch(ctx context.Context) ([]*CancelMultipleOrderResponse, error) {
+	ids := len(s.orderIdList)
+	total := ids + len(s.origClientOrderIdList)
+	if total == 0 {
+		return nil, errors.New("orderIdList or origClientOrderIdList is required")
+	}
+	resp := make([]*CancelMultipleOrderResponse, total)
+	chunks := append(splitBatch(0, ids, maxBatchCancel), splitBatch(ids, len(s.origClientOrderIdList), maxBatchCancel)...)
+	err := s.c.dispatchBatch(ctx, chunks, s.concurrency,
+		func(ctx context.Context, c *Client, chunk batchChunk) error {
+			r := s.r.Clone()
+			if chunk.start < ids {
+				r.Set("orderIdList", formatOrderIdList(s.orderIdList[chunk.start:chunk.end]))
+			} else {
+				r.Set("origClientOrderIdList", s.origClientOrderIdList[chunk.start-ids:chunk.end-ids])
+			}
+			if err := c.invoke(r, ctx); err != nil {
+				return err
+			}
+			items := make([]*CancelMultipleOrderResponse, 0)
+			if err := json.Unmarshal(c.rawBody(), &items); err != nil {
+				return err
+			}
+			if len(items) != chunk.end-chunk.start {
+				return fmt.Errorf("expected %d batch results, got %d", chunk.end-chunk.start, len(items))
+			}
+			copy(resp[chunk.start:chunk.end], items)
+			return nil
+		},
+		func(chunk batchChunk, err error) {
+			for i := chunk.start; i < chunk.end; i++ {
+				resp[i] = &CancelMultipleOrderResponse{Err: &BatchItemError{Index: i, Err: err}}
+			}
+		})
+	for i, item := range resp {
+		if item.Err == nil && item.Code != 0 {
+			item.Err = &BatchItemError{Index: i, Code: item.Code, Msg: item.Msg}
+		}
+	}
+	return resp, err
+}
+
+func formatOrderIdList(orderIdList []int64) string {
+	orderList := make([]string, 0, len(orderIdList))
+	for _, orderId := range orderIdList {
+		orderList = append(orderList, strconv.FormatInt(orderId, 10))
+	}
+	return "[" + strings.Join(orderList, ",") + "]"
+}
+
 // CancelOpenOrder Cancel All Open Orders
 type CancelOpenOrder struct {
 	c *Client
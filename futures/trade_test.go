@@ -253,6 +253,12 @@ func (s *apiTradeTestSuite) TestNewModifyMultipleOrder() {
 		Side:     "BUY",
 		Price:    "30005",
 		Quantity: "10",
+	}, {
+		Symbol:   "BTCUSDT",
+		OrderId:  20072994038,
+		Side:     "BUY",
+		Price:    "30005",
+		Quantity: "10",
 	}}
 
 	resp, err := s.client.NewModifyMultipleOrder().BatchOrders(orders).Do(context.Background())
@@ -260,11 +266,14 @@ func (s *apiTradeTestSuite) TestNewModifyMultipleOrder() {
 	r.Empty(err)
 	var testResp []*ModifyMultipleOrderResponse
 	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Len(resp, len(orders))
 	for i := range resp {
 		s.assertModifyOrderResponse(&resp[i].ModifyOrderResponse, &testResp[i].ModifyOrderResponse)
 		r.Equal(testResp[i].Code, resp[i].Code, "code")
 		r.Equal(testResp[i].Msg, resp[i].Msg, "msg")
 	}
+	r.Nil(resp[0].Err)
+	r.Equal(&BatchItemError{Index: 1, Code: -2022, Msg: "ReduceOnly Order is rejected."}, resp[1].Err)
 }
 
 func (s *apiTradeTestSuite) TestNewOrderAmendment() {
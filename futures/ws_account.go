@@ -36,27 +36,12 @@ func (s *WsAccountBalance) RecvWindow(recvWindow int) *WsAccountBalance {
 }
 
 func (s *WsAccountBalance) Do(ctx context.Context) (*WsAccountBalanceResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsAccountBalanceResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsAccountBalanceResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsAccountInfo Get current account information. User in single-asset/ multi-assets mode will see different value, see comments in response section for detail.
@@ -92,25 +77,77 @@ func (s *WsAccountInfo) RecvWindow(recvWindow int) *WsAccountInfo {
 }
 
 func (s *WsAccountInfo) Do(ctx context.Context) (*WsAccountInfoResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsAccountInfoResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
+	var resp *WsAccountInfoResponse
+	return resp, json.Unmarshal(message, &resp)
+}
+
+// WsAccountInfoV1 Get current account information (v1 account.status), including the fee tier, trade permissions and leverage of each position.
+type WsAccountInfoV1 struct {
+	c *WsClient
+	r *core.WsRequest
+}
+
+type AccountPositionV1 struct {
+	Symbol                 string          `json:"symbol"`
+	InitialMargin          decimal.Decimal `json:"initialMargin"`
+	MaintMargin            decimal.Decimal `json:"maintMargin"`
+	UnrealizedProfit       decimal.Decimal `json:"unrealizedProfit"`
+	PositionInitialMargin  decimal.Decimal `json:"positionInitialMargin"`
+	OpenOrderInitialMargin decimal.Decimal `json:"openOrderInitialMargin"`
+	Leverage               decimal.Decimal `json:"leverage"`
+	Isolated               bool            `json:"isolated"`
+	EntryPrice             decimal.Decimal `json:"entryPrice"`
+	BreakEvenPrice         decimal.Decimal `json:"breakEvenPrice"`
+	MaxNotional            decimal.Decimal `json:"maxNotional"`
+	BidNotional            decimal.Decimal `json:"bidNotional"`
+	AskNotional            decimal.Decimal `json:"askNotional"`
+	PositionSide           string          `json:"positionSide"`
+	PositionAmt            decimal.Decimal `json:"positionAmt"`
+	UpdateTime             int64           `json:"updateTime"`
+}
+
+type AccountInfoV1Result struct {
+	FeeTier                     int                  `json:"feeTier"`
+	CanTrade                    bool                 `json:"canTrade"`
+	CanDeposit                  bool                 `json:"canDeposit"`
+	CanWithdraw                 bool                 `json:"canWithdraw"`
+	UpdateTime                  int64                `json:"updateTime"`
+	MultiAssetsMargin           bool                 `json:"multiAssetsMargin"`
+	TradeGroupId                int64                `json:"tradeGroupId"`
+	TotalInitialMargin          decimal.Decimal      `json:"totalInitialMargin"`
+	TotalMaintMargin            decimal.Decimal      `json:"totalMaintMargin"`
+	TotalWalletBalance          decimal.Decimal      `json:"totalWalletBalance"`
+	TotalUnrealizedProfit       decimal.Decimal      `json:"totalUnrealizedProfit"`
+	TotalMarginBalance          decimal.Decimal      `json:"totalMarginBalance"`
+	TotalPositionInitialMargin  decimal.Decimal      `json:"totalPositionInitialMargin"`
+	TotalOpenOrderInitialMargin decimal.Decimal      `json:"totalOpenOrderInitialMargin"`
+	TotalCrossWalletBalance     decimal.Decimal      `json:"totalCrossWalletBalance"`
+	TotalCrossUnPnl             decimal.Decimal      `json:"totalCrossUnPnl"`
+	AvailableBalance            decimal.Decimal      `json:"availableBalance"`
+	MaxWithdrawAmount           decimal.Decimal      `json:"maxWithdrawAmount"`
+	Assets                      []*AccountAsset      `json:"assets"`
+	Positions                   []*AccountPositionV1 `json:"positions"`
+}
+
+type WsAccountInfoV1Response struct {
+	ApiResponse
+	Result *AccountInfoV1Result `json:"result"`
+}
+
+func (s *WsAccountInfoV1) RecvWindow(recvWindow int) *WsAccountInfoV1 {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *WsAccountInfoV1) Do(ctx context.Context) (*WsAccountInfoV1Response, error) {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
+		return nil, err
 	}
+	var resp *WsAccountInfoV1Response
+	return resp, json.Unmarshal(message, &resp)
 }
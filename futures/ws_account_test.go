@@ -214,3 +214,108 @@ func (s *accountWsTestSuite) assertAccountPosition(r1, r2 *AccountPosition) {
 	r.Equal(r1.MaintMargin, r2.MaintMargin, "MaintMargin")
 	r.Equal(r1.UpdateTime, r2.UpdateTime, "UpdateTime")
 }
+
+func (s *accountWsTestSuite) TestNewAccountBalanceV1() {
+	msg := []byte(`{
+	  "id": "9328e612-1560-4108-979e-283bf85b5acb",
+	  "status": 200,
+	  "result": [
+		{
+		  "accountAlias": "SgsR",
+		  "asset": "USDT",
+		  "balance": "122607.35137903",
+		  "crossWalletBalance": "23.72469206",
+		  "crossUnPnl": "0.00000000",
+		  "availableBalance": "23.72469206",
+		  "maxWithdrawAmount": "23.72469206",
+		  "marginAvailable": true,
+		  "updateTime": 1617939110373
+		}
+	  ]
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewAccountBalanceV1().Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *WsAccountBalanceResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	s.assertTestAccountBalanceResponse(resp, testResp)
+}
+
+func (s *accountWsTestSuite) TestNewAccountInfoV1() {
+	msg := []byte(`{
+	  "id": "605a6d20-6588-4cb9-afa0-b0ab087507ba",
+	  "status": 200,
+	  "result": {
+		"feeTier": 0,
+		"canTrade": true,
+		"canDeposit": true,
+		"canWithdraw": true,
+		"updateTime": 0,
+		"multiAssetsMargin": true,
+		"tradeGroupId": -1,
+		"totalInitialMargin": "0.00000000",
+		"totalMaintMargin": "0.00000000",
+		"totalWalletBalance": "103.12345678",
+		"totalUnrealizedProfit": "0.00000000",
+		"totalMarginBalance": "103.12345678",
+		"totalPositionInitialMargin": "0.00000000",
+		"totalOpenOrderInitialMargin": "0.00000000",
+		"totalCrossWalletBalance": "103.12345678",
+		"totalCrossUnPnl": "0.00000000",
+		"availableBalance": "103.12345678",
+		"maxWithdrawAmount": "103.12345678",
+		"assets": [
+		  {
+			"asset": "USDT",
+			"walletBalance": "23.72469206",
+			"unrealizedProfit": "0.00000000",
+			"marginBalance": "23.72469206",
+			"maintMargin": "0.00000000",
+			"initialMargin": "0.00000000",
+			"positionInitialMargin": "0.00000000",
+			"openOrderInitialMargin": "0.00000000",
+			"crossWalletBalance": "23.72469206",
+			"crossUnPnl": "0.00000000",
+			"availableBalance": "23.72469206",
+			"maxWithdrawAmount": "23.72469206",
+			"marginAvailable": true,
+			"updateTime": 1625474304765
+		  }
+		],
+		"positions": [
+		  {
+			"symbol": "BTCUSDT",
+			"initialMargin": "0",
+			"maintMargin": "0",
+			"unrealizedProfit": "0.00000000",
+			"positionInitialMargin": "0",
+			"openOrderInitialMargin": "0",
+			"leverage": "100",
+			"isolated": true,
+			"entryPrice": "0.00000",
+			"breakEvenPrice": "0.0",
+			"maxNotional": "250000",
+			"bidNotional": "0",
+			"askNotional": "0",
+			"positionSide": "BOTH",
+			"positionAmt": "0",
+			"updateTime": 0
+		  }
+		]
+	  }
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewAccountInfoV1().Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *WsAccountInfoV1Response
+	r.Empty(json.Unmarshal(msg, &testResp))
+	s.assertWsResponse(resp.ApiResponse, testResp.ApiResponse)
+	r.True(resp.Result.CanTrade)
+	r.Equal(testResp.Result.TotalWalletBalance, resp.Result.TotalWalletBalance)
+	r.Equal(*testResp.Result.Assets[0], *resp.Result.Assets[0])
+	r.Equal(*testResp.Result.Positions[0], *resp.Result.Positions[0])
+}
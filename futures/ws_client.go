@@ -27,31 +27,8 @@ type ApiResponse struct {
 	Error      *ApiError       `json:"error,omitempty"`
 }
 
-func (c *WsClient) wsServe(ctx context.Context) (<-chan []byte, <-chan error) {
-	return c.WsServe(ctx)
-}
-
-func (c *WsClient) combined(combine bool) {
-	c.Combined(combine)
-}
-
-func (c *WsClient) getEndpoint() string {
-	return c.Opt.Endpoint
-}
-
-func (c *WsClient) setEndpoint(endpoint string) {
-	c.Opt.Endpoint = endpoint
-}
-
-func (c *WsClient) close() error {
-	return c.Close()
-}
-func (c *WsClient) send(r *core.WsRequest) error {
-	return c.Send(r)
-}
-
-func (c *WsClient) wsApiServe(ctx context.Context) (<-chan []byte, <-chan error) {
-	return c.WsApiServe(ctx)
+func (c *WsClient) call(ctx context.Context, r *core.WsRequest) ([]byte, error) {
+	return c.Call(ctx, r)
 }
 
 func (c *WsClient) NewWebsocketStreams() *WebsocketStreams {
@@ -108,6 +85,21 @@ func (c *WsClient) NewAccountInfo() *WsAccountInfo {
 	return &WsAccountInfo{c: c, r: c.SetReq("v2/account.status", core.AuthSigned)}
 }
 
+// NewPositionInfoV1 Position Information (USER_DATA)
+func (c *WsClient) NewPositionInfoV1() *WsPositionInfoV1 {
+	return &WsPositionInfoV1{c: c, r: c.SetReq("account.position", core.AuthSigned)}
+}
+
+// NewAccountBalanceV1 Futures Account Balance (USER_DATA)
+func (c *WsClient) NewAccountBalanceV1() *WsAccountBalance {
+	return &WsAccountBalance{c: c, r: c.SetReq("account.balance", core.AuthSigned)}
+}
+
+// NewAccountInfoV1 Account Information (USER_DATA)
+func (c *WsClient) NewAccountInfoV1() *WsAccountInfoV1 {
+	return &WsAccountInfoV1{c: c, r: c.SetReq("account.status", core.AuthSigned)}
+}
+
 // NewSessionLogon Log in with API key (SIGNED)
 func (c *WsClient) NewSessionLogon() *SessionLogon {
 	return &SessionLogon{c: c, r: c.SetReq("session.logon", core.AuthSigned)}
@@ -122,3 +114,18 @@ func (c *WsClient) NewSessionStatus() *SessionStatus {
 func (c *WsClient) NewSessionLogout() *SessionLogout {
 	return &SessionLogout{c: c, r: c.SetReq("session.logout", core.AuthSigned)}
 }
+
+// NewStartUserDataStream Start User Data Stream (USER_STREAM)
+func (c *WsClient) NewStartUserDataStream() *WsStartUserDataStream {
+	return &WsStartUserDataStream{c: c, r: c.SetReq("userDataStream.start", core.AuthApiKey)}
+}
+
+// NewPingUserDataStream Keepalive User Data Stream (USER_STREAM)
+func (c *WsClient) NewPingUserDataStream() *WsPingUserDataStream {
+	return &WsPingUserDataStream{c: c, r: c.SetReq("userDataStream.ping", core.AuthApiKey)}
+}
+
+// NewStopUserDataStream Close User Data Stream (USER_STREAM)
+func (c *WsClient) NewStopUserDataStream() *WsStopUserDataStream {
+	return &WsStopUserDataStream{c: c, r: c.SetReq("userDataStream.stop", core.AuthApiKey)}
+}
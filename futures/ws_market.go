@@ -38,27 +38,12 @@ func (s *WsDepth) Limit(limit int) *WsDepth {
 }
 
 func (s *WsDepth) Do(ctx context.Context) (*WsDepthResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsDepthResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsDepthResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsTickerPrice Latest price for a symbol or symbols.
@@ -84,40 +69,25 @@ func (s *WsTickerPrice) Symbol(symbol string) *WsTickerPrice {
 }
 
 func (s *WsTickerPrice) Do(ctx context.Context) (*WsTickerPriceResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			resp := new(WsTickerPriceResponse)
-			if s.r.Get("symbol") == nil {
-				return resp, json.Unmarshal(message, &resp)
-			}
-			var apiResp ApiResponse
-			if err := json.Unmarshal(message, &apiResp); err != nil {
-				return nil, err
-			}
-			resp.ApiResponse = apiResp
-			var single *TickerPriceResult
-			if err := json.Unmarshal(message, &single); err != nil {
-				return nil, err
-			}
-			resp.Result = append(resp.Result, single)
-			return resp, nil
-		case err := <-onError:
-			return nil, err
-		}
+	resp := new(WsTickerPriceResponse)
+	if s.r.Get("symbol") == nil {
+		return resp, json.Unmarshal(message, &resp)
+	}
+	var apiResp ApiResponse
+	if err := json.Unmarshal(message, &apiResp); err != nil {
+		return nil, err
+	}
+	resp.ApiResponse = apiResp
+	var single *TickerPriceResult
+	if err := json.Unmarshal(message, &single); err != nil {
+		return nil, err
 	}
+	resp.Result = append(resp.Result, single)
+	return resp, nil
 }
 
 // WsTickerBook Best price/qty on the order book for a symbol or symbols.
@@ -147,39 +117,23 @@ func (s *WsTickerBook) Symbol(symbol string) *WsTickerBook {
 }
 
 func (s *WsTickerBook) Do(ctx context.Context) (*WsTickerBookResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			resp := new(WsTickerBookResponse)
-			if s.r.Get("symbol") == nil {
-				return resp, json.Unmarshal(message, &resp)
-			}
-			var apiResp ApiResponse
-			if err := json.Unmarshal(message, &apiResp); err != nil {
-				return nil, err
-			}
-			resp.ApiResponse = apiResp
-			var single *TickerBookResult
-			if err := json.Unmarshal(message, &single); err != nil {
-				return nil, err
-			}
-			resp.Result = append(resp.Result, single)
-			return resp, nil
-
-		case err := <-onError:
-			return nil, err
-		}
+	resp := new(WsTickerBookResponse)
+	if s.r.Get("symbol") == nil {
+		return resp, json.Unmarshal(message, &resp)
+	}
+	var apiResp ApiResponse
+	if err := json.Unmarshal(message, &apiResp); err != nil {
+		return nil, err
+	}
+	resp.ApiResponse = apiResp
+	var single *TickerBookResult
+	if err := json.Unmarshal(message, &single); err != nil {
+		return nil, err
 	}
+	resp.Result = append(resp.Result, single)
+	return resp, nil
 }
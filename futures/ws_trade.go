@@ -34,6 +34,8 @@ type OrderResult struct {
 	WorkingType             string          `json:"workingType"`
 	PriceProtect            bool            `json:"priceProtect"`
 	OrigType                string          `json:"origType"`
+	ActivatePrice           decimal.Decimal `json:"activatePrice"`
+	PriceRate               decimal.Decimal `json:"priceRate"`
 	PriceMatch              string          `json:"priceMatch"`
 	SelfTradePreventionMode string          `json:"selfTradePreventionMode"`
 	GoodTillDate            int             `json:"goodTillDate"`
@@ -72,6 +74,8 @@ func (s *WsCreateOrder) Quantity(quantity float64) *WsCreateOrder {
 	s.r.Set("quantity", quantity)
 	return s
 }
+
+// ReduceOnly "true" or "false". default "false". Cannot be sent in Hedge Mode; cannot be sent with closePosition=true
 func (s *WsCreateOrder) ReduceOnly(reduceOnly string) *WsCreateOrder {
 	s.r.Set("reduceOnly", reduceOnly)
 	return s
@@ -81,30 +85,44 @@ func (s *WsCreateOrder) Price(price float64) *WsCreateOrder {
 	s.r.Set("price", price)
 	return s
 }
+
+// NewClientOrderId A unique id among open orders. Automatically generated if not sent.
 func (s *WsCreateOrder) NewClientOrderId(newClientOrderId string) *WsCreateOrder {
 	s.r.Set("newClientOrderId", newClientOrderId)
 	return s
 }
+
+// StopPrice Used with STOP/STOP_MARKET or TAKE_PROFIT/TAKE_PROFIT_MARKET orders.
 func (s *WsCreateOrder) StopPrice(stopPrice float64) *WsCreateOrder {
 	s.r.Set("stopPrice", stopPrice)
 	return s
 }
+
+// ClosePosition true, false；Close-All，used with STOP_MARKET or TAKE_PROFIT_MARKET.
 func (s *WsCreateOrder) ClosePosition(closePosition string) *WsCreateOrder {
 	s.r.Set("closePosition", closePosition)
 	return s
 }
+
+// ActivationPrice Used with TRAILING_STOP_MARKET orders, default as the latest price(supporting different workingType)
 func (s *WsCreateOrder) ActivationPrice(activationPrice float64) *WsCreateOrder {
 	s.r.Set("activationPrice", activationPrice)
 	return s
 }
+
+// CallbackRate Used with TRAILING_STOP_MARKET orders, min 0.1, max 10 where 1 for 1%
 func (s *WsCreateOrder) CallbackRate(callbackRate float64) *WsCreateOrder {
 	s.r.Set("callbackRate", callbackRate)
 	return s
 }
+
+// WorkingType stopPrice triggered by: "MARK_PRICE", "CONTRACT_PRICE". Default "CONTRACT_PRICE"
 func (s *WsCreateOrder) WorkingType(workingType core.WorkingType) *WsCreateOrder {
 	s.r.Set("workingType", workingType)
 	return s
 }
+
+// PriceProtect "TRUE" or "FALSE", default "FALSE". Used with STOP/STOP_MARKET or TAKE_PROFIT/TAKE_PROFIT_MARKET orders.
 func (s *WsCreateOrder) PriceProtect(priceProtect string) *WsCreateOrder {
 	s.r.Set("priceProtect", priceProtect)
 	return s
@@ -122,6 +140,8 @@ func (s *WsCreateOrder) SelfTradePreventionMode(selfTradePreventionMode core.STP
 	s.r.Set("selfTradePreventionMode", selfTradePreventionMode)
 	return s
 }
+
+// GoodTillDate Order cancel time for timeInForce GTD, mandatory when timeInForce set to GTD
 func (s *WsCreateOrder) GoodTillDate(goodTillDate int64) *WsCreateOrder {
 	s.r.Set("goodTillDate", goodTillDate)
 	return s
@@ -132,27 +152,12 @@ func (s *WsCreateOrder) RecvWindow(recvWindow int64) *WsCreateOrder {
 }
 
 func (s *WsCreateOrder) Do(ctx context.Context) (*WsOrderResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsOrderResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsOrderResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsModifyOrder Order modify function, currently only LIMIT order modification is supported, modified orders will be reordered in the match queue
@@ -204,27 +209,12 @@ func (s *WsModifyOrder) RecvWindow(recvWindow int64) *WsModifyOrder {
 }
 
 func (s *WsModifyOrder) Do(ctx context.Context) (*WsOrderResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsOrderResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsOrderResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsCancelOrder Cancel an active order.
@@ -251,27 +241,12 @@ func (s *WsCancelOrder) RecvWindow(recvWindow int64) *WsCancelOrder {
 }
 
 func (s *WsCancelOrder) Do(ctx context.Context) (*WsOrderResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsOrderResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsOrderResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsQueryOrder Check an order's status.
@@ -297,27 +272,12 @@ func (s *WsQueryOrder) RecvWindow(recvWindow int64) *WsQueryOrder {
 	return s
 }
 func (s *WsQueryOrder) Do(ctx context.Context) (*WsOrderResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsOrderResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsOrderResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsPositionInfo Get current position information(only symbol that has position or open orders will be returned).
@@ -363,25 +323,58 @@ func (s *WsPositionInfo) RecvWindow(recvWindow int64) *WsPositionInfo {
 	return s
 }
 func (s *WsPositionInfo) Do(ctx context.Context) (*PositionInfoResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *PositionInfoResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
+	var resp *PositionInfoResponse
+	return resp, json.Unmarshal(message, &resp)
+}
+
+// WsPositionInfoV1 Get current position information (v1 account.position), including leverage, margin type and max notional of each position.
+type WsPositionInfoV1 struct {
+	c *WsClient
+	r *core.WsRequest
+}
+
+type PositionInfoV1Result struct {
+	Symbol           string          `json:"symbol"`
+	PositionSide     string          `json:"positionSide"`
+	PositionAmt      decimal.Decimal `json:"positionAmt"`
+	EntryPrice       decimal.Decimal `json:"entryPrice"`
+	BreakEvenPrice   decimal.Decimal `json:"breakEvenPrice"`
+	MarkPrice        decimal.Decimal `json:"markPrice"`
+	UnRealizedProfit decimal.Decimal `json:"unRealizedProfit"`
+	LiquidationPrice decimal.Decimal `json:"liquidationPrice"`
+	IsolatedMargin   decimal.Decimal `json:"isolatedMargin"`
+	Notional         decimal.Decimal `json:"notional"`
+	MarginAsset      string          `json:"marginAsset"`
+	IsolatedWallet   decimal.Decimal `json:"isolatedWallet"`
+	Leverage         decimal.Decimal `json:"leverage"`
+	MaxNotionalValue decimal.Decimal `json:"maxNotionalValue"`
+	MarginType       string          `json:"marginType"`
+	IsAutoAddMargin  string          `json:"isAutoAddMargin"`
+	UpdateTime       int64           `json:"updateTime"`
+}
+
+type PositionInfoV1Response struct {
+	ApiResponse
+	Result []*PositionInfoV1Result `json:"result"`
+}
+
+func (s *WsPositionInfoV1) Symbol(symbol string) *WsPositionInfoV1 {
+	s.r.Set("symbol", symbol)
+	return s
+}
+func (s *WsPositionInfoV1) RecvWindow(recvWindow int64) *WsPositionInfoV1 {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+func (s *WsPositionInfoV1) Do(ctx context.Context) (*PositionInfoV1Response, error) {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
+		return nil, err
 	}
+	var resp *PositionInfoV1Response
+	return resp, json.Unmarshal(message, &resp)
 }
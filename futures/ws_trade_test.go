@@ -112,6 +112,8 @@ func (s *tradeTestSuite) assertCreateOrderResult(r1, r2 *OrderResult) {
 	r.Equal(r2.TimeInForce, r1.TimeInForce, "TimeInForce")
 	r.Equal(r2.Type, r1.Type, "Type")
 	r.Equal(r2.OrigType, r1.OrigType, "OrigType")
+	r.Equal(r2.ActivatePrice, r1.ActivatePrice, "ActivatePrice")
+	r.Equal(r2.PriceRate, r1.PriceRate, "PriceRate")
 	r.Equal(r2.UpdateTime, r1.UpdateTime, "UpdateTime")
 	r.Equal(r2.WorkingType, r1.WorkingType, "WorkingType")
 	r.Equal(r2.PriceProtect, r1.PriceProtect, "PriceProtect")
@@ -367,3 +369,87 @@ func (s *tradeTestSuite) assertPositionInfoResult(r1, r2 *PositionInfoResult) {
 	r.Equal(r1.AskNotional, r2.AskNotional, "AskNotional")
 	r.Equal(r1.UpdateTime, r2.UpdateTime, "UpdateTime")
 }
+
+func (s *tradeTestSuite) TestNewTrailingStopOrder() {
+	msg := []byte(`{
+	  "id": "60fa3b6c-2a1b-4c4b-9f0a-0a1c4c0b6a7d",
+	  "status": 200,
+	  "result": {
+		"orderId": 325078477,
+		"symbol": "BTCUSDT",
+		"status": "NEW",
+		"clientOrderId": "iCXL1BywlBaf2sesNUrVl3",
+		"price": "0.00",
+		"avgPrice": "0.00",
+		"origQty": "0.010",
+		"executedQty": "0.000",
+		"cumQty": "0.000",
+		"cumQuote": "0.00000",
+		"timeInForce": "GTC",
+		"type": "TRAILING_STOP_MARKET",
+		"reduceOnly": true,
+		"closePosition": false,
+		"side": "SELL",
+		"positionSide": "BOTH",
+		"stopPrice": "0.00",
+		"workingType": "MARK_PRICE",
+		"priceProtect": false,
+		"origType": "TRAILING_STOP_MARKET",
+		"activatePrice": "43250.00",
+		"priceRate": "0.5",
+		"priceMatch": "NONE",
+		"selfTradePreventionMode": "NONE",
+		"goodTillDate": 0,
+		"updateTime": 1702555534435
+	  }
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewCreateOrder().Symbol("BTCUSDT").Side(core.OrderSideSELL).
+		Type(core.OrderTypeTRAILING_STOP_MARKET).Quantity(0.01).ReduceOnly("true").
+		ActivationPrice(43250).CallbackRate(0.5).WorkingType(core.WorkingTypeMARK_PRICE).Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *WsOrderResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	s.assertTestOrderResponse(resp, testResp)
+	r.Equal("43250", resp.Result.ActivatePrice.String())
+	r.Equal("0.5", resp.Result.PriceRate.String())
+}
+
+func (s *tradeTestSuite) TestNewPositionInfoV1() {
+	msg := []byte(`{
+	  "id": "605a6d20-6588-4cb9-afa0-b0ab087507ba",
+	  "status": 200,
+	  "result": [
+		{
+		  "entryPrice": "0.00000",
+		  "breakEvenPrice": "0.0",
+		  "marginType": "isolated",
+		  "isAutoAddMargin": "false",
+		  "isolatedMargin": "0.00000000",
+		  "leverage": "10",
+		  "liquidationPrice": "0",
+		  "markPrice": "6679.50671178",
+		  "maxNotionalValue": "20000000",
+		  "positionAmt": "0.000",
+		  "notional": "0",
+		  "isolatedWallet": "0",
+		  "symbol": "BTCUSDT",
+		  "unRealizedProfit": "0.00000000",
+		  "positionSide": "BOTH",
+		  "updateTime": 0
+		}
+	  ]
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewPositionInfoV1().Symbol("BTCUSDT").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *PositionInfoV1Response
+	r.Empty(json.Unmarshal(msg, &testResp))
+	s.assertWsResponse(resp.ApiResponse, testResp.ApiResponse)
+	r.Equal(*testResp.Result[0], *resp.Result[0])
+	r.Equal("10", resp.Result[0].Leverage.String())
+}
@@ -3,13 +3,12 @@ package futures
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"github.com/jekaxv/go-binance/core"
 	"github.com/shopspring/decimal"
 )
 
 type UserDataStream struct {
-	*WebsocketStreams
+	*core.Stream[*UserDataEvent]
 }
 
 type UserDataEventType string
@@ -200,40 +199,10 @@ type AccountUpdate struct {
 }
 
 func (s *WebsocketStreams) SubscribeUserData(listenKey string) *UserDataStream {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s", s.c.getEndpoint(), listenKey))
-	return &UserDataStream{s}
-}
-
-func (e *UserDataStream) Do(ctx context.Context) (<-chan *UserDataEvent, <-chan error) {
-	messageCh := make(chan *UserDataEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				event, err := e.parseUserEvent(message)
-				if err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
-}
-
-func (e *UserDataStream) parseUserEvent(message []byte) (*UserDataEvent, error) {
+	return &UserDataStream{core.NewRawStream(s.c.WsClient, Streams.UserData(listenKey))}
+}
+
+func parseUserEvent(message []byte) (*UserDataEvent, error) {
 	var event *UserDataEvent
 	if err := json.Unmarshal(message, &event); err != nil {
 		return event, err
@@ -281,27 +250,12 @@ type SessionResponse struct {
 }
 
 func (s *SessionLogon) Do(ctx context.Context) (*SessionResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *SessionResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *SessionResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // SessionStatus Query the status of the WebSocket connection, inspecting which API key (if any) is used to authorize requests.
@@ -311,27 +265,12 @@ type SessionStatus struct {
 }
 
 func (s *SessionStatus) Do(ctx context.Context) (*SessionResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *SessionResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *SessionResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // SessionLogout Forget the API key previously authenticated.
@@ -342,25 +281,70 @@ type SessionLogout struct {
 }
 
 func (s *SessionLogout) Do(ctx context.Context) (*SessionResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
+		return nil, err
+	}
+	var resp *SessionResponse
+	return resp, json.Unmarshal(message, &resp)
+}
+
+// WsStartUserDataStream Start a new user data stream over the WebSocket API.
+// Events are delivered on the listenKey stream, subscribe to it with WebsocketStreams.SubscribeUserData to receive them as UserDataEvent.
+type WsStartUserDataStream struct {
+	c *WsClient
+	r *core.WsRequest
+}
+
+type ListenKeyResult struct {
+	ListenKey string `json:"listenKey"`
+}
+
+type WsListenKeyResponse struct {
+	ApiResponse
+	Result *ListenKeyResult `json:"result"`
+}
+
+func (s *WsStartUserDataStream) Do(ctx context.Context) (*WsListenKeyResponse, error) {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *SessionResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
+	var resp *WsListenKeyResponse
+	return resp, json.Unmarshal(message, &resp)
+}
+
+// WsPingUserDataStream Keepalive the user data stream to prevent a time out. It's recommended to send a ping about every 60 minutes.
+type WsPingUserDataStream struct {
+	c *WsClient
+	r *core.WsRequest
+}
+
+func (s *WsPingUserDataStream) Do(ctx context.Context) (*WsListenKeyResponse, error) {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
+		return nil, err
+	}
+	var resp *WsListenKeyResponse
+	return resp, json.Unmarshal(message, &resp)
+}
+
+// WsStopUserDataStream Close out the user data stream.
+type WsStopUserDataStream struct {
+	c *WsClient
+	r *core.WsRequest
+}
+
+type WsStopUserDataStreamResponse struct {
+	ApiResponse
+	Result struct{} `json:"result"`
+}
+
+func (s *WsStopUserDataStream) Do(ctx context.Context) (*WsStopUserDataStreamResponse, error) {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
+		return nil, err
 	}
+	var resp *WsStopUserDataStreamResponse
+	return resp, json.Unmarshal(message, &resp)
 }
@@ -0,0 +1,76 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type userDataWsTestSuite struct {
+	baseWsTestSuite
+}
+
+func TestWebsocketUserData(t *testing.T) {
+	suite.Run(t, new(userDataWsTestSuite))
+}
+
+func (s *userDataWsTestSuite) TestStartUserDataStream() {
+	msg := []byte(`{
+	  "id": "d3df8a61-98ea-4fe0-8f4e-0fcea5d418b0",
+	  "status": 200,
+	  "result": {
+		"listenKey": "xs0mRXdAKlIPDRFrlPcw0qI41Eh3ixNntmymGyhrhgqo7L6FuLaWArTD7RLP"
+	  },
+	  "rateLimits": [
+		{
+		  "rateLimitType": "REQUEST_WEIGHT",
+		  "interval": "MINUTE",
+		  "intervalNum": 1,
+		  "limit": 2400,
+		  "count": 2
+		}
+	  ]
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewStartUserDataStream().Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *WsListenKeyResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	s.assertWsResponse(resp.ApiResponse, testResp.ApiResponse)
+	r.Equal("xs0mRXdAKlIPDRFrlPcw0qI41Eh3ixNntmymGyhrhgqo7L6FuLaWArTD7RLP", resp.Result.ListenKey)
+}
+
+func (s *userDataWsTestSuite) TestPingUserDataStream() {
+	msg := []byte(`{
+	  "id": "815d5fce-0880-4287-a567-80badf004c74",
+	  "status": 200,
+	  "result": {
+		"listenKey": "3HBntNTepshgEdjIwSUIBgB9keLyOCg5qv3n6bYAtktG8ejcaW5HXz9Vx1JgIieg"
+	  }
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewPingUserDataStream().Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Equal(200, resp.Status)
+	r.Equal("3HBntNTepshgEdjIwSUIBgB9keLyOCg5qv3n6bYAtktG8ejcaW5HXz9Vx1JgIieg", resp.Result.ListenKey)
+}
+
+func (s *userDataWsTestSuite) TestStopUserDataStream() {
+	msg := []byte(`{
+	  "id": "819e1b1b-8c06-485b-a13e-131326c69599",
+	  "status": 200,
+	  "result": {}
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewStopUserDataStream().Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Equal(200, resp.Status)
+	r.Nil(resp.Error)
+}
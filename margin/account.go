@@ -0,0 +1,575 @@
+package margin
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/shopspring/decimal"
+	"strconv"
+	"strings"
+)
+
+type TranIdResponse struct {
+	TranId int64 `json:"tranId"`
+}
+
+type BorrowRepayRecordsResponse struct {
+	Rows  []*BorrowRepayRecord `json:"rows"`
+	Total int                  `json:"total"`
+}
+
+type BorrowRepayRecord struct {
+	IsolatedSymbol string          `json:"isolatedSymbol"`
+	Amount         decimal.Decimal `json:"amount"`
+	Asset          string          `json:"asset"`
+	Interest       decimal.Decimal `json:"interest"`
+	Principal      decimal.Decimal `json:"principal"`
+	Status         string          `json:"status"`
+	Timestamp      int64           `json:"timestamp"`
+	TxId           int64           `json:"txId"`
+}
+
+type MaxBorrowableResponse struct {
+	Amount      decimal.Decimal `json:"amount"`
+	BorrowLimit decimal.Decimal `json:"borrowLimit"`
+}
+
+type MaxTransferableResponse struct {
+	Amount decimal.Decimal `json:"amount"`
+}
+
+type InterestHistoryResponse struct {
+	Rows  []*InterestRecord `json:"rows"`
+	Total int               `json:"total"`
+}
+
+type InterestRecord struct {
+	TxId                int64           `json:"txId"`
+	InterestAccuredTime int64           `json:"interestAccuredTime"`
+	Asset               string          `json:"asset"`
+	RawAsset            string          `json:"rawAsset"`
+	Principal           decimal.Decimal `json:"principal"`
+	Interest            decimal.Decimal `json:"interest"`
+	InterestRate        decimal.Decimal `json:"interestRate"`
+	Type                string          `json:"type"`
+	IsolatedSymbol      string          `json:"isolatedSymbol"`
+}
+
+type ForceLiquidationResponse struct {
+	Rows  []*ForceLiquidation `json:"rows"`
+	Total int                 `json:"total"`
+}
+
+type ForceLiquidation struct {
+	AvgPrice    decimal.Decimal `json:"avgPrice"`
+	ExecutedQty decimal.Decimal `json:"executedQty"`
+	OrderId     int64           `json:"orderId"`
+	Price       decimal.Decimal `json:"price"`
+	Qty         decimal.Decimal `json:"qty"`
+	Side        string          `json:"side"`
+	Symbol      string          `json:"symbol"`
+	TimeInForce string          `json:"timeInForce"`
+	IsIsolated  bool            `json:"isIsolated"`
+	UpdatedTime int64           `json:"updatedTime"`
+}
+
+type AccountInfoResponse struct {
+	Created                    bool            `json:"created"`
+	BorrowEnabled              bool            `json:"borrowEnabled"`
+	MarginLevel                decimal.Decimal `json:"marginLevel"`
+	CollateralMarginLevel      decimal.Decimal `json:"collateralMarginLevel"`
+	TotalAssetOfBtc            decimal.Decimal `json:"totalAssetOfBtc"`
+	TotalLiabilityOfBtc        decimal.Decimal `json:"totalLiabilityOfBtc"`
+	TotalNetAssetOfBtc         decimal.Decimal `json:"totalNetAssetOfBtc"`
+	TotalCollateralValueInUSDT decimal.Decimal `json:"TotalCollateralValueInUSDT"`
+	TradeEnabled               bool            `json:"tradeEnabled"`
+	TransferInEnabled          bool            `json:"transferInEnabled"`
+	TransferOutEnabled         bool            `json:"transferOutEnabled"`
+	AccountType                string          `json:"accountType"`
+	UserAssets                 []*UserAsset    `json:"userAssets"`
+}
+
+type UserAsset struct {
+	Asset    string          `json:"asset"`
+	Borrowed decimal.Decimal `json:"borrowed"`
+	Free     decimal.Decimal `json:"free"`
+	Interest decimal.Decimal `json:"interest"`
+	Locked   decimal.Decimal `json:"locked"`
+	NetAsset decimal.Decimal `json:"netAsset"`
+}
+
+type IsolatedSwitchResponse struct {
+	Success bool   `json:"success"`
+	Symbol  string `json:"symbol"`
+}
+
+type IsolatedAccountLimitResponse struct {
+	EnabledAccount int `json:"enabledAccount"`
+	MaxAccount     int `json:"maxAccount"`
+}
+
+type IsolatedAccountResponse struct {
+	Assets              []*IsolatedAsset `json:"assets"`
+	TotalAssetOfBtc     decimal.Decimal  `json:"totalAssetOfBtc"`
+	TotalLiabilityOfBtc decimal.Decimal  `json:"totalLiabilityOfBtc"`
+	TotalNetAssetOfBtc  decimal.Decimal  `json:"totalNetAssetOfBtc"`
+}
+
+type IsolatedAsset struct {
+	BaseAsset         *IsolatedAssetDetail `json:"baseAsset"`
+	QuoteAsset        *IsolatedAssetDetail `json:"quoteAsset"`
+	Symbol            string               `json:"symbol"`
+	IsolatedCreated   bool                 `json:"isolatedCreated"`
+	Enabled           bool                 `json:"enabled"`
+	MarginLevel       decimal.Decimal      `json:"marginLevel"`
+	MarginLevelStatus string               `json:"marginLevelStatus"`
+	MarginRatio       decimal.Decimal      `json:"marginRatio"`
+	IndexPrice        decimal.Decimal      `json:"indexPrice"`
+	LiquidatePrice    decimal.Decimal      `json:"liquidatePrice"`
+	LiquidateRate     decimal.Decimal      `json:"liquidateRate"`
+	TradeEnabled      bool                 `json:"tradeEnabled"`
+}
+
+type IsolatedAssetDetail struct {
+	Asset         string          `json:"asset"`
+	BorrowEnabled bool            `json:"borrowEnabled"`
+	Borrowed      decimal.Decimal `json:"borrowed"`
+	Free          decimal.Decimal `json:"free"`
+	Interest      decimal.Decimal `json:"interest"`
+	Locked        decimal.Decimal `json:"locked"`
+	NetAsset      decimal.Decimal `json:"netAsset"`
+	NetAssetOfBtc decimal.Decimal `json:"netAssetOfBtc"`
+	RepayEnabled  bool            `json:"repayEnabled"`
+	TotalAsset    decimal.Decimal `json:"totalAsset"`
+}
+
+type IsolatedPair struct {
+	Symbol        string `json:"symbol"`
+	Base          string `json:"base"`
+	Quote         string `json:"quote"`
+	IsMarginTrade bool   `json:"isMarginTrade"`
+	IsBuyAllowed  bool   `json:"isBuyAllowed"`
+	IsSellAllowed bool   `json:"isSellAllowed"`
+}
+
+// BorrowRepay Margin account borrow/repay. If isIsolated is true, symbol must be sent.
+type BorrowRepay struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *BorrowRepay) Asset(asset string) *BorrowRepay {
+	s.r.Set("asset", asset)
+	return s
+}
+
+// IsIsolated For isolated margin or not, default false
+func (s *BorrowRepay) IsIsolated(isIsolated bool) *BorrowRepay {
+	s.r.Set("isIsolated", strings.ToUpper(strconv.FormatBool(isIsolated)))
+	return s
+}
+
+// Symbol Only for isolated margin
+func (s *BorrowRepay) Symbol(symbol string) *BorrowRepay {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *BorrowRepay) Amount(amount string) *BorrowRepay {
+	s.r.Set("amount", amount)
+	return s
+}
+
+// Type BORROW or REPAY
+func (s *BorrowRepay) Type(typ core.BorrowRepayTypeEnum) *BorrowRepay {
+	s.r.Set("type", typ)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *BorrowRepay) RecvWindow(recvWindow int64) *BorrowRepay {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *BorrowRepay) Do(ctx context.Context) (*TranIdResponse, error) {
+	resp := new(TranIdResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// BorrowRepayRecords Query borrow/repay records in margin account. txId or startTime must be sent, txId takes precedence.
+type BorrowRepayRecords struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *BorrowRepayRecords) Asset(asset string) *BorrowRepayRecords {
+	s.r.Set("asset", asset)
+	return s
+}
+
+// IsIsolated For isolated margin or not, default false
+func (s *BorrowRepayRecords) IsIsolated(isIsolated bool) *BorrowRepayRecords {
+	s.r.Set("isIsolated", strings.ToUpper(strconv.FormatBool(isIsolated)))
+	return s
+}
+
+// IsolatedSymbol Symbol in Isolated Margin
+func (s *BorrowRepayRecords) IsolatedSymbol(isolatedSymbol string) *BorrowRepayRecords {
+	s.r.Set("isolatedSymbol", isolatedSymbol)
+	return s
+}
+
+func (s *BorrowRepayRecords) TxId(txId int64) *BorrowRepayRecords {
+	s.r.Set("txId", txId)
+	return s
+}
+
+func (s *BorrowRepayRecords) StartTime(startTime int64) *BorrowRepayRecords {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *BorrowRepayRecords) EndTime(endTime int64) *BorrowRepayRecords {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Current Currently querying page. Start from 1. Default:1
+func (s *BorrowRepayRecords) Current(current int64) *BorrowRepayRecords {
+	s.r.Set("current", current)
+	return s
+}
+
+// Size Default:10 Max:100
+func (s *BorrowRepayRecords) Size(size int64) *BorrowRepayRecords {
+	s.r.Set("size", size)
+	return s
+}
+
+// Type BORROW or REPAY
+func (s *BorrowRepayRecords) Type(typ core.BorrowRepayTypeEnum) *BorrowRepayRecords {
+	s.r.Set("type", typ)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *BorrowRepayRecords) RecvWindow(recvWindow int64) *BorrowRepayRecords {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *BorrowRepayRecords) Do(ctx context.Context) (*BorrowRepayRecordsResponse, error) {
+	resp := new(BorrowRepayRecordsResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// MaxBorrowable Query max borrow amount for an asset. If isolatedSymbol is not sent, crossed margin data will be sent.
+type MaxBorrowable struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *MaxBorrowable) Asset(asset string) *MaxBorrowable {
+	s.r.Set("asset", asset)
+	return s
+}
+
+// IsolatedSymbol Symbol in Isolated Margin
+func (s *MaxBorrowable) IsolatedSymbol(isolatedSymbol string) *MaxBorrowable {
+	s.r.Set("isolatedSymbol", isolatedSymbol)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *MaxBorrowable) RecvWindow(recvWindow int64) *MaxBorrowable {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *MaxBorrowable) Do(ctx context.Context) (*MaxBorrowableResponse, error) {
+	resp := new(MaxBorrowableResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// MaxTransferable Query max transfer-out amount. If isolatedSymbol is not sent, crossed margin data will be sent.
+type MaxTransferable struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *MaxTransferable) Asset(asset string) *MaxTransferable {
+	s.r.Set("asset", asset)
+	return s
+}
+
+// IsolatedSymbol Symbol in Isolated Margin
+func (s *MaxTransferable) IsolatedSymbol(isolatedSymbol string) *MaxTransferable {
+	s.r.Set("isolatedSymbol", isolatedSymbol)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *MaxTransferable) RecvWindow(recvWindow int64) *MaxTransferable {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *MaxTransferable) Do(ctx context.Context) (*MaxTransferableResponse, error) {
+	resp := new(MaxTransferableResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// InterestHistory Get interest history. Response in descending order; the max interval between startTime and endTime is 30 days.
+type InterestHistory struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *InterestHistory) Asset(asset string) *InterestHistory {
+	s.r.Set("asset", asset)
+	return s
+}
+
+// IsolatedSymbol Symbol in Isolated Margin
+func (s *InterestHistory) IsolatedSymbol(isolatedSymbol string) *InterestHistory {
+	s.r.Set("isolatedSymbol", isolatedSymbol)
+	return s
+}
+
+func (s *InterestHistory) StartTime(startTime int64) *InterestHistory {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *InterestHistory) EndTime(endTime int64) *InterestHistory {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Current Currently querying page. Start from 1. Default:1
+func (s *InterestHistory) Current(current int64) *InterestHistory {
+	s.r.Set("current", current)
+	return s
+}
+
+// Size Default:10 Max:100
+func (s *InterestHistory) Size(size int64) *InterestHistory {
+	s.r.Set("size", size)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *InterestHistory) RecvWindow(recvWindow int64) *InterestHistory {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *InterestHistory) Do(ctx context.Context) (*InterestHistoryResponse, error) {
+	resp := new(InterestHistoryResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// ForceLiquidationRec Get force liquidation record. Response in descending order.
+type ForceLiquidationRec struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *ForceLiquidationRec) StartTime(startTime int64) *ForceLiquidationRec {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *ForceLiquidationRec) EndTime(endTime int64) *ForceLiquidationRec {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// IsolatedSymbol Symbol in Isolated Margin
+func (s *ForceLiquidationRec) IsolatedSymbol(isolatedSymbol string) *ForceLiquidationRec {
+	s.r.Set("isolatedSymbol", isolatedSymbol)
+	return s
+}
+
+// Current Currently querying page. Start from 1. Default:1
+func (s *ForceLiquidationRec) Current(current int64) *ForceLiquidationRec {
+	s.r.Set("current", current)
+	return s
+}
+
+// Size Default:10 Max:100
+func (s *ForceLiquidationRec) Size(size int64) *ForceLiquidationRec {
+	s.r.Set("size", size)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *ForceLiquidationRec) RecvWindow(recvWindow int64) *ForceLiquidationRec {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *ForceLiquidationRec) Do(ctx context.Context) (*ForceLiquidationResponse, error) {
+	resp := new(ForceLiquidationResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// AccountInfo Query cross margin account details.
+type AccountInfo struct {
+	c *Client
+	r *core.Request
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *AccountInfo) RecvWindow(recvWindow int64) *AccountInfo {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *AccountInfo) Do(ctx context.Context) (*AccountInfoResponse, error) {
+	resp := new(AccountInfoResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// IsolatedAccount Query isolated margin account info. If symbols is not sent, all isolated assets will be returned. Max 5 symbols can be sent.
+type IsolatedAccount struct {
+	c *Client
+	r *core.Request
+}
+
+// Symbols Max 5 symbols can be sent
+func (s *IsolatedAccount) Symbols(symbols []string) *IsolatedAccount {
+	s.r.Set("symbols", symbols)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *IsolatedAccount) RecvWindow(recvWindow int64) *IsolatedAccount {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *IsolatedAccount) Do(ctx context.Context) (*IsolatedAccountResponse, error) {
+	resp := new(IsolatedAccountResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// EnableIsolated Enable isolated margin account for a specific symbol. Only 10 accounts can be enabled at most.
+type EnableIsolated struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *EnableIsolated) Symbol(symbol string) *EnableIsolated {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *EnableIsolated) RecvWindow(recvWindow int64) *EnableIsolated {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *EnableIsolated) Do(ctx context.Context) (*IsolatedSwitchResponse, error) {
+	resp := new(IsolatedSwitchResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// DisableIsolated Disable isolated margin account for a specific symbol. Each trading pair can only be deactivated once every 24 hours.
+type DisableIsolated struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *DisableIsolated) Symbol(symbol string) *DisableIsolated {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *DisableIsolated) RecvWindow(recvWindow int64) *DisableIsolated {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *DisableIsolated) Do(ctx context.Context) (*IsolatedSwitchResponse, error) {
+	resp := new(IsolatedSwitchResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// IsolatedAccountLimit Query enabled isolated margin account limit.
+type IsolatedAccountLimit struct {
+	c *Client
+	r *core.Request
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *IsolatedAccountLimit) RecvWindow(recvWindow int64) *IsolatedAccountLimit {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *IsolatedAccountLimit) Do(ctx context.Context) (*IsolatedAccountLimitResponse, error) {
+	resp := new(IsolatedAccountLimitResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// AllIsolatedPairs Get all isolated margin symbols, or a single one when symbol is sent.
+type AllIsolatedPairs struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *AllIsolatedPairs) Symbol(symbol string) *AllIsolatedPairs {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *AllIsolatedPairs) RecvWindow(recvWindow int64) *AllIsolatedPairs {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *AllIsolatedPairs) Do(ctx context.Context) ([]*IsolatedPair, error) {
+	resp := make([]*IsolatedPair, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
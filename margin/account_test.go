@@ -0,0 +1,144 @@
+package margin
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type accountTestSuite struct {
+	baseHttpTestSuite
+}
+
+func TestAccount(t *testing.T) {
+	suite.Run(t, new(accountTestSuite))
+}
+
+func (s *accountTestSuite) TestBorrowRepay() {
+	msg := []byte(`{"tranId": 100000001}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewBorrowRepay().Asset("BTC").Amount("1.01").Type(core.BorrowRepayTypeBORROW).Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Equal(int64(100000001), resp.TranId)
+}
+
+func (s *accountTestSuite) TestBorrowRepayRecords() {
+	msg := []byte(`{
+	  "rows": [
+		{
+		  "isolatedSymbol": "BNBUSDT",
+		  "amount": "14.00000000",
+		  "asset": "BNB",
+		  "interest": "0.01866667",
+		  "principal": "13.98133333",
+		  "status": "CONFIRMED",
+		  "timestamp": 1563438204000,
+		  "txId": 2970933056
+		}
+	  ],
+	  "total": 1
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewBorrowRepayRecords().Asset("BNB").Type(core.BorrowRepayTypeREPAY).Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *BorrowRepayRecordsResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(testResp.Total, resp.Total)
+	r.Equal(*testResp.Rows[0], *resp.Rows[0])
+}
+
+func (s *accountTestSuite) TestMaxBorrowable() {
+	msg := []byte(`{"amount": "1.69248805", "borrowLimit": "60"}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewMaxBorrowable().Asset("BTC").IsolatedSymbol("BTCUSDT").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Equal("1.69248805", resp.Amount.String())
+	r.Equal("60", resp.BorrowLimit.String())
+}
+
+func (s *accountTestSuite) TestAccountInfo() {
+	msg := []byte(`{
+	  "created": true,
+	  "borrowEnabled": true,
+	  "marginLevel": "11.64405625",
+	  "collateralMarginLevel": "3.2",
+	  "totalAssetOfBtc": "6.82728457",
+	  "totalLiabilityOfBtc": "0.58633215",
+	  "totalNetAssetOfBtc": "6.24095242",
+	  "TotalCollateralValueInUSDT": "5.82728457",
+	  "tradeEnabled": true,
+	  "transferInEnabled": true,
+	  "transferOutEnabled": true,
+	  "accountType": "MARGIN_1",
+	  "userAssets": [
+		{
+		  "asset": "BTC",
+		  "borrowed": "0.00000000",
+		  "free": "0.00499500",
+		  "interest": "0.00000000",
+		  "locked": "0.00000000",
+		  "netAsset": "0.00499500"
+		}
+	  ]
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewAccountInfo().Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *AccountInfoResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(testResp.MarginLevel, resp.MarginLevel)
+	r.Equal(testResp.AccountType, resp.AccountType)
+	r.Equal(*testResp.UserAssets[0], *resp.UserAssets[0])
+}
+
+func (s *accountTestSuite) TestEnableIsolated() {
+	msg := []byte(`{"success": true, "symbol": "BTCUSDT"}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewEnableIsolated().Symbol("BTCUSDT").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.True(resp.Success)
+	r.Equal("BTCUSDT", resp.Symbol)
+}
+
+func (s *accountTestSuite) TestAllIsolatedPairs() {
+	msg := []byte(`[
+	  {
+		"symbol": "BTCUSDT",
+		"base": "BTC",
+		"quote": "USDT",
+		"isMarginTrade": true,
+		"isBuyAllowed": true,
+		"isSellAllowed": true
+	  }
+	]`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewAllIsolatedPairs().Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp []*IsolatedPair
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(*testResp[0], *resp[0])
+}
+
+func (s *accountTestSuite) TestStartUserDataStream() {
+	msg := []byte(`{"listenKey": "T3ee22BIYuWqmvne0HNq2A2WsFlEtLhvWCtItw6ffhhdmjifQ2tRbuKkTHhr"}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewStartIsolatedUserDataStream().Symbol("BTCUSDT").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Equal("T3ee22BIYuWqmvne0HNq2A2WsFlEtLhvWCtItw6ffhhdmjifQ2tRbuKkTHhr", resp.ListenKey)
+}
@@ -0,0 +1,154 @@
+package margin
+
+import (
+	"context"
+	"github.com/jekaxv/go-binance/core"
+	"net/http"
+)
+
+type Client struct {
+	*core.Client
+}
+
+func (c *Client) invoke(r *core.Request, ctx context.Context) error {
+	return c.Invoke(r, ctx)
+}
+
+func (c *Client) rawBody() []byte {
+	return c.RawBody()
+}
+
+// NewCreateOrder Margin Account New Order (TRADE)
+func (c *Client) NewCreateOrder() *CreateOrder {
+	return &CreateOrder{c: c, r: c.SetReq("/sapi/v1/margin/order", http.MethodPost, core.AuthSigned)}
+}
+
+// NewCancelOrder Margin Account Cancel Order (TRADE)
+func (c *Client) NewCancelOrder() *CancelOrder {
+	return &CancelOrder{c: c, r: c.SetReq("/sapi/v1/margin/order", http.MethodDelete, core.AuthSigned)}
+}
+
+// NewCancelOpenOrder Margin Account Cancel all Open Orders on a Symbol (TRADE)
+func (c *Client) NewCancelOpenOrder() *CancelOpenOrder {
+	return &CancelOpenOrder{c: c, r: c.SetReq("/sapi/v1/margin/openOrders", http.MethodDelete, core.AuthSigned)}
+}
+
+// NewQueryOrder Query Margin Account's Order (USER_DATA)
+func (c *Client) NewQueryOrder() *QueryOrder {
+	return &QueryOrder{c: c, r: c.SetReq("/sapi/v1/margin/order", http.MethodGet, core.AuthSigned)}
+}
+
+// NewOpenOrders Query Margin Account's Open Orders (USER_DATA)
+func (c *Client) NewOpenOrders() *OpenOrders {
+	return &OpenOrders{c: c, r: c.SetReq("/sapi/v1/margin/openOrders", http.MethodGet, core.AuthSigned)}
+}
+
+// NewAllOrders Query Margin Account's All Orders (USER_DATA)
+func (c *Client) NewAllOrders() *AllOrders {
+	return &AllOrders{c: c, r: c.SetReq("/sapi/v1/margin/allOrders", http.MethodGet, core.AuthSigned)}
+}
+
+// NewCreateOCO Margin Account New OCO (TRADE)
+func (c *Client) NewCreateOCO() *CreateOCO {
+	return &CreateOCO{c: c, r: c.SetReq("/sapi/v1/margin/order/oco", http.MethodPost, core.AuthSigned)}
+}
+
+// NewCancelOrderList Margin Account Cancel OCO (TRADE)
+func (c *Client) NewCancelOrderList() *CancelOrderList {
+	return &CancelOrderList{c: c, r: c.SetReq("/sapi/v1/margin/orderList", http.MethodDelete, core.AuthSigned)}
+}
+
+// NewQueryOrderList Query Margin Account's OCO (USER_DATA)
+func (c *Client) NewQueryOrderList() *QueryOrderList {
+	return &QueryOrderList{c: c, r: c.SetReq("/sapi/v1/margin/orderList", http.MethodGet, core.AuthSigned)}
+}
+
+// NewBorrowRepay Margin account borrow/repay (MARGIN)
+func (c *Client) NewBorrowRepay() *BorrowRepay {
+	return &BorrowRepay{c: c, r: c.SetReq("/sapi/v1/margin/borrow-repay", http.MethodPost, core.AuthSigned)}
+}
+
+// NewBorrowRepayRecords Query borrow/repay records in Margin account (USER_DATA)
+func (c *Client) NewBorrowRepayRecords() *BorrowRepayRecords {
+	return &BorrowRepayRecords{c: c, r: c.SetReq("/sapi/v1/margin/borrow-repay", http.MethodGet, core.AuthSigned)}
+}
+
+// NewMaxBorrowable Query Max Borrow (USER_DATA)
+func (c *Client) NewMaxBorrowable() *MaxBorrowable {
+	return &MaxBorrowable{c: c, r: c.SetReq("/sapi/v1/margin/maxBorrowable", http.MethodGet, core.AuthSigned)}
+}
+
+// NewMaxTransferable Query Max Transfer-Out Amount (USER_DATA)
+func (c *Client) NewMaxTransferable() *MaxTransferable {
+	return &MaxTransferable{c: c, r: c.SetReq("/sapi/v1/margin/maxTransferable", http.MethodGet, core.AuthSigned)}
+}
+
+// NewInterestHistory Get Interest History (USER_DATA)
+func (c *Client) NewInterestHistory() *InterestHistory {
+	return &InterestHistory{c: c, r: c.SetReq("/sapi/v1/margin/interestHistory", http.MethodGet, core.AuthSigned)}
+}
+
+// NewForceLiquidationRec Get Force Liquidation Record (USER_DATA)
+func (c *Client) NewForceLiquidationRec() *ForceLiquidationRec {
+	return &ForceLiquidationRec{c: c, r: c.SetReq("/sapi/v1/margin/forceLiquidationRec", http.MethodGet, core.AuthSigned)}
+}
+
+// NewAccountInfo Query Cross Margin Account Details (USER_DATA)
+func (c *Client) NewAccountInfo() *AccountInfo {
+	return &AccountInfo{c: c, r: c.SetReq("/sapi/v1/margin/account", http.MethodGet, core.AuthSigned)}
+}
+
+// NewIsolatedAccount Query Isolated Margin Account Info (USER_DATA)
+func (c *Client) NewIsolatedAccount() *IsolatedAccount {
+	return &IsolatedAccount{c: c, r: c.SetReq("/sapi/v1/margin/isolated/account", http.MethodGet, core.AuthSigned)}
+}
+
+// NewEnableIsolated Enable Isolated Margin Account (TRADE)
+func (c *Client) NewEnableIsolated() *EnableIsolated {
+	return &EnableIsolated{c: c, r: c.SetReq("/sapi/v1/margin/isolated/account", http.MethodPost, core.AuthSigned)}
+}
+
+// NewDisableIsolated Disable Isolated Margin Account (TRADE)
+func (c *Client) NewDisableIsolated() *DisableIsolated {
+	return &DisableIsolated{c: c, r: c.SetReq("/sapi/v1/margin/isolated/account", http.MethodDelete, core.AuthSigned)}
+}
+
+// NewIsolatedAccountLimit Query Enabled Isolated Margin Account Limit (USER_DATA)
+func (c *Client) NewIsolatedAccountLimit() *IsolatedAccountLimit {
+	return &IsolatedAccountLimit{c: c, r: c.SetReq("/sapi/v1/margin/isolated/accountLimit", http.MethodGet, core.AuthSigned)}
+}
+
+// NewAllIsolatedPairs Get All Isolated Margin Symbol (MARKET_DATA)
+func (c *Client) NewAllIsolatedPairs() *AllIsolatedPairs {
+	return &AllIsolatedPairs{c: c, r: c.SetReq("/sapi/v1/margin/isolated/allPairs", http.MethodGet, core.AuthSigned)}
+}
+
+// NewStartUserDataStream Start Margin User Data Stream (USER_STREAM)
+func (c *Client) NewStartUserDataStream() *StartUserDataStream {
+	return &StartUserDataStream{c: c, r: c.SetReq("/sapi/v1/userDataStream", http.MethodPost, core.AuthApiKey)}
+}
+
+// NewKeepaliveUserDataStream Keepalive Margin User Data Stream (USER_STREAM)
+func (c *Client) NewKeepaliveUserDataStream() *KeepaliveUserDataStream {
+	return &KeepaliveUserDataStream{c: c, r: c.SetReq("/sapi/v1/userDataStream", http.MethodPut, core.AuthApiKey)}
+}
+
+// NewCloseUserDataStream Close Margin User Data Stream (USER_STREAM)
+func (c *Client) NewCloseUserDataStream() *CloseUserDataStream {
+	return &CloseUserDataStream{c: c, r: c.SetReq("/sapi/v1/userDataStream", http.MethodDelete, core.AuthApiKey)}
+}
+
+// NewStartIsolatedUserDataStream Start Isolated Margin User Data Stream (USER_STREAM)
+func (c *Client) NewStartIsolatedUserDataStream() *StartIsolatedUserDataStream {
+	return &StartIsolatedUserDataStream{c: c, r: c.SetReq("/sapi/v1/userDataStream/isolated", http.MethodPost, core.AuthApiKey)}
+}
+
+// NewKeepaliveIsolatedUserDataStream Keepalive Isolated Margin User Data Stream (USER_STREAM)
+func (c *Client) NewKeepaliveIsolatedUserDataStream() *KeepaliveIsolatedUserDataStream {
+	return &KeepaliveIsolatedUserDataStream{c: c, r: c.SetReq("/sapi/v1/userDataStream/isolated", http.MethodPut, core.AuthApiKey)}
+}
+
+// NewCloseIsolatedUserDataStream Close Isolated Margin User Data Stream (USER_STREAM)
+func (c *Client) NewCloseIsolatedUserDataStream() *CloseIsolatedUserDataStream {
+	return &CloseIsolatedUserDataStream{c: c, r: c.SetReq("/sapi/v1/userDataStream/isolated", http.MethodDelete, core.AuthApiKey)}
+}
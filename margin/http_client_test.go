@@ -0,0 +1,52 @@
+package margin
+
+import (
+	"github.com/jekaxv/go-binance/core"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+)
+
+type mockedHttpClient struct {
+	mock.Mock
+	*Client
+}
+
+type baseHttpTestSuite struct {
+	suite.Suite
+	client *mockedHttpClient
+}
+
+func (s *baseHttpTestSuite) SetupTest() {
+	s.client = new(mockedHttpClient)
+	client := Client{
+		&core.Client{
+			Opt: &core.Options{
+				ApiKey:    "YOUR_API_KEY",
+				ApiSecret: "YOUR_API_SECRET",
+				Logger:    slog.Default(),
+			},
+			HttpClient: http.DefaultClient,
+		},
+	}
+	s.client.Client = &client
+}
+
+func (s *baseHttpTestSuite) r() *require.Assertions {
+	return s.Require()
+}
+
+func (s *baseHttpTestSuite) mockServer(msg []byte) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(msg)
+	}))
+}
+
+func (s *baseHttpTestSuite) setup(msg []byte) *httptest.Server {
+	server := s.mockServer(msg)
+	s.client.Opt.Endpoint = server.URL
+	return server
+}
@@ -0,0 +1,16 @@
+package margin
+
+import (
+	"github.com/jekaxv/go-binance/core"
+)
+
+// StreamDescriptors Builds descriptors of the margin user data stream, use it through Streams.
+type StreamDescriptors struct{}
+
+// Streams Describes margin streams, e.g. margin.Streams.UserData(listenKey).
+var Streams StreamDescriptors
+
+// UserData Stream Name: <listenKey>
+func (StreamDescriptors) UserData(listenKey string) core.StreamDescriptor[*UserDataEvent] {
+	return core.NewStreamDescriptorFunc(listenKey, parseUserEvent)
+}
@@ -0,0 +1,536 @@
+package margin
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/spot"
+	"github.com/shopspring/decimal"
+	"strconv"
+	"strings"
+)
+
+// CreateOrderResponse The spot order response plus the borrowed amount of MARGIN_BUY and AUTO_BORROW_REPAY orders.
+type CreateOrderResponse struct {
+	spot.CreateOrderResponse
+	MarginBuyBorrowAmount decimal.Decimal `json:"marginBuyBorrowAmount"`
+	MarginBuyBorrowAsset  string          `json:"marginBuyBorrowAsset"`
+	IsIsolated            bool            `json:"isIsolated"`
+}
+
+type OrderResponse struct {
+	spot.OrdersResponse
+	OrigClientOrderId string `json:"origClientOrderId"`
+	IsIsolated        bool   `json:"isIsolated"`
+}
+
+type OrderListResponse struct {
+	spot.OrderListResponse
+	MarginBuyBorrowAmount decimal.Decimal `json:"marginBuyBorrowAmount"`
+	MarginBuyBorrowAsset  string          `json:"marginBuyBorrowAsset"`
+	IsIsolated            bool            `json:"isIsolated"`
+}
+
+// CreateOrder Post a new order for margin account. The parameters are the ones of spot CreateOrder plus isIsolated, sideEffectType and autoRepayAtCancel.
+type CreateOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *CreateOrder) Symbol(symbol string) *CreateOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// IsIsolated For isolated margin or not, default false
+func (s *CreateOrder) IsIsolated(isIsolated bool) *CreateOrder {
+	s.r.Set("isIsolated", strings.ToUpper(strconv.FormatBool(isIsolated)))
+	return s
+}
+
+// Side BUY or SELL
+func (s *CreateOrder) Side(side core.OrderSideEnum) *CreateOrder {
+	s.r.Set("side", side)
+	return s
+}
+
+func (s *CreateOrder) Type(typ core.OrderTypeEnum) *CreateOrder {
+	s.r.Set("type", typ)
+	return s
+}
+
+func (s *CreateOrder) TimeInForce(timeInForce core.TimeInForceEnum) *CreateOrder {
+	s.r.Set("timeInForce", timeInForce)
+	return s
+}
+
+func (s *CreateOrder) Quantity(quantity string) *CreateOrder {
+	s.r.Set("quantity", quantity)
+	return s
+}
+
+func (s *CreateOrder) QuoteOrderQty(quoteOrderQty string) *CreateOrder {
+	s.r.Set("quoteOrderQty", quoteOrderQty)
+	return s
+}
+
+func (s *CreateOrder) Price(price string) *CreateOrder {
+	s.r.Set("price", price)
+	return s
+}
+
+// NewClientOrderId A unique id among open orders. Automatically generated if not sent.
+func (s *CreateOrder) NewClientOrderId(newClientOrderId string) *CreateOrder {
+	s.r.Set("newClientOrderId", newClientOrderId)
+	return s
+}
+
+// StopPrice Used with STOP_LOSS, STOP_LOSS_LIMIT, TAKE_PROFIT, and TAKE_PROFIT_LIMIT orders.
+func (s *CreateOrder) StopPrice(stopPrice string) *CreateOrder {
+	s.r.Set("stopPrice", stopPrice)
+	return s
+}
+
+// IcebergQty Used with LIMIT, STOP_LOSS_LIMIT, and TAKE_PROFIT_LIMIT to create an iceberg order.
+func (s *CreateOrder) IcebergQty(icebergQty string) *CreateOrder {
+	s.r.Set("icebergQty", icebergQty)
+	return s
+}
+
+// NewOrderRespType ACK, RESULT, or FULL; MARKET and LIMIT order types default to FULL, all other orders default to ACK.
+func (s *CreateOrder) NewOrderRespType(newOrderRespType core.OrderResponseTypeEnum) *CreateOrder {
+	s.r.Set("newOrderRespType", newOrderRespType)
+	return s
+}
+
+func (s *CreateOrder) SelfTradePreventionMode(selfTradePreventionMode core.STPModeEnum) *CreateOrder {
+	s.r.Set("selfTradePreventionMode", selfTradePreventionMode)
+	return s
+}
+
+// SideEffectType NO_SIDE_EFFECT, MARGIN_BUY, AUTO_REPAY, AUTO_BORROW_REPAY; default NO_SIDE_EFFECT.
+func (s *CreateOrder) SideEffectType(sideEffectType core.SideEffectTypeEnum) *CreateOrder {
+	s.r.Set("sideEffectType", sideEffectType)
+	return s
+}
+
+// AutoRepayAtCancel Only when MARGIN_BUY or AUTO_BORROW_REPAY order takes effect, true means that the debt generated by the order needs to be repaid after the order is cancelled. default true
+func (s *CreateOrder) AutoRepayAtCancel(autoRepayAtCancel bool) *CreateOrder {
+	s.r.Set("autoRepayAtCancel", autoRepayAtCancel)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *CreateOrder) RecvWindow(recvWindow int64) *CreateOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CreateOrder) Do(ctx context.Context) (*CreateOrderResponse, error) {
+	resp := new(CreateOrderResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// CancelOrder Cancel an active order for margin account. Either orderId or origClientOrderId must be sent.
+type CancelOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *CancelOrder) Symbol(symbol string) *CancelOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// IsIsolated For isolated margin or not, default false
+func (s *CancelOrder) IsIsolated(isIsolated bool) *CancelOrder {
+	s.r.Set("isIsolated", strings.ToUpper(strconv.FormatBool(isIsolated)))
+	return s
+}
+
+func (s *CancelOrder) OrderId(orderId int64) *CancelOrder {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *CancelOrder) OrigClientOrderId(origClientOrderId string) *CancelOrder {
+	s.r.Set("origClientOrderId", origClientOrderId)
+	return s
+}
+
+// NewClientOrderId Used to uniquely identify this cancel. Automatically generated by default.
+func (s *CancelOrder) NewClientOrderId(newClientOrderId string) *CancelOrder {
+	s.r.Set("newClientOrderId", newClientOrderId)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *CancelOrder) RecvWindow(recvWindow int64) *CancelOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CancelOrder) Do(ctx context.Context) (*OrderResponse, error) {
+	resp := new(OrderResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// CancelOpenOrder Cancels all active orders on a symbol for margin account. This includes OCO orders.
+type CancelOpenOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *CancelOpenOrder) Symbol(symbol string) *CancelOpenOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// IsIsolated For isolated margin or not, default false
+func (s *CancelOpenOrder) IsIsolated(isIsolated bool) *CancelOpenOrder {
+	s.r.Set("isIsolated", strings.ToUpper(strconv.FormatBool(isIsolated)))
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *CancelOpenOrder) RecvWindow(recvWindow int64) *CancelOpenOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CancelOpenOrder) Do(ctx context.Context) ([]*spot.CancelOpenOrderResponse, error) {
+	resp := make([]*spot.CancelOpenOrderResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// QueryOrder Check an order's status for margin account. Either orderId or origClientOrderId must be sent.
+type QueryOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *QueryOrder) Symbol(symbol string) *QueryOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// IsIsolated For isolated margin or not, default false
+func (s *QueryOrder) IsIsolated(isIsolated bool) *QueryOrder {
+	s.r.Set("isIsolated", strings.ToUpper(strconv.FormatBool(isIsolated)))
+	return s
+}
+
+func (s *QueryOrder) OrderId(orderId int64) *QueryOrder {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *QueryOrder) OrigClientOrderId(origClientOrderId string) *QueryOrder {
+	s.r.Set("origClientOrderId", origClientOrderId)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *QueryOrder) RecvWindow(recvWindow int64) *QueryOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *QueryOrder) Do(ctx context.Context) (*OrderResponse, error) {
+	resp := new(OrderResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// OpenOrders Get all open orders on a symbol for margin account. Careful when accessing this with no symbol.
+type OpenOrders struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *OpenOrders) Symbol(symbol string) *OpenOrders {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// IsIsolated For isolated margin or not, default false
+func (s *OpenOrders) IsIsolated(isIsolated bool) *OpenOrders {
+	s.r.Set("isIsolated", strings.ToUpper(strconv.FormatBool(isIsolated)))
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *OpenOrders) RecvWindow(recvWindow int64) *OpenOrders {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *OpenOrders) Do(ctx context.Context) ([]*OrderResponse, error) {
+	resp := make([]*OrderResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// AllOrders Get all margin account orders; active, canceled, or filled.
+type AllOrders struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *AllOrders) Symbol(symbol string) *AllOrders {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// IsIsolated For isolated margin or not, default false
+func (s *AllOrders) IsIsolated(isIsolated bool) *AllOrders {
+	s.r.Set("isIsolated", strings.ToUpper(strconv.FormatBool(isIsolated)))
+	return s
+}
+
+func (s *AllOrders) OrderId(orderId int64) *AllOrders {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *AllOrders) StartTime(startTime int64) *AllOrders {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *AllOrders) EndTime(endTime int64) *AllOrders {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Limit Default 500; max 500.
+func (s *AllOrders) Limit(limit int) *AllOrders {
+	s.r.Set("limit", limit)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *AllOrders) RecvWindow(recvWindow int64) *AllOrders {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *AllOrders) Do(ctx context.Context) ([]*OrderResponse, error) {
+	resp := make([]*OrderResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// CreateOCO Send in a new OCO for a margin account.
+type CreateOCO struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *CreateOCO) Symbol(symbol string) *CreateOCO {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// IsIsolated For isolated margin or not, default false
+func (s *CreateOCO) IsIsolated(isIsolated bool) *CreateOCO {
+	s.r.Set("isIsolated", strings.ToUpper(strconv.FormatBool(isIsolated)))
+	return s
+}
+
+// ListClientOrderId A unique Id for the entire orderList
+func (s *CreateOCO) ListClientOrderId(listClientOrderId string) *CreateOCO {
+	s.r.Set("listClientOrderId", listClientOrderId)
+	return s
+}
+
+// Side BUY or SELL
+func (s *CreateOCO) Side(side core.OrderSideEnum) *CreateOCO {
+	s.r.Set("side", side)
+	return s
+}
+
+func (s *CreateOCO) Quantity(quantity string) *CreateOCO {
+	s.r.Set("quantity", quantity)
+	return s
+}
+
+func (s *CreateOCO) LimitClientOrderId(limitClientOrderId string) *CreateOCO {
+	s.r.Set("limitClientOrderId", limitClientOrderId)
+	return s
+}
+
+func (s *CreateOCO) Price(price string) *CreateOCO {
+	s.r.Set("price", price)
+	return s
+}
+
+func (s *CreateOCO) LimitIcebergQty(limitIcebergQty string) *CreateOCO {
+	s.r.Set("limitIcebergQty", limitIcebergQty)
+	return s
+}
+
+func (s *CreateOCO) StopClientOrderId(stopClientOrderId string) *CreateOCO {
+	s.r.Set("stopClientOrderId", stopClientOrderId)
+	return s
+}
+
+func (s *CreateOCO) StopPrice(stopPrice string) *CreateOCO {
+	s.r.Set("stopPrice", stopPrice)
+	return s
+}
+
+// StopLimitPrice If provided, stopLimitTimeInForce is required.
+func (s *CreateOCO) StopLimitPrice(stopLimitPrice string) *CreateOCO {
+	s.r.Set("stopLimitPrice", stopLimitPrice)
+	return s
+}
+
+func (s *CreateOCO) StopIcebergQty(stopIcebergQty string) *CreateOCO {
+	s.r.Set("stopIcebergQty", stopIcebergQty)
+	return s
+}
+
+// StopLimitTimeInForce Valid values are GTC/FOK/IOC
+func (s *CreateOCO) StopLimitTimeInForce(stopLimitTimeInForce core.TimeInForceEnum) *CreateOCO {
+	s.r.Set("stopLimitTimeInForce", stopLimitTimeInForce)
+	return s
+}
+
+func (s *CreateOCO) NewOrderRespType(newOrderRespType core.OrderResponseTypeEnum) *CreateOCO {
+	s.r.Set("newOrderRespType", newOrderRespType)
+	return s
+}
+
+// SideEffectType NO_SIDE_EFFECT, MARGIN_BUY, AUTO_REPAY, AUTO_BORROW_REPAY; default NO_SIDE_EFFECT.
+func (s *CreateOCO) SideEffectType(sideEffectType core.SideEffectTypeEnum) *CreateOCO {
+	s.r.Set("sideEffectType", sideEffectType)
+	return s
+}
+
+func (s *CreateOCO) SelfTradePreventionMode(selfTradePreventionMode core.STPModeEnum) *CreateOCO {
+	s.r.Set("selfTradePreventionMode", selfTradePreventionMode)
+	return s
+}
+
+func (s *CreateOCO) AutoRepayAtCancel(autoRepayAtCancel bool) *CreateOCO {
+	s.r.Set("autoRepayAtCancel", autoRepayAtCancel)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *CreateOCO) RecvWindow(recvWindow int64) *CreateOCO {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CreateOCO) Do(ctx context.Context) (*OrderListResponse, error) {
+	resp := new(OrderListResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// CancelOrderList Cancel an entire Order List for a margin account. Either orderListId or listClientOrderId must be provided.
+type CancelOrderList struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *CancelOrderList) Symbol(symbol string) *CancelOrderList {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// IsIsolated For isolated margin or not, default false
+func (s *CancelOrderList) IsIsolated(isIsolated bool) *CancelOrderList {
+	s.r.Set("isIsolated", strings.ToUpper(strconv.FormatBool(isIsolated)))
+	return s
+}
+
+func (s *CancelOrderList) OrderListId(orderListId int64) *CancelOrderList {
+	s.r.Set("orderListId", orderListId)
+	return s
+}
+
+func (s *CancelOrderList) ListClientOrderId(listClientOrderId string) *CancelOrderList {
+	s.r.Set("listClientOrderId", listClientOrderId)
+	return s
+}
+
+// NewClientOrderId Used to uniquely identify this cancel. Automatically generated by default.
+func (s *CancelOrderList) NewClientOrderId(newClientOrderId string) *CancelOrderList {
+	s.r.Set("newClientOrderId", newClientOrderId)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *CancelOrderList) RecvWindow(recvWindow int64) *CancelOrderList {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CancelOrderList) Do(ctx context.Context) (*OrderListResponse, error) {
+	resp := new(OrderListResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// QueryOrderList Retrieves a specific OCO based on provided optional parameters.
+type QueryOrderList struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *QueryOrderList) Symbol(symbol string) *QueryOrderList {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// IsIsolated For isolated margin or not, default false
+func (s *QueryOrderList) IsIsolated(isIsolated bool) *QueryOrderList {
+	s.r.Set("isIsolated", strings.ToUpper(strconv.FormatBool(isIsolated)))
+	return s
+}
+
+func (s *QueryOrderList) OrderListId(orderListId int64) *QueryOrderList {
+	s.r.Set("orderListId", orderListId)
+	return s
+}
+
+func (s *QueryOrderList) OrigClientOrderId(origClientOrderId string) *QueryOrderList {
+	s.r.Set("origClientOrderId", origClientOrderId)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *QueryOrderList) RecvWindow(recvWindow int64) *QueryOrderList {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *QueryOrderList) Do(ctx context.Context) (*OrderListResponse, error) {
+	resp := new(OrderListResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
@@ -0,0 +1,173 @@
+package margin
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type tradeTestSuite struct {
+	baseHttpTestSuite
+}
+
+func TestTrade(t *testing.T) {
+	suite.Run(t, new(tradeTestSuite))
+}
+
+func (s *tradeTestSuite) TestCreateOrder() {
+	msg := []byte(`{
+	  "symbol": "BTCUSDT",
+	  "orderId": 28,
+	  "clientOrderId": "6gCrw2kRUAF9CvJDGP16IP",
+	  "transactTime": 1507725176595,
+	  "price": "1.00000000",
+	  "origQty": "10.00000000",
+	  "executedQty": "10.00000000",
+	  "cummulativeQuoteQty": "10.00000000",
+	  "status": "FILLED",
+	  "timeInForce": "GTC",
+	  "type": "MARKET",
+	  "side": "BUY",
+	  "marginBuyBorrowAmount": "5",
+	  "marginBuyBorrowAsset": "BTC",
+	  "isIsolated": true,
+	  "selfTradePreventionMode": "NONE",
+	  "fills": [
+		{
+		  "price": "4000.00000000",
+		  "qty": "1.00000000",
+		  "commission": "4.00000000",
+		  "commissionAsset": "USDT"
+		}
+	  ]
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewCreateOrder().Symbol("BTCUSDT").IsIsolated(true).Side(core.OrderSideBUY).
+		Type(core.OrderTypeMARKET).Quantity("10").SideEffectType(core.SideEffectTypeMARGIN_BUY).Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *CreateOrderResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(*testResp, *resp)
+	r.Equal("5", resp.MarginBuyBorrowAmount.String())
+	r.Equal("BTC", resp.MarginBuyBorrowAsset)
+	r.Len(resp.Fills, 1)
+}
+
+func (s *tradeTestSuite) TestQueryOrder() {
+	msg := []byte(`{
+	  "clientOrderId": "ZwfQzuDIGpceVhKW5DvCmO",
+	  "cummulativeQuoteQty": "0.00000000",
+	  "executedQty": "0.00000000",
+	  "icebergQty": "0.00000000",
+	  "isWorking": true,
+	  "orderId": 213205622,
+	  "origQty": "0.30000000",
+	  "price": "0.00493630",
+	  "side": "SELL",
+	  "status": "NEW",
+	  "stopPrice": "0.00000000",
+	  "symbol": "BNBBTC",
+	  "isIsolated": true,
+	  "time": 1562133008725,
+	  "timeInForce": "GTC",
+	  "type": "LIMIT",
+	  "selfTradePreventionMode": "NONE",
+	  "updateTime": 1562133008725
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewQueryOrder().Symbol("BNBBTC").IsIsolated(true).OrderId(213205622).Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *OrderResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(*testResp, *resp)
+	r.True(resp.IsIsolated)
+}
+
+func (s *tradeTestSuite) TestOpenOrders() {
+	msg := []byte(`[
+	  {
+		"clientOrderId": "qhcZw71gAkCCTv0t0k8LUK",
+		"cummulativeQuoteQty": "0.00000000",
+		"executedQty": "0.00000000",
+		"icebergQty": "0.00000000",
+		"isWorking": true,
+		"orderId": 211842552,
+		"origQty": "0.30000000",
+		"price": "0.00475010",
+		"side": "SELL",
+		"status": "NEW",
+		"stopPrice": "0.00000000",
+		"symbol": "BNBBTC",
+		"isIsolated": true,
+		"time": 1562040170089,
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"selfTradePreventionMode": "NONE",
+		"updateTime": 1562040170089
+	  }
+	]`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewOpenOrders().Symbol("BNBBTC").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp []*OrderResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	for i := range testResp {
+		r.Equal(*testResp[i], *resp[i])
+	}
+}
+
+func (s *tradeTestSuite) TestCreateOCO() {
+	msg := []byte(`{
+	  "orderListId": 0,
+	  "contingencyType": "OCO",
+	  "listStatusType": "EXEC_STARTED",
+	  "listOrderStatus": "EXECUTING",
+	  "listClientOrderId": "JYVpp3F0f5CAG15DhtrqLp",
+	  "transactionTime": 1563417480525,
+	  "symbol": "LTCBTC",
+	  "marginBuyBorrowAmount": "5",
+	  "marginBuyBorrowAsset": "BTC",
+	  "isIsolated": false,
+	  "orders": [
+		{"symbol": "LTCBTC", "orderId": 2, "clientOrderId": "Kk7sqHb9J6mJWTMDVW7Vos"},
+		{"symbol": "LTCBTC", "orderId": 3, "clientOrderId": "xTXKaGYd4bluPVp78IVRvl"}
+	  ],
+	  "orderReports": [
+		{
+		  "symbol": "LTCBTC",
+		  "orderId": 2,
+		  "orderListId": 0,
+		  "clientOrderId": "Kk7sqHb9J6mJWTMDVW7Vos",
+		  "transactTime": 1563417480525,
+		  "price": "0.000000",
+		  "origQty": "0.624363",
+		  "executedQty": "0.000000",
+		  "cummulativeQuoteQty": "0.000000",
+		  "status": "NEW",
+		  "timeInForce": "GTC",
+		  "type": "STOP_LOSS",
+		  "side": "BUY",
+		  "stopPrice": "0.960664",
+		  "selfTradePreventionMode": "NONE"
+		}
+	  ]
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewCreateOCO().Symbol("LTCBTC").Side(core.OrderSideBUY).Quantity("0.624363").
+		Price("0.9").StopPrice("0.960664").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *OrderListResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(*testResp, *resp)
+	r.Len(resp.Orders, 2)
+}
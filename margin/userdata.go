@@ -0,0 +1,126 @@
+package margin
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+)
+
+type ListenKeyResponse struct {
+	ListenKey string `json:"listenKey"`
+}
+
+// StartUserDataStream Start a new margin user data stream. The stream will close after 60 minutes unless a keepalive is sent.
+type StartUserDataStream struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *StartUserDataStream) Do(ctx context.Context) (*ListenKeyResponse, error) {
+	resp := new(ListenKeyResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// KeepaliveUserDataStream Keepalive a margin user data stream to prevent a time out.
+type KeepaliveUserDataStream struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *KeepaliveUserDataStream) ListenKey(listenKey string) *KeepaliveUserDataStream {
+	s.r.Set("listenKey", listenKey)
+	return s
+}
+
+func (s *KeepaliveUserDataStream) Do(ctx context.Context) error {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CloseUserDataStream Close out a margin user data stream.
+type CloseUserDataStream struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *CloseUserDataStream) ListenKey(listenKey string) *CloseUserDataStream {
+	s.r.Set("listenKey", listenKey)
+	return s
+}
+
+func (s *CloseUserDataStream) Do(ctx context.Context) error {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// StartIsolatedUserDataStream Start a new isolated margin user data stream for a symbol.
+type StartIsolatedUserDataStream struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *StartIsolatedUserDataStream) Symbol(symbol string) *StartIsolatedUserDataStream {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *StartIsolatedUserDataStream) Do(ctx context.Context) (*ListenKeyResponse, error) {
+	resp := new(ListenKeyResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// KeepaliveIsolatedUserDataStream Keepalive an isolated margin user data stream to prevent a time out.
+type KeepaliveIsolatedUserDataStream struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *KeepaliveIsolatedUserDataStream) Symbol(symbol string) *KeepaliveIsolatedUserDataStream {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *KeepaliveIsolatedUserDataStream) ListenKey(listenKey string) *KeepaliveIsolatedUserDataStream {
+	s.r.Set("listenKey", listenKey)
+	return s
+}
+
+func (s *KeepaliveIsolatedUserDataStream) Do(ctx context.Context) error {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CloseIsolatedUserDataStream Close out an isolated margin user data stream.
+type CloseIsolatedUserDataStream struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *CloseIsolatedUserDataStream) Symbol(symbol string) *CloseIsolatedUserDataStream {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *CloseIsolatedUserDataStream) ListenKey(listenKey string) *CloseIsolatedUserDataStream {
+	s.r.Set("listenKey", listenKey)
+	return s
+}
+
+func (s *CloseIsolatedUserDataStream) Do(ctx context.Context) error {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return err
+	}
+	return nil
+}
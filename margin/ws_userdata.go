@@ -0,0 +1,85 @@
+package margin
+
+import (
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/spot"
+	"github.com/shopspring/decimal"
+)
+
+// WebsocketStreams The cross and isolated margin user data stream.
+type WebsocketStreams struct {
+	c *WsClient
+}
+
+type UserDataStream struct {
+	*core.Stream[*UserDataEvent]
+}
+
+type UserDataEventType string
+
+const (
+	outboundAccountPosition UserDataEventType = "outboundAccountPosition"
+	balanceUpdate                             = "balanceUpdate"
+	executionReport                           = "executionReport"
+	listStatus                                = "listStatus"
+	listenKeyExpired                          = "listenKeyExpired"
+	marginLevelStatusChange                   = "MARGIN_LEVEL_STATUS_CHANGE"
+	userLiabilityChange                       = "USER_LIABILITY_CHANGE"
+)
+
+// UserDataEvent Order, balance and list events reuse the spot payloads.
+type UserDataEvent struct {
+	Event                   UserDataEventType `json:"e"`
+	Time                    int64             `json:"E"`
+	AccountUpdate           spot.AccountUpdate
+	BalanceUpdate           spot.BalanceUpdate
+	OrderUpdate             spot.OrderUpdate
+	ListStatus              spot.ListStatus
+	ListenExpired           spot.ListenExpired
+	MarginLevelStatusChange MarginLevelStatusChange
+	LiabilityChange         LiabilityChange
+}
+
+// MarginLevelStatusChange Pushed when the margin level status of the account changes.
+type MarginLevelStatusChange struct {
+	MarginLevel decimal.Decimal `json:"l"`
+	Status      string          `json:"s"` // NORMAL, MARGIN_CALL, PRE_LIQUIDATION, FORCE_LIQUIDATION
+}
+
+// LiabilityChange Pushed when the liability of an asset changes, e.g. after a borrow or an interest accrual.
+type LiabilityChange struct {
+	Asset     string          `json:"a"`
+	Type      string          `json:"t"` // BORROW
+	TxId      int64           `json:"T"`
+	Principal decimal.Decimal `json:"p"`
+	Interest  decimal.Decimal `json:"i"`
+}
+
+func (s *WebsocketStreams) SubscribeUserData(listenKey string) *UserDataStream {
+	return &UserDataStream{core.NewRawStream(s.c.WsClient, Streams.UserData(listenKey))}
+}
+
+func parseUserEvent(message []byte) (*UserDataEvent, error) {
+	var event *UserDataEvent
+	if err := json.Unmarshal(message, &event); err != nil {
+		return event, err
+	}
+	switch event.Event {
+	case outboundAccountPosition:
+		return event, json.Unmarshal(message, &event.AccountUpdate)
+	case balanceUpdate:
+		return event, json.Unmarshal(message, &event.BalanceUpdate)
+	case executionReport:
+		return event, json.Unmarshal(message, &event.OrderUpdate)
+	case listStatus:
+		return event, json.Unmarshal(message, &event.ListStatus)
+	case listenKeyExpired:
+		return event, json.Unmarshal(message, &event.ListenExpired)
+	case marginLevelStatusChange:
+		return event, json.Unmarshal(message, &event.MarginLevelStatusChange)
+	case userLiabilityChange:
+		return event, json.Unmarshal(message, &event.LiabilityChange)
+	}
+	return event, nil
+}
@@ -0,0 +1,54 @@
+package margin
+
+import (
+	"context"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type userDataStreamTestSuite struct {
+	baseWsTestSuite
+}
+
+func TestUserDataStream(t *testing.T) {
+	suite.Run(t, new(userDataStreamTestSuite))
+}
+
+func (s *userDataStreamTestSuite) subscribe(msg []byte) *UserDataEvent {
+	server := s.setup(msg)
+	defer server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	onMessage, onError := s.client.NewWebsocketStreams().SubscribeUserData("listenKey").Do(ctx)
+	select {
+	case event := <-onMessage:
+		return event
+	case err := <-onError:
+		s.FailNow(err.Error())
+	}
+	return nil
+}
+
+func (s *userDataStreamTestSuite) TestExecutionReport() {
+	event := s.subscribe([]byte(`{"e":"executionReport","E":1499405658658,"s":"ETHBTC","c":"mUvoqJxFIILMdfAW5iGSOW","S":"BUY","o":"LIMIT","f":"GTC","q":"1.00000000","p":"0.10264410","P":"0.00000000","F":"0.00000000","g":-1,"C":"","x":"NEW","X":"NEW","r":"NONE","i":4293153,"l":"0.00000000","z":"0.00000000","L":"0.00000000","n":"0","N":null,"T":1499405658657,"t":-1,"I":8641984,"w":true,"m":false,"M":false,"O":1499405658657,"Z":"0.00000000","Y":"0.00000000","Q":"0.00000000","V":"NONE"}`))
+	r := s.r()
+	r.Equal(executionReport, string(event.Event), "Event")
+	r.Equal("ETHBTC", event.OrderUpdate.Symbol, "Symbol")
+	r.Equal(4293153, event.OrderUpdate.OrderId, "OrderId")
+}
+
+func (s *userDataStreamTestSuite) TestMarginLevelStatusChange() {
+	event := s.subscribe([]byte(`{"e":"MARGIN_LEVEL_STATUS_CHANGE","E":1710000000000,"l":"1.25","s":"MARGIN_CALL"}`))
+	r := s.r()
+	r.Equal(marginLevelStatusChange, string(event.Event), "Event")
+	r.Equal("1.25", event.MarginLevelStatusChange.MarginLevel.String(), "MarginLevel")
+	r.Equal("MARGIN_CALL", event.MarginLevelStatusChange.Status, "Status")
+}
+
+func (s *userDataStreamTestSuite) TestLiabilityChange() {
+	event := s.subscribe([]byte(`{"e":"USER_LIABILITY_CHANGE","E":1710000000000,"a":"BTC","t":"BORROW","T":1352286576452864727,"p":"1.03453430","i":"0"}`))
+	r := s.r()
+	r.Equal(userLiabilityChange, string(event.Event), "Event")
+	r.Equal("BTC", event.LiabilityChange.Asset, "Asset")
+	r.Equal(int64(1352286576452864727), event.LiabilityChange.TxId, "TxId")
+}
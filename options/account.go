@@ -0,0 +1,57 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/shopspring/decimal"
+)
+
+// AccountInfo Get current option margin account information.
+type AccountInfo struct {
+	c *Client
+	r *core.Request
+}
+
+type AccountAsset struct {
+	Asset          string          `json:"asset"`
+	MarginBalance  decimal.Decimal `json:"marginBalance"`
+	Equity         decimal.Decimal `json:"equity"`
+	Available      decimal.Decimal `json:"available"`
+	InitialMargin  decimal.Decimal `json:"initialMargin"`
+	MaintMargin    decimal.Decimal `json:"maintMargin"`
+	UnrealizedPNL  decimal.Decimal `json:"unrealizedPNL"`
+	AdjustedEquity decimal.Decimal `json:"adjustedEquity"`
+}
+
+// AccountGreek Greeks of all positions on an underlying.
+type AccountGreek struct {
+	Underlying string          `json:"underlying"`
+	Delta      decimal.Decimal `json:"delta"`
+	Gamma      decimal.Decimal `json:"gamma"`
+	Theta      decimal.Decimal `json:"theta"`
+	Vega       decimal.Decimal `json:"vega"`
+}
+
+type AccountInfoResponse struct {
+	Asset       []*AccountAsset `json:"asset"`
+	Greek       []*AccountGreek `json:"greek"`
+	Time        int64           `json:"time"`
+	CanTrade    bool            `json:"canTrade"`
+	CanDeposit  bool            `json:"canDeposit"`
+	CanWithdraw bool            `json:"canWithdraw"`
+	ReduceOnly  bool            `json:"reduceOnly"`
+}
+
+func (s *AccountInfo) RecvWindow(recvWindow int64) *AccountInfo {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *AccountInfo) Do(ctx context.Context) (*AccountInfoResponse, error) {
+	resp := new(AccountInfoResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
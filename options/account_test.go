@@ -0,0 +1,68 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type accountTestSuite struct {
+	baseHttpTestSuite
+}
+
+func TestAccount(t *testing.T) {
+	suite.Run(t, new(accountTestSuite))
+}
+
+func (s *accountTestSuite) TestAccountInfo() {
+	msg := []byte(`{
+	  "asset": [
+		{
+		  "asset": "USDT",
+		  "marginBalance": "10099.448",
+		  "equity": "10094.44662",
+		  "available": "8725.92524",
+		  "initialMargin": "1084.52138",
+		  "maintMargin": "151.00138",
+		  "unrealizedPNL": "-5.00138",
+		  "adjustedEquity": "34.13282285"
+		}
+	  ],
+	  "greek": [
+		{
+		  "underlying": "BTCUSDT",
+		  "delta": "-0.05",
+		  "gamma": "-0.002",
+		  "theta": "-0.05",
+		  "vega": "-0.002"
+		}
+	  ],
+	  "time": 1592449455993,
+	  "canTrade": true,
+	  "canDeposit": true,
+	  "canWithdraw": true,
+	  "reduceOnly": false
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewAccountInfo().Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *AccountInfoResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(testResp.Time, resp.Time, "Time")
+	r.Equal(testResp.CanTrade, resp.CanTrade, "CanTrade")
+	r.Equal(*testResp.Asset[0], *resp.Asset[0])
+	r.Equal(*testResp.Greek[0], *resp.Greek[0])
+}
+
+func (s *accountTestSuite) TestGetListenKey() {
+	msg := []byte(`{"listenKey": "pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1"}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewGetListenKey().Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Equal("pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1", resp.ListenKey)
+}
@@ -0,0 +1,154 @@
+package options
+
+import (
+	"context"
+	"github.com/jekaxv/go-binance/core"
+	"net/http"
+)
+
+type Client struct {
+	*core.Client
+}
+
+func (c *Client) invoke(r *core.Request, ctx context.Context) error {
+	return c.Invoke(r, ctx)
+}
+
+func (c *Client) rawBody() []byte {
+	return c.RawBody()
+}
+
+// NewPing Test connectivity
+func (c *Client) NewPing() *Ping {
+	return &Ping{c: c, r: c.SetReq("/eapi/v1/ping", http.MethodGet)}
+}
+
+// NewServerTime Check server time
+func (c *Client) NewServerTime() *ServerTime {
+	return &ServerTime{c: c, r: c.SetReq("/eapi/v1/time", http.MethodGet)}
+}
+
+// NewExchangeInfo Exchange information
+func (c *Client) NewExchangeInfo() *ExchangeInfo {
+	return &ExchangeInfo{c: c, r: c.SetReq("/eapi/v1/exchangeInfo", http.MethodGet)}
+}
+
+// NewDepth Order book
+func (c *Client) NewDepth() *Depth {
+	return &Depth{c: c, r: c.SetReq("/eapi/v1/depth", http.MethodGet)}
+}
+
+// NewTrades Recent trades list
+func (c *Client) NewTrades() *Trades {
+	return &Trades{c: c, r: c.SetReq("/eapi/v1/trades", http.MethodGet)}
+}
+
+// NewKline Kline/Candlestick data
+func (c *Client) NewKline() *KlineData {
+	return &KlineData{c: c, r: c.SetReq("/eapi/v1/klines", http.MethodGet)}
+}
+
+// NewMarkPrice Option mark price and greek info
+func (c *Client) NewMarkPrice() *MarkPrice {
+	return &MarkPrice{c: c, r: c.SetReq("/eapi/v1/mark", http.MethodGet)}
+}
+
+// NewTicker24hr 24hr Ticker Price Change Statistics
+func (c *Client) NewTicker24hr() *Ticker24hr {
+	return &Ticker24hr{c: c, r: c.SetReq("/eapi/v1/ticker", http.MethodGet)}
+}
+
+// NewIndexPrice Symbol Price Ticker of the underlying
+func (c *Client) NewIndexPrice() *IndexPrice {
+	return &IndexPrice{c: c, r: c.SetReq("/eapi/v1/index", http.MethodGet)}
+}
+
+// NewExerciseHistory Historical Exercise Records
+func (c *Client) NewExerciseHistory() *ExerciseHistory {
+	return &ExerciseHistory{c: c, r: c.SetReq("/eapi/v1/exerciseHistory", http.MethodGet)}
+}
+
+// NewOpenInterest Open Interest
+func (c *Client) NewOpenInterest() *OpenInterest {
+	return &OpenInterest{c: c, r: c.SetReq("/eapi/v1/openInterest", http.MethodGet)}
+}
+
+// NewCreateOrder New Order (TRADE)
+func (c *Client) NewCreateOrder() *CreateOrder {
+	return &CreateOrder{c: c, r: c.SetReq("/eapi/v1/order", http.MethodPost, core.AuthSigned)}
+}
+
+// NewPlaceBatchOrder Place Multiple Orders (TRADE)
+func (c *Client) NewPlaceBatchOrder() *PlaceBatchOrder {
+	return &PlaceBatchOrder{c: c, r: c.SetReq("/eapi/v1/batchOrders", http.MethodPost, core.AuthSigned)}
+}
+
+// NewQueryOrder Query Single Order (TRADE)
+func (c *Client) NewQueryOrder() *QueryOrder {
+	return &QueryOrder{c: c, r: c.SetReq("/eapi/v1/order", http.MethodGet, core.AuthSigned)}
+}
+
+// NewCancelOrder Cancel Option Order (TRADE)
+func (c *Client) NewCancelOrder() *CancelOrder {
+	return &CancelOrder{c: c, r: c.SetReq("/eapi/v1/order", http.MethodDelete, core.AuthSigned)}
+}
+
+// NewCancelMultipleOrder Cancel Multiple Option Orders (TRADE)
+func (c *Client) NewCancelMultipleOrder() *CancelMultipleOrder {
+	return &CancelMultipleOrder{c: c, r: c.SetReq("/eapi/v1/batchOrders", http.MethodDelete, core.AuthSigned)}
+}
+
+// NewCancelOpenOrder Cancel all Option orders on specific symbol (TRADE)
+func (c *Client) NewCancelOpenOrder() *CancelOpenOrder {
+	return &CancelOpenOrder{c: c, r: c.SetReq("/eapi/v1/allOpenOrders", http.MethodDelete, core.AuthSigned)}
+}
+
+// NewCancelUnderlyingOrder Cancel All Option Orders By Underlying (TRADE)
+func (c *Client) NewCancelUnderlyingOrder() *CancelUnderlyingOrder {
+	return &CancelUnderlyingOrder{c: c, r: c.SetReq("/eapi/v1/allOpenOrdersByUnderlying", http.MethodDelete, core.AuthSigned)}
+}
+
+// NewOpenOrder Query Current Open Option Orders (USER_DATA)
+func (c *Client) NewOpenOrder() *OpenOrder {
+	return &OpenOrder{c: c, r: c.SetReq("/eapi/v1/openOrders", http.MethodGet, core.AuthSigned)}
+}
+
+// NewHistoryOrder Query Option Order History (TRADE)
+func (c *Client) NewHistoryOrder() *HistoryOrder {
+	return &HistoryOrder{c: c, r: c.SetReq("/eapi/v1/historyOrders", http.MethodGet, core.AuthSigned)}
+}
+
+// NewPosition Option Position Information (USER_DATA)
+func (c *Client) NewPosition() *Position {
+	return &Position{c: c, r: c.SetReq("/eapi/v1/position", http.MethodGet, core.AuthSigned)}
+}
+
+// NewUserTrades Account Trade List (USER_DATA)
+func (c *Client) NewUserTrades() *UserTrades {
+	return &UserTrades{c: c, r: c.SetReq("/eapi/v1/userTrades", http.MethodGet, core.AuthSigned)}
+}
+
+// NewExerciseRecord User Exercise Record (USER_DATA)
+func (c *Client) NewExerciseRecord() *ExerciseRecord {
+	return &ExerciseRecord{c: c, r: c.SetReq("/eapi/v1/exerciseRecord", http.MethodGet, core.AuthSigned)}
+}
+
+// NewAccountInfo Option Margin Account Information (USER_DATA)
+func (c *Client) NewAccountInfo() *AccountInfo {
+	return &AccountInfo{c: c, r: c.SetReq("/eapi/v1/marginAccount", http.MethodGet, core.AuthSigned)}
+}
+
+// NewGetListenKey Start User Data Stream (USER_STREAM)
+func (c *Client) NewGetListenKey() *GetListenKey {
+	return &GetListenKey{c: c, r: c.SetReq("/eapi/v1/listenKey", http.MethodPost, core.AuthApiKey)}
+}
+
+// NewKeepaliveListenKey Keepalive User Data Stream (USER_STREAM)
+func (c *Client) NewKeepaliveListenKey() *KeepaliveListenKey {
+	return &KeepaliveListenKey{c: c, r: c.SetReq("/eapi/v1/listenKey", http.MethodPut, core.AuthApiKey)}
+}
+
+// NewCloseListenKey Close User Data Stream (USER_STREAM)
+func (c *Client) NewCloseListenKey() *CloseListenKey {
+	return &CloseListenKey{c: c, r: c.SetReq("/eapi/v1/listenKey", http.MethodDelete, core.AuthApiKey)}
+}
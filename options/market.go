@@ -0,0 +1,422 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/shopspring/decimal"
+)
+
+// Ping Test connectivity to the Rest API.
+type Ping struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *Ping) Do(ctx context.Context) error {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+type ServerTime struct {
+	c *Client
+	r *core.Request
+}
+
+type ServerTimeResponse struct {
+	ServerTime int64 `json:"serverTime"`
+}
+
+func (s *ServerTime) Do(ctx context.Context) (*ServerTimeResponse, error) {
+	resp := new(ServerTimeResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// ExchangeInfo Current exchange trading rules and symbol information
+type ExchangeInfo struct {
+	c *Client
+	r *core.Request
+}
+
+type RateLimit struct {
+	RateLimitType string `json:"rateLimitType"`
+	Interval      string `json:"interval"`
+	IntervalNum   int    `json:"intervalNum"`
+	Limit         int    `json:"limit"`
+}
+
+type OptionContract struct {
+	Id          int64  `json:"id"`
+	BaseAsset   string `json:"baseAsset"`
+	QuoteAsset  string `json:"quoteAsset"`
+	Underlying  string `json:"underlying"`
+	SettleAsset string `json:"settleAsset"`
+}
+
+type OptionAsset struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type SymbolFilter struct {
+	FilterType string          `json:"filterType"`
+	MinPrice   decimal.Decimal `json:"minPrice"`
+	MaxPrice   decimal.Decimal `json:"maxPrice"`
+	TickSize   decimal.Decimal `json:"tickSize"`
+	MinQty     decimal.Decimal `json:"minQty"`
+	MaxQty     decimal.Decimal `json:"maxQty"`
+	StepSize   decimal.Decimal `json:"stepSize"`
+}
+
+type SymbolInfo struct {
+	ContractId           int64           `json:"contractId"`
+	ExpiryDate           int64           `json:"expiryDate"`
+	Filters              []*SymbolFilter `json:"filters"`
+	Id                   int64           `json:"id"`
+	Symbol               string          `json:"symbol"`
+	Side                 string          `json:"side"` // CALL or PUT
+	StrikePrice          decimal.Decimal `json:"strikePrice"`
+	Underlying           string          `json:"underlying"`
+	Unit                 int             `json:"unit"`
+	MakerFeeRate         decimal.Decimal `json:"makerFeeRate"`
+	TakerFeeRate         decimal.Decimal `json:"takerFeeRate"`
+	MinQty               decimal.Decimal `json:"minQty"`
+	MaxQty               decimal.Decimal `json:"maxQty"`
+	InitialMargin        decimal.Decimal `json:"initialMargin"`
+	MaintenanceMargin    decimal.Decimal `json:"maintenanceMargin"`
+	MinInitialMargin     decimal.Decimal `json:"minInitialMargin"`
+	MinMaintenanceMargin decimal.Decimal `json:"minMaintenanceMargin"`
+	PriceScale           int             `json:"priceScale"`
+	QuantityScale        int             `json:"quantityScale"`
+	QuoteAsset           string          `json:"quoteAsset"`
+}
+
+type ExchangeInfoResponse struct {
+	Timezone        string            `json:"timezone"`
+	ServerTime      int64             `json:"serverTime"`
+	OptionContracts []*OptionContract `json:"optionContracts"`
+	OptionAssets    []*OptionAsset    `json:"optionAssets"`
+	OptionSymbols   []*SymbolInfo     `json:"optionSymbols"`
+	RateLimits      []*RateLimit      `json:"rateLimits"`
+}
+
+// Symbol Info of symbol, nil when it is not listed.
+func (e *ExchangeInfoResponse) Symbol(symbol string) *SymbolInfo {
+	for _, info := range e.OptionSymbols {
+		if info.Symbol == symbol {
+			return info
+		}
+	}
+	return nil
+}
+
+func (s *ExchangeInfo) Do(ctx context.Context) (*ExchangeInfoResponse, error) {
+	resp := new(ExchangeInfoResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// Depth Get depth of a market
+type Depth struct {
+	c *Client
+	r *core.Request
+}
+
+type DepthResponse struct {
+	TransactionTime int64               `json:"T"`
+	UpdateId        int64               `json:"u"`
+	Bids            [][]decimal.Decimal `json:"bids"` // first is PRICE, second is QTY
+	Asks            [][]decimal.Decimal `json:"asks"`
+}
+
+func (s *Depth) Symbol(symbol string) *Depth {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// Limit Default 100; Valid limits:[10, 20, 50, 100, 500, 1000]
+func (s *Depth) Limit(limit int) *Depth {
+	s.r.Set("limit", limit)
+	return s
+}
+
+func (s *Depth) Do(ctx context.Context) (*DepthResponse, error) {
+	resp := new(DepthResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// Trades Get recent block trades
+type Trades struct {
+	c *Client
+	r *core.Request
+}
+
+type TradesResponse struct {
+	Id       int64           `json:"id"`
+	TradeId  int64           `json:"tradeId"`
+	Symbol   string          `json:"symbol"`
+	Price    decimal.Decimal `json:"price"`
+	Qty      decimal.Decimal `json:"qty"`
+	QuoteQty decimal.Decimal `json:"quoteQty"`
+	Side     int             `json:"side"` // 1 buy, -1 sell
+	Time     int64           `json:"time"`
+}
+
+func (s *Trades) Symbol(symbol string) *Trades {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// Limit Default 100; max 500.
+func (s *Trades) Limit(limit int) *Trades {
+	s.r.Set("limit", limit)
+	return s
+}
+
+func (s *Trades) Do(ctx context.Context) ([]*TradesResponse, error) {
+	resp := make([]*TradesResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// KlineData Kline/candlestick bars for an option symbol.
+type KlineData struct {
+	c *Client
+	r *core.Request
+}
+
+type KlineDataResponse struct {
+	Open        decimal.Decimal `json:"open"`
+	High        decimal.Decimal `json:"high"`
+	Low         decimal.Decimal `json:"low"`
+	Close       decimal.Decimal `json:"close"`
+	Volume      decimal.Decimal `json:"volume"`
+	Amount      decimal.Decimal `json:"amount"`
+	Interval    string          `json:"interval"`
+	TradeCount  int             `json:"tradeCount"`
+	TakerVolume decimal.Decimal `json:"takerVolume"`
+	TakerAmount decimal.Decimal `json:"takerAmount"`
+	OpenTime    int64           `json:"openTime"`
+	CloseTime   int64           `json:"closeTime"`
+}
+
+func (s *KlineData) Symbol(symbol string) *KlineData {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *KlineData) Interval(interval core.IntervalEnum) *KlineData {
+	s.r.Set("interval", interval)
+	return s
+}
+
+func (s *KlineData) StartTime(startTime int64) *KlineData {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *KlineData) EndTime(endTime int64) *KlineData {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Limit Default 500; max 1500.
+func (s *KlineData) Limit(limit int) *KlineData {
+	s.r.Set("limit", limit)
+	return s
+}
+
+func (s *KlineData) Do(ctx context.Context) ([]*KlineDataResponse, error) {
+	resp := make([]*KlineDataResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// MarkPrice Option mark price, implied volatility and greeks. All symbols are returned when symbol is omitted.
+type MarkPrice struct {
+	c *Client
+	r *core.Request
+}
+
+type MarkPriceResponse struct {
+	Symbol           string          `json:"symbol"`
+	MarkPrice        decimal.Decimal `json:"markPrice"`
+	BidIV            decimal.Decimal `json:"bidIV"`
+	AskIV            decimal.Decimal `json:"askIV"`
+	MarkIV           decimal.Decimal `json:"markIV"`
+	Delta            decimal.Decimal `json:"delta"`
+	Theta            decimal.Decimal `json:"theta"`
+	Gamma            decimal.Decimal `json:"gamma"`
+	Vega             decimal.Decimal `json:"vega"`
+	HighPriceLimit   decimal.Decimal `json:"highPriceLimit"`
+	LowPriceLimit    decimal.Decimal `json:"lowPriceLimit"`
+	RiskFreeInterest decimal.Decimal `json:"riskFreeInterest"`
+}
+
+func (s *MarkPrice) Symbol(symbol string) *MarkPrice {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *MarkPrice) Do(ctx context.Context) ([]*MarkPriceResponse, error) {
+	resp := make([]*MarkPriceResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// Ticker24hr 24 hour rolling window price change statistics.
+type Ticker24hr struct {
+	c *Client
+	r *core.Request
+}
+
+type TickerStatisticsResponse struct {
+	Symbol             string          `json:"symbol"`
+	PriceChange        decimal.Decimal `json:"priceChange"`
+	PriceChangePercent decimal.Decimal `json:"priceChangePercent"`
+	LastPrice          decimal.Decimal `json:"lastPrice"`
+	LastQty            decimal.Decimal `json:"lastQty"`
+	Open               decimal.Decimal `json:"open"`
+	High               decimal.Decimal `json:"high"`
+	Low                decimal.Decimal `json:"low"`
+	Volume             decimal.Decimal `json:"volume"`
+	Amount             decimal.Decimal `json:"amount"`
+	BidPrice           decimal.Decimal `json:"bidPrice"`
+	AskPrice           decimal.Decimal `json:"askPrice"`
+	OpenTime           int64           `json:"openTime"`
+	CloseTime          int64           `json:"closeTime"`
+	FirstTradeId       int64           `json:"firstTradeId"`
+	TradeCount         int             `json:"tradeCount"`
+	StrikePrice        decimal.Decimal `json:"strikePrice"`
+	ExercisePrice      decimal.Decimal `json:"exercisePrice"`
+}
+
+func (s *Ticker24hr) Symbol(symbol string) *Ticker24hr {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *Ticker24hr) Do(ctx context.Context) ([]*TickerStatisticsResponse, error) {
+	resp := make([]*TickerStatisticsResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// IndexPrice Get spot index price for option underlying.
+type IndexPrice struct {
+	c *Client
+	r *core.Request
+}
+
+type IndexPriceResponse struct {
+	Time       int64           `json:"time"`
+	IndexPrice decimal.Decimal `json:"indexPrice"`
+}
+
+// Underlying Option underlying, e.g BTCUSDT
+func (s *IndexPrice) Underlying(underlying string) *IndexPrice {
+	s.r.Set("underlying", underlying)
+	return s
+}
+
+func (s *IndexPrice) Do(ctx context.Context) (*IndexPriceResponse, error) {
+	resp := new(IndexPriceResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// ExerciseHistory Get historical exercise records.
+type ExerciseHistory struct {
+	c *Client
+	r *core.Request
+}
+
+type ExerciseHistoryResponse struct {
+	Symbol          string          `json:"symbol"`
+	StrikePrice     decimal.Decimal `json:"strikePrice"`
+	RealStrikePrice decimal.Decimal `json:"realStrikePrice"`
+	ExpiryDate      int64           `json:"expiryDate"`
+	StrikeResult    string          `json:"strikeResult"` // REALISTIC_VALUE_STRICKEN or EXTRINSIC_VALUE_EXPIRED
+}
+
+func (s *ExerciseHistory) Underlying(underlying string) *ExerciseHistory {
+	s.r.Set("underlying", underlying)
+	return s
+}
+
+func (s *ExerciseHistory) StartTime(startTime int64) *ExerciseHistory {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *ExerciseHistory) EndTime(endTime int64) *ExerciseHistory {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Limit Default 100; max 100.
+func (s *ExerciseHistory) Limit(limit int) *ExerciseHistory {
+	s.r.Set("limit", limit)
+	return s
+}
+
+func (s *ExerciseHistory) Do(ctx context.Context) ([]*ExerciseHistoryResponse, error) {
+	resp := make([]*ExerciseHistoryResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// OpenInterest Get open interest for specific underlying asset on specific expiration date.
+type OpenInterest struct {
+	c *Client
+	r *core.Request
+}
+
+type OpenInterestResponse struct {
+	Symbol             string          `json:"symbol"`
+	SumOpenInterest    decimal.Decimal `json:"sumOpenInterest"`
+	SumOpenInterestUsd decimal.Decimal `json:"sumOpenInterestUsd"`
+	Timestamp          string          `json:"timestamp"`
+}
+
+// UnderlyingAsset Underlying asset, e.g ETH/BTC
+func (s *OpenInterest) UnderlyingAsset(underlyingAsset string) *OpenInterest {
+	s.r.Set("underlyingAsset", underlyingAsset)
+	return s
+}
+
+// Expiration Expiration date, e.g 221225
+func (s *OpenInterest) Expiration(expiration string) *OpenInterest {
+	s.r.Set("expiration", expiration)
+	return s
+}
+
+func (s *OpenInterest) Do(ctx context.Context) ([]*OpenInterestResponse, error) {
+	resp := make([]*OpenInterestResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
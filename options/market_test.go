@@ -0,0 +1,150 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type marketTestSuite struct {
+	baseHttpTestSuite
+}
+
+func TestMarket(t *testing.T) {
+	suite.Run(t, new(marketTestSuite))
+}
+
+func (s *marketTestSuite) TestExchangeInfo() {
+	msg := []byte(`{
+	  "timezone": "UTC",
+	  "serverTime": 1592387337630,
+	  "optionContracts": [
+		{"baseAsset": "BTC", "quoteAsset": "USDT", "underlying": "BTCUSDT", "settleAsset": "USDT"}
+	  ],
+	  "optionAssets": [
+		{"name": "USDT"}
+	  ],
+	  "optionSymbols": [
+		{
+		  "expiryDate": 1660521600000,
+		  "filters": [
+			{"filterType": "PRICE_FILTER", "minPrice": "0.02", "maxPrice": "80000.01", "tickSize": "0.01"},
+			{"filterType": "LOT_SIZE", "minQty": "0.01", "maxQty": "100", "stepSize": "0.01"}
+		  ],
+		  "symbol": "BTC-220815-50000-C",
+		  "side": "CALL",
+		  "strikePrice": "50000",
+		  "underlying": "BTCUSDT",
+		  "unit": 1,
+		  "makerFeeRate": "0.0002",
+		  "takerFeeRate": "0.0002",
+		  "minQty": "0.01",
+		  "maxQty": "100",
+		  "initialMargin": "0.15",
+		  "maintenanceMargin": "0.075",
+		  "minInitialMargin": "0.1",
+		  "minMaintenanceMargin": "0.05",
+		  "priceScale": 2,
+		  "quantityScale": 2,
+		  "quoteAsset": "USDT"
+		}
+	  ],
+	  "rateLimits": [
+		{"rateLimitType": "REQUEST_WEIGHT", "interval": "MINUTE", "intervalNum": 1, "limit": 2400}
+	  ]
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewExchangeInfo().Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *ExchangeInfoResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(testResp.ServerTime, resp.ServerTime, "ServerTime")
+	r.Equal(*testResp.OptionContracts[0], *resp.OptionContracts[0])
+	r.Equal(*testResp.RateLimits[0], *resp.RateLimits[0])
+	r.Nil(resp.Symbol("BTC-220815-50000-P"))
+	info := resp.Symbol("BTC-220815-50000-C")
+	r.NotNil(info)
+	r.Equal("CALL", info.Side, "Side")
+	r.Equal("50000", info.StrikePrice.String(), "StrikePrice")
+	r.Equal(*testResp.OptionSymbols[0].Filters[1], *info.Filters[1])
+}
+
+func (s *marketTestSuite) TestMarkPrice() {
+	msg := []byte(`[
+	  {
+		"symbol": "BTC-200730-9000-C",
+		"markPrice": "1343.2883",
+		"bidIV": "1.40000077",
+		"askIV": "1.50000153",
+		"markIV": "1.45000000",
+		"delta": "0.55937056",
+		"theta": "3739.82509871",
+		"gamma": "0.00010969",
+		"vega": "978.58874732",
+		"highPriceLimit": "1618.241",
+		"lowPriceLimit": "1068.3356",
+		"riskFreeInterest": "0.1"
+	  }
+	]`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewMarkPrice().Symbol("BTC-200730-9000-C").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp []*MarkPriceResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Len(resp, 1)
+	r.Equal(*testResp[0], *resp[0])
+}
+
+func (s *marketTestSuite) TestKline() {
+	msg := []byte(`[
+	  {
+		"open": "950",
+		"high": "1100",
+		"low": "950",
+		"close": "1100",
+		"volume": "1.7",
+		"amount": "1616.85",
+		"interval": "5m",
+		"tradeCount": 4,
+		"takerVolume": "0.5",
+		"takerAmount": "550",
+		"openTime": 1592548200000,
+		"closeTime": 1592548499999
+	  }
+	]`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewKline().Symbol("BTC-200730-9000-C").Interval(core.Interval5m).Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp []*KlineDataResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Len(resp, 1)
+	r.Equal(*testResp[0], *resp[0])
+}
+
+func (s *marketTestSuite) TestOpenInterest() {
+	msg := []byte(`[
+	  {
+		"symbol": "ETH-221119-1175-P",
+		"sumOpenInterest": "4.01",
+		"sumOpenInterestUsd": "4880.2985615624",
+		"timestamp": "1668754020000"
+	  }
+	]`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewOpenInterest().UnderlyingAsset("ETH").Expiration("221119").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp []*OpenInterestResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Len(resp, 1)
+	r.Equal(*testResp[0], *resp[0])
+}
@@ -0,0 +1,43 @@
+package options
+
+import (
+	"github.com/jekaxv/go-binance/core"
+)
+
+// StreamDescriptors Builds descriptors of the options market and user data streams, use it through Streams.
+// Option stream names are case-sensitive, symbols are sent as given.
+type StreamDescriptors struct{}
+
+// Streams Describes options streams, e.g. options.Streams.Ticker("BTC-250328-90000-C").
+// Subscribe a descriptor with core.Subscribe or mix several into one connection with core.SubscribeCombined.
+var Streams StreamDescriptors
+
+// Trade Stream Name: <symbol>@trade OR <underlyingAsset>@trade, e.g. BTC-250328-90000-C@trade, BTC@trade
+func (StreamDescriptors) Trade(symbol string) core.StreamDescriptor[*TradeEvent] {
+	return core.NewStreamDescriptor[*TradeEvent](symbol + "@trade")
+}
+
+// Ticker Stream Name: <symbol>@ticker, e.g. BTC-250328-90000-C@ticker
+func (StreamDescriptors) Ticker(symbol string) core.StreamDescriptor[*TickerEvent] {
+	return core.NewStreamDescriptor[*TickerEvent](symbol + "@ticker")
+}
+
+// ExpirationTicker Stream Name: <underlyingAsset>@ticker@<expirationDate>, e.g. ETH@ticker@250328
+func (StreamDescriptors) ExpirationTicker(underlyingAsset, expirationDate string) core.StreamDescriptor[[]*TickerEvent] {
+	return core.NewStreamDescriptor[[]*TickerEvent](underlyingAsset + "@ticker@" + expirationDate)
+}
+
+// Index Stream Name: <underlying>@index, e.g. ETHUSDT@index
+func (StreamDescriptors) Index(underlying string) core.StreamDescriptor[*IndexEvent] {
+	return core.NewStreamDescriptor[*IndexEvent](underlying + "@index")
+}
+
+// MarkPrice Stream Name: <underlyingAsset>@markPrice, e.g. ETH@markPrice
+func (StreamDescriptors) MarkPrice(underlyingAsset string) core.StreamDescriptor[[]*MarkPriceEvent] {
+	return core.NewStreamDescriptor[[]*MarkPriceEvent](underlyingAsset + "@markPrice")
+}
+
+// UserData Stream Name: <listenKey>
+func (StreamDescriptors) UserData(listenKey string) core.StreamDescriptor[*UserDataEvent] {
+	return core.NewStreamDescriptorFunc(listenKey, parseUserEvent)
+}
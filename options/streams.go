@@ -0,0 +1,125 @@
+package options
+
+import (
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/shopspring/decimal"
+)
+
+// WebsocketStreams Market streams of nbstream.binance.com/eoptions. Option stream names are case-sensitive
+// and use upper case symbols, e.g. BTC-250328-90000-C@ticker, so names are sent as given.
+type WebsocketStreams struct {
+	c *WsClient
+}
+
+// TradeService The Trade Streams push raw trade information for an option symbol or for every symbol of an underlying asset.
+type TradeService struct {
+	*core.Stream[*TradeEvent]
+}
+
+type TradeEvent struct {
+	Event       string          `json:"e"`
+	Time        int64           `json:"E"`
+	Symbol      string          `json:"s"`
+	TradeId     json.Number     `json:"t"`
+	Price       decimal.Decimal `json:"p"`
+	Quantity    decimal.Decimal `json:"q"`
+	BuyOrderId  int64           `json:"b"`
+	SellOrderId int64           `json:"a"`
+	TradeTime   int64           `json:"T"`
+	Direction   json.Number     `json:"S"` // -1 sell, 1 buy
+	TradeType   string          `json:"X"`
+}
+
+// SubscribeTrade Stream Name: <symbol>@trade OR <underlyingAsset>@trade, e.g. BTC-250328-90000-C@trade, BTC@trade
+func (s *WebsocketStreams) SubscribeTrade(symbol string) *TradeService {
+	return &TradeService{core.NewRawStream(s.c.WsClient, Streams.Trade(symbol))}
+}
+
+// TickerService 24hr ticker info of an option symbol pushed every 1000ms.
+type TickerService struct {
+	*core.Stream[*TickerEvent]
+}
+
+type TickerEvent struct {
+	Event              string          `json:"e"`
+	Time               int64           `json:"E"`
+	TransactionTime    int64           `json:"T"`
+	Symbol             string          `json:"s"`
+	OpenPrice          decimal.Decimal `json:"o"`
+	HighPrice          decimal.Decimal `json:"h"`
+	LowPrice           decimal.Decimal `json:"l"`
+	LastPrice          decimal.Decimal `json:"c"`
+	Volume             decimal.Decimal `json:"V"`
+	Amount             decimal.Decimal `json:"A"`
+	PriceChangePercent decimal.Decimal `json:"P"`
+	PriceChange        decimal.Decimal `json:"p"`
+	LastQty            decimal.Decimal `json:"Q"`
+	FirstTradeId       json.Number     `json:"F"`
+	LastTradeId        json.Number     `json:"L"`
+	TradeCount         int             `json:"n"`
+	BestBidPrice       decimal.Decimal `json:"bo"`
+	BestAskPrice       decimal.Decimal `json:"ao"`
+	BestBidQty         decimal.Decimal `json:"bq"`
+	BestAskQty         decimal.Decimal `json:"aq"`
+	BuyImpliedVol      decimal.Decimal `json:"b"`
+	SellImpliedVol     decimal.Decimal `json:"a"`
+	Delta              decimal.Decimal `json:"d"`
+	Theta              decimal.Decimal `json:"t"`
+	Gamma              decimal.Decimal `json:"g"`
+	Vega               decimal.Decimal `json:"v"`
+	ImpliedVol         decimal.Decimal `json:"vo"`
+	MarkPrice          decimal.Decimal `json:"mp"`
+	BuyMaxPrice        decimal.Decimal `json:"hl"`
+	SellMinPrice       decimal.Decimal `json:"ll"`
+	ExercisePrice      decimal.Decimal `json:"eep"`
+}
+
+// SubscribeTicker Stream Name: <symbol>@ticker, e.g. BTC-250328-90000-C@ticker
+func (s *WebsocketStreams) SubscribeTicker(symbol string) *TickerService {
+	return &TickerService{core.NewRawStream(s.c.WsClient, Streams.Ticker(symbol))}
+}
+
+// ExpirationTickerService 24hr ticker info of every option symbol of an underlying asset with the given expiration date, pushed every 1000ms.
+type ExpirationTickerService struct {
+	*core.Stream[[]*TickerEvent]
+}
+
+// SubscribeExpirationTicker Stream Name: <underlyingAsset>@ticker@<expirationDate>, e.g. ETH@ticker@250328
+func (s *WebsocketStreams) SubscribeExpirationTicker(underlyingAsset, expirationDate string) *ExpirationTickerService {
+	return &ExpirationTickerService{core.NewRawStream(s.c.WsClient, Streams.ExpirationTicker(underlyingAsset, expirationDate))}
+}
+
+// IndexService Underlying (e.g. ETHUSDT) index stream pushed every 1000ms.
+type IndexService struct {
+	*core.Stream[*IndexEvent]
+}
+
+type IndexEvent struct {
+	Event  string          `json:"e"`
+	Time   int64           `json:"E"`
+	Symbol string          `json:"s"`
+	Price  decimal.Decimal `json:"p"`
+}
+
+// SubscribeIndex Stream Name: <underlying>@index, e.g. ETHUSDT@index
+func (s *WebsocketStreams) SubscribeIndex(underlying string) *IndexService {
+	return &IndexService{core.NewRawStream(s.c.WsClient, Streams.Index(underlying))}
+}
+
+// MarkPriceService The latest mark price of every option symbol of an underlying asset, pushed every 1000ms.
+type MarkPriceService struct {
+	*core.Stream[[]*MarkPriceEvent]
+}
+
+type MarkPriceEvent struct {
+	Event     string          `json:"e"`
+	Time      int64           `json:"E"`
+	Symbol    string          `json:"s"`
+	MarkPrice decimal.Decimal `json:"mp"`
+}
+
+// SubscribeMarkPrice Stream Name: <underlyingAsset>@markPrice, e.g. ETH@markPrice
+func (s *WebsocketStreams) SubscribeMarkPrice(underlyingAsset string) *MarkPriceService {
+	return &MarkPriceService{core.NewRawStream(s.c.WsClient, Streams.MarkPrice(underlyingAsset))}
+}
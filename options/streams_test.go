@@ -0,0 +1,123 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type websocketStreamsTestSuite struct {
+	baseWsTestSuite
+}
+
+func TestWebsocketStreams(t *testing.T) {
+	suite.Run(t, new(websocketStreamsTestSuite))
+}
+
+func (s *websocketStreamsTestSuite) TestWebSocketSubscribeTrade() {
+	msg := []byte(`{"e":"trade","E":1591677941092,"s":"BTC-200630-9000-P","t":"315","p":"4.000","q":"-0.99","b":4611781675939004417,"a":4611781675939004418,"T":1591677567872,"S":"-1","X":"TRADE"}`)
+	server := s.setup(msg)
+	defer server.Close()
+	onMessage, onError := s.client.NewWebsocketStreams().SubscribeTrade("BTC-200630-9000-P").Do(context.Background())
+	r := s.r()
+	var testResp *TradeEvent
+	r.Empty(json.Unmarshal(msg, &testResp))
+	for {
+		select {
+		case event := <-onMessage:
+			r.Equal(*testResp, *event)
+			r.Equal("315", event.TradeId.String(), "TradeId")
+			return
+		case err := <-onError:
+			s.Error(err)
+			return
+		}
+	}
+}
+
+func (s *websocketStreamsTestSuite) TestWebSocketSubscribeTicker() {
+	msg := []byte(`{"e":"24hrTicker","E":1657706425200,"T":1657706425220,"s":"BTC-220930-18000-C","o":"2000","h":"2020","l":"2000","c":"2020","V":"1.42","A":"2841.9","P":"0.01","p":"20","Q":"0.01","F":"27","L":"48","n":22,"bo":"2012","ao":"2020","bq":"4.9","aq":"0.03","b":"0.1202","a":"0.1318","d":"0.98911","t":"-0.16961","g":"0.00004","v":"2.66584","vo":"0.10001","mp":"2003.5102","hl":"2023.511","ll":"1983.5108","eep":"0"}`)
+	server := s.setup(msg)
+	defer server.Close()
+	onMessage, onError := s.client.NewWebsocketStreams().SubscribeTicker("BTC-220930-18000-C").Do(context.Background())
+	r := s.r()
+	var testResp *TickerEvent
+	r.Empty(json.Unmarshal(msg, &testResp))
+	for {
+		select {
+		case event := <-onMessage:
+			r.Equal(*testResp, *event)
+			r.Equal("0.98911", event.Delta.String(), "Delta")
+			return
+		case err := <-onError:
+			s.Error(err)
+			return
+		}
+	}
+}
+
+func (s *websocketStreamsTestSuite) TestWebSocketSubscribeMarkPrice() {
+	msg := []byte(`[{"e":"markPrice","E":1663684594227,"s":"ETH-220930-1500-C","mp":"30.3"},{"e":"markPrice","E":1663684594228,"s":"ETH-220930-1500-P","mp":"12.1"}]`)
+	server := s.setup(msg)
+	defer server.Close()
+	onMessage, onError := s.client.NewWebsocketStreams().SubscribeMarkPrice("ETH").Do(context.Background())
+	r := s.r()
+	var testResp []*MarkPriceEvent
+	r.Empty(json.Unmarshal(msg, &testResp))
+	for {
+		select {
+		case event := <-onMessage:
+			r.Len(event, 2)
+			r.Equal(*testResp[1], *event[1])
+			return
+		case err := <-onError:
+			s.Error(err)
+			return
+		}
+	}
+}
+
+func (s *websocketStreamsTestSuite) TestWebSocketSubscribeIndex() {
+	msg := []byte(`{"e":"index","E":1661415480351,"s":"ETHUSDT","p":"1707.89008607"}`)
+	server := s.setup(msg)
+	defer server.Close()
+	onMessage, onError := s.client.NewWebsocketStreams().SubscribeIndex("ETHUSDT").Do(context.Background())
+	r := s.r()
+	var testResp *IndexEvent
+	r.Empty(json.Unmarshal(msg, &testResp))
+	for {
+		select {
+		case event := <-onMessage:
+			r.Equal(*testResp, *event)
+			return
+		case err := <-onError:
+			s.Error(err)
+			return
+		}
+	}
+}
+
+func (s *websocketStreamsTestSuite) TestWebSocketSubscribeUserData() {
+	msg := []byte(`{"e":"ORDER_TRADE_UPDATE","E":1657613775883,"o":[{"T":1657613342918,"t":1657613342918,"s":"BTC-220930-18000-C","c":"","oid":"4611869636869226548","p":"1993","q":"1","stp":0,"r":false,"po":true,"S":"PARTIALLY_FILLED","e":"0.1","ec":"199.3","f":"2","tif":"GTC","oty":"LIMIT","fi":[{"t":"20","p":"1993","q":"0.1","T":1657613774336,"m":"TAKER","f":"0.0002"}]}]}`)
+	server := s.setup(msg)
+	defer server.Close()
+	onMessage, onError := s.client.NewWebsocketStreams().SubscribeUserData("listenKey").Do(context.Background())
+	r := s.r()
+	for {
+		select {
+		case event := <-onMessage:
+			r.Equal(ORDER_TRADE_UPDATE, string(event.Event), "Event")
+			r.Len(event.OrderTradeUpdate.Orders, 1)
+			order := event.OrderTradeUpdate.Orders[0]
+			r.Equal("4611869636869226548", order.OrderId.String(), "OrderId")
+			r.Equal("PARTIALLY_FILLED", order.Status, "Status")
+			r.Equal("0.1", order.ExecutedQty.String(), "ExecutedQty")
+			r.Equal("TAKER", order.Fills[0].Liquidity, "Liquidity")
+			return
+		case err := <-onError:
+			s.Error(err)
+			return
+		}
+	}
+}
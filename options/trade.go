@@ -0,0 +1,604 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/shopspring/decimal"
+	"strconv"
+	"strings"
+)
+
+const (
+	maxBatchOrders = 10
+	maxBatchCancel = 10
+)
+
+type OrderReq struct {
+	Symbol           string                     `json:"symbol,omitempty"`
+	Side             core.OrderSideEnum         `json:"side,omitempty"`
+	OrderType        core.OrderTypeEnum         `json:"type,omitempty"`
+	Quantity         string                     `json:"quantity,omitempty"`
+	Price            string                     `json:"price,omitempty"`
+	TimeInForce      core.TimeInForceEnum       `json:"timeInForce,omitempty"`
+	ReduceOnly       bool                       `json:"reduceOnly,omitempty"`
+	PostOnly         bool                       `json:"postOnly,omitempty"`
+	NewOrderRespType core.OrderResponseTypeEnum `json:"newOrderRespType,omitempty"`
+	ClientOrderId    string                     `json:"clientOrderId,omitempty"`
+	IsMmp            bool                       `json:"isMmp,omitempty"`
+}
+
+// CreateOrder Send a new order. Options only support LIMIT orders.
+// https://developers.binance.com/docs/derivatives/option/trade
+type CreateOrder struct {
+	c *Client
+	r *core.Request
+}
+
+type OrderResponse struct {
+	OrderId       int64           `json:"orderId"`
+	Symbol        string          `json:"symbol"`
+	Price         decimal.Decimal `json:"price"`
+	Quantity      decimal.Decimal `json:"quantity"`
+	ExecutedQty   decimal.Decimal `json:"executedQty"`
+	Fee           decimal.Decimal `json:"fee"`
+	Side          string          `json:"side"`
+	Type          string          `json:"type"`
+	TimeInForce   string          `json:"timeInForce"`
+	ReduceOnly    bool            `json:"reduceOnly"`
+	PostOnly      bool            `json:"postOnly"`
+	CreateTime    int64           `json:"createTime"`
+	UpdateTime    int64           `json:"updateTime"`
+	Status        string          `json:"status"`
+	AvgPrice      decimal.Decimal `json:"avgPrice"`
+	Source        string          `json:"source"`
+	ClientOrderId string          `json:"clientOrderId"`
+	PriceScale    int             `json:"priceScale"`
+	QuantityScale int             `json:"quantityScale"`
+	OptionSide    string          `json:"optionSide"`
+	QuoteAsset    string          `json:"quoteAsset"`
+	Mmp           bool            `json:"mmp"`
+}
+
+func (s *CreateOrder) Symbol(symbol string) *CreateOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *CreateOrder) Side(side core.OrderSideEnum) *CreateOrder {
+	s.r.Set("side", side)
+	return s
+}
+
+func (s *CreateOrder) Type(orderType core.OrderTypeEnum) *CreateOrder {
+	s.r.Set("type", orderType)
+	return s
+}
+
+func (s *CreateOrder) Quantity(quantity string) *CreateOrder {
+	s.r.Set("quantity", quantity)
+	return s
+}
+
+func (s *CreateOrder) Price(price string) *CreateOrder {
+	s.r.Set("price", price)
+	return s
+}
+
+func (s *CreateOrder) TimeInForce(timeInForce core.TimeInForceEnum) *CreateOrder {
+	s.r.Set("timeInForce", timeInForce)
+	return s
+}
+
+func (s *CreateOrder) ReduceOnly(reduceOnly bool) *CreateOrder {
+	s.r.Set("reduceOnly", reduceOnly)
+	return s
+}
+
+func (s *CreateOrder) PostOnly(postOnly bool) *CreateOrder {
+	s.r.Set("postOnly", postOnly)
+	return s
+}
+
+// NewOrderRespType "ACK", "RESULT", Default "ACK"
+func (s *CreateOrder) NewOrderRespType(newOrderRespType core.OrderResponseTypeEnum) *CreateOrder {
+	s.r.Set("newOrderRespType", newOrderRespType)
+	return s
+}
+
+func (s *CreateOrder) ClientOrderId(clientOrderId string) *CreateOrder {
+	s.r.Set("clientOrderId", clientOrderId)
+	return s
+}
+
+// IsMmp is market maker protection order
+func (s *CreateOrder) IsMmp(isMmp bool) *CreateOrder {
+	s.r.Set("isMmp", isMmp)
+	return s
+}
+
+func (s *CreateOrder) RecvWindow(recvWindow int64) *CreateOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CreateOrder) Do(ctx context.Context) (*OrderResponse, error) {
+	resp := new(OrderResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// PlaceBatchOrder Send multiple option orders, at most 10 per request.
+type PlaceBatchOrder struct {
+	c      *Client
+	r      *core.Request
+	orders []OrderReq
+}
+
+type PlaceBatchOrderResponse struct {
+	OrderResponse
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+func (s *PlaceBatchOrder) Orders(orders []OrderReq) *PlaceBatchOrder {
+	s.orders = orders
+	return s
+}
+
+func (s *PlaceBatchOrder) RecvWindow(recvWindow int64) *PlaceBatchOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *PlaceBatchOrder) Do(ctx context.Context) ([]*PlaceBatchOrderResponse, error) {
+	if len(s.orders) == 0 || len(s.orders) > maxBatchOrders {
+		return nil, fmt.Errorf("orders must hold 1 to %d orders", maxBatchOrders)
+	}
+	orderJson, err := json.Marshal(s.orders)
+	if err != nil {
+		return nil, err
+	}
+	s.r.Set("orders", string(orderJson))
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := make([]*PlaceBatchOrderResponse, 0)
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// QueryOrder Check an order status.
+type QueryOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *QueryOrder) Symbol(symbol string) *QueryOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *QueryOrder) OrderId(orderId int64) *QueryOrder {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *QueryOrder) ClientOrderId(clientOrderId string) *QueryOrder {
+	s.r.Set("clientOrderId", clientOrderId)
+	return s
+}
+
+func (s *QueryOrder) RecvWindow(recvWindow int64) *QueryOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *QueryOrder) Do(ctx context.Context) (*OrderResponse, error) {
+	resp := new(OrderResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// CancelOrder Cancel an active order. Either orderId or clientOrderId must be sent.
+type CancelOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *CancelOrder) Symbol(symbol string) *CancelOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *CancelOrder) OrderId(orderId int64) *CancelOrder {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *CancelOrder) ClientOrderId(clientOrderId string) *CancelOrder {
+	s.r.Set("clientOrderId", clientOrderId)
+	return s
+}
+
+func (s *CancelOrder) RecvWindow(recvWindow int64) *CancelOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CancelOrder) Do(ctx context.Context) (*OrderResponse, error) {
+	resp := new(OrderResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// CancelMultipleOrder Cancel multiple orders, at most 10 per request.
+type CancelMultipleOrder struct {
+	c    *Client
+	r    *core.Request
+	size int
+}
+
+type CancelMultipleOrderResponse struct {
+	OrderResponse
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+func (s *CancelMultipleOrder) Symbol(symbol string) *CancelMultipleOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *CancelMultipleOrder) OrderIds(orderIds []int64) *CancelMultipleOrder {
+	orderList := make([]string, 0, len(orderIds))
+	for _, orderId := range orderIds {
+		orderList = append(orderList, strconv.FormatInt(orderId, 10))
+	}
+	s.r.Set("orderIds", "["+strings.Join(orderList, ",")+"]")
+	s.size = len(orderIds)
+	return s
+}
+
+func (s *CancelMultipleOrder) ClientOrderIds(clientOrderIds []string) *CancelMultipleOrder {
+	s.r.Set("clientOrderIds", clientOrderIds)
+	s.size = len(clientOrderIds)
+	return s
+}
+
+func (s *CancelMultipleOrder) RecvWindow(recvWindow int64) *CancelMultipleOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CancelMultipleOrder) Do(ctx context.Context) ([]*CancelMultipleOrderResponse, error) {
+	if s.r.GetQuery("orderIds") == "" && s.r.GetQuery("clientOrderIds") == "" {
+		return nil, errors.New("orderIds or clientOrderIds is required")
+	}
+	if s.size > maxBatchCancel {
+		return nil, fmt.Errorf("at most %d orders can be canceled in one batch", maxBatchCancel)
+	}
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := make([]*CancelMultipleOrderResponse, 0)
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// CancelOpenOrder Cancel all active orders on a symbol.
+type CancelOpenOrder struct {
+	c *Client
+	r *core.Request
+}
+
+type CancelOpenOrderResponse struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+func (s *CancelOpenOrder) Symbol(symbol string) *CancelOpenOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *CancelOpenOrder) RecvWindow(recvWindow int64) *CancelOpenOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CancelOpenOrder) Do(ctx context.Context) (*CancelOpenOrderResponse, error) {
+	resp := new(CancelOpenOrderResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// CancelUnderlyingOrder Cancel all active orders on specified underlying.
+type CancelUnderlyingOrder struct {
+	c *Client
+	r *core.Request
+}
+
+type CancelUnderlyingOrderResponse struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+	Data int    `json:"data"`
+}
+
+// Underlying Option underlying, e.g BTCUSDT
+func (s *CancelUnderlyingOrder) Underlying(underlying string) *CancelUnderlyingOrder {
+	s.r.Set("underlying", underlying)
+	return s
+}
+
+func (s *CancelUnderlyingOrder) RecvWindow(recvWindow int64) *CancelUnderlyingOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CancelUnderlyingOrder) Do(ctx context.Context) (*CancelUnderlyingOrderResponse, error) {
+	resp := new(CancelUnderlyingOrderResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// OpenOrder Query current all open orders, status: ACCEPTED PARTIALLY_FILLED
+type OpenOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *OpenOrder) Symbol(symbol string) *OpenOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// OrderId Returns the orderId and subsequent orders, the most recent order is returned by default
+func (s *OpenOrder) OrderId(orderId int64) *OpenOrder {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *OpenOrder) StartTime(startTime int64) *OpenOrder {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *OpenOrder) EndTime(endTime int64) *OpenOrder {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+func (s *OpenOrder) RecvWindow(recvWindow int64) *OpenOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *OpenOrder) Do(ctx context.Context) ([]*OrderResponse, error) {
+	resp := make([]*OrderResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// HistoryOrder Query all finished orders within 5 days, finished status: CANCELLED FILLED REJECTED.
+type HistoryOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *HistoryOrder) Symbol(symbol string) *HistoryOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *HistoryOrder) OrderId(orderId int64) *HistoryOrder {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *HistoryOrder) StartTime(startTime int64) *HistoryOrder {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *HistoryOrder) EndTime(endTime int64) *HistoryOrder {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Limit Default 100; max 1000.
+func (s *HistoryOrder) Limit(limit int) *HistoryOrder {
+	s.r.Set("limit", limit)
+	return s
+}
+
+func (s *HistoryOrder) RecvWindow(recvWindow int64) *HistoryOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *HistoryOrder) Do(ctx context.Context) ([]*OrderResponse, error) {
+	resp := make([]*OrderResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// Position Get current position information.
+type Position struct {
+	c *Client
+	r *core.Request
+}
+
+type PositionResponse struct {
+	EntryPrice    decimal.Decimal `json:"entryPrice"`
+	Symbol        string          `json:"symbol"`
+	Side          string          `json:"side"` // LONG or SHORT
+	Quantity      decimal.Decimal `json:"quantity"`
+	ReducibleQty  decimal.Decimal `json:"reducibleQty"`
+	MarkValue     decimal.Decimal `json:"markValue"`
+	Ror           decimal.Decimal `json:"ror"`
+	UnrealizedPNL decimal.Decimal `json:"unrealizedPNL"`
+	MarkPrice     decimal.Decimal `json:"markPrice"`
+	StrikePrice   decimal.Decimal `json:"strikePrice"`
+	PositionCost  decimal.Decimal `json:"positionCost"`
+	ExpiryDate    int64           `json:"expiryDate"`
+	PriceScale    int             `json:"priceScale"`
+	QuantityScale int             `json:"quantityScale"`
+	OptionSide    string          `json:"optionSide"`
+	QuoteAsset    string          `json:"quoteAsset"`
+}
+
+func (s *Position) Symbol(symbol string) *Position {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *Position) RecvWindow(recvWindow int64) *Position {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *Position) Do(ctx context.Context) ([]*PositionResponse, error) {
+	resp := make([]*PositionResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// UserTrades Get trades for a specific account and symbol.
+type UserTrades struct {
+	c *Client
+	r *core.Request
+}
+
+type UserTradesResponse struct {
+	Id             int64           `json:"id"`
+	TradeId        int64           `json:"tradeId"`
+	OrderId        int64           `json:"orderId"`
+	Symbol         string          `json:"symbol"`
+	Price          decimal.Decimal `json:"price"`
+	Quantity       decimal.Decimal `json:"quantity"`
+	Fee            decimal.Decimal `json:"fee"`
+	RealizedProfit decimal.Decimal `json:"realizedProfit"`
+	Side           string          `json:"side"`
+	Type           string          `json:"type"`
+	Volatility     decimal.Decimal `json:"volatility"`
+	Liquidity      string          `json:"liquidity"` // TAKER or MAKER
+	QuoteAsset     string          `json:"quoteAsset"`
+	Time           int64           `json:"time"`
+	PriceScale     int             `json:"priceScale"`
+	QuantityScale  int             `json:"quantityScale"`
+	OptionSide     string          `json:"optionSide"`
+}
+
+func (s *UserTrades) Symbol(symbol string) *UserTrades {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// FromId The trade ID to fetch from. Default gets most recent trades.
+func (s *UserTrades) FromId(fromId int64) *UserTrades {
+	s.r.Set("fromId", fromId)
+	return s
+}
+
+func (s *UserTrades) StartTime(startTime int64) *UserTrades {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *UserTrades) EndTime(endTime int64) *UserTrades {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Limit Default 100; max 1000.
+func (s *UserTrades) Limit(limit int) *UserTrades {
+	s.r.Set("limit", limit)
+	return s
+}
+
+func (s *UserTrades) RecvWindow(recvWindow int64) *UserTrades {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *UserTrades) Do(ctx context.Context) ([]*UserTradesResponse, error) {
+	resp := make([]*UserTradesResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// ExerciseRecord Get account exercise records.
+type ExerciseRecord struct {
+	c *Client
+	r *core.Request
+}
+
+type ExerciseRecordResponse struct {
+	Id            string          `json:"id"`
+	Currency      string          `json:"currency"`
+	Symbol        string          `json:"symbol"`
+	ExercisePrice decimal.Decimal `json:"exercisePrice"`
+	MarkPrice     decimal.Decimal `json:"markPrice"`
+	Quantity      decimal.Decimal `json:"quantity"`
+	Amount        decimal.Decimal `json:"amount"`
+	Fee           decimal.Decimal `json:"fee"`
+	CreateDate    int64           `json:"createDate"`
+	PriceScale    int             `json:"priceScale"`
+	QuantityScale int             `json:"quantityScale"`
+	OptionSide    string          `json:"optionSide"`
+	PositionSide  string          `json:"positionSide"`
+	QuoteAsset    string          `json:"quoteAsset"`
+}
+
+func (s *ExerciseRecord) Symbol(symbol string) *ExerciseRecord {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *ExerciseRecord) StartTime(startTime int64) *ExerciseRecord {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *ExerciseRecord) EndTime(endTime int64) *ExerciseRecord {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Limit Default 1000; max 1000.
+func (s *ExerciseRecord) Limit(limit int) *ExerciseRecord {
+	s.r.Set("limit", limit)
+	return s
+}
+
+func (s *ExerciseRecord) RecvWindow(recvWindow int64) *ExerciseRecord {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *ExerciseRecord) Do(ctx context.Context) ([]*ExerciseRecordResponse, error) {
+	resp := make([]*ExerciseRecordResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
@@ -0,0 +1,158 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type tradeTestSuite struct {
+	baseHttpTestSuite
+}
+
+func TestTrade(t *testing.T) {
+	suite.Run(t, new(tradeTestSuite))
+}
+
+func (s *tradeTestSuite) TestCreateOrder() {
+	msg := []byte(`{
+	  "orderId": 4611875134427365377,
+	  "symbol": "BTC-200730-9000-C",
+	  "price": "100",
+	  "quantity": "1",
+	  "executedQty": "0",
+	  "fee": "0",
+	  "side": "BUY",
+	  "type": "LIMIT",
+	  "timeInForce": "GTC",
+	  "reduceOnly": false,
+	  "postOnly": false,
+	  "createTime": 1592465880683,
+	  "updateTime": 1566818724722,
+	  "status": "ACCEPTED",
+	  "avgPrice": "0",
+	  "source": "API",
+	  "clientOrderId": "",
+	  "priceScale": 2,
+	  "quantityScale": 2,
+	  "optionSide": "CALL",
+	  "quoteAsset": "USDT",
+	  "mmp": false
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewCreateOrder().Symbol("BTC-200730-9000-C").Side(core.OrderSideBUY).
+		Type(core.OrderTypeLIMIT).Quantity("1").Price("100").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *OrderResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(*testResp, *resp)
+}
+
+func (s *tradeTestSuite) TestPlaceBatchOrder() {
+	msg := []byte(`[
+	  {
+		"orderId": 4612288550799409153,
+		"symbol": "ETH-220826-1800-C",
+		"price": "100",
+		"quantity": "0.01",
+		"side": "BUY",
+		"type": "LIMIT",
+		"reduceOnly": false,
+		"postOnly": false,
+		"clientOrderId": "1001",
+		"mmp": false
+	  },
+	  {"code": -4001, "msg": "Price out of range"}
+	]`)
+	server := s.setup(msg)
+	defer server.Close()
+	orders := []OrderReq{
+		{Symbol: "ETH-220826-1800-C", Side: core.OrderSideBUY, OrderType: core.OrderTypeLIMIT, Quantity: "0.01", Price: "100", ClientOrderId: "1001"},
+		{Symbol: "ETH-220826-1800-C", Side: core.OrderSideBUY, OrderType: core.OrderTypeLIMIT, Quantity: "0.01", Price: "1"},
+	}
+	resp, err := s.client.NewPlaceBatchOrder().Orders(orders).Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Len(resp, 2)
+	r.Equal(int64(4612288550799409153), resp[0].OrderId, "OrderId")
+	r.Equal("1001", resp[0].ClientOrderId, "ClientOrderId")
+	r.Equal(-4001, resp[1].Code, "Code")
+
+	_, err = s.client.NewPlaceBatchOrder().Orders(make([]OrderReq, maxBatchOrders+1)).Do(context.Background())
+	r.Error(err)
+}
+
+func (s *tradeTestSuite) TestCancelMultipleOrderLimit() {
+	r := s.r()
+	_, err := s.client.NewCancelMultipleOrder().Symbol("BTC-200730-9000-C").Do(context.Background())
+	r.Error(err)
+	_, err = s.client.NewCancelMultipleOrder().Symbol("BTC-200730-9000-C").
+		OrderIds([]int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}).Do(context.Background())
+	r.Error(err)
+}
+
+func (s *tradeTestSuite) TestPosition() {
+	msg := []byte(`[
+	  {
+		"entryPrice": "1000",
+		"symbol": "BTC-200730-9000-C",
+		"side": "SHORT",
+		"quantity": "-0.1",
+		"reducibleQty": "0",
+		"markValue": "105.00138",
+		"ror": "-0.05",
+		"unrealizedPNL": "-5.00138",
+		"markPrice": "1050.0138",
+		"strikePrice": "9000",
+		"positionCost": "1000.0000",
+		"expiryDate": 1593511200000,
+		"priceScale": 2,
+		"quantityScale": 2,
+		"optionSide": "CALL",
+		"quoteAsset": "USDT"
+	  }
+	]`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewPosition().Symbol("BTC-200730-9000-C").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp []*PositionResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Len(resp, 1)
+	r.Equal(*testResp[0], *resp[0])
+}
+
+func (s *tradeTestSuite) TestExerciseRecord() {
+	msg := []byte(`[
+	  {
+		"id": "1125899906842624000",
+		"currency": "USDT",
+		"symbol": "BTC-220721-25000-C",
+		"exercisePrice": "25000.00000000",
+		"markPrice": "25000.00000000",
+		"quantity": "1.00000000",
+		"amount": "0.00000000",
+		"fee": "0.00000000",
+		"createDate": 1658361600000,
+		"priceScale": 2,
+		"quantityScale": 2,
+		"optionSide": "CALL",
+		"positionSide": "LONG",
+		"quoteAsset": "USDT"
+	  }
+	]`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewExerciseRecord().Symbol("BTC-220721-25000-C").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp []*ExerciseRecordResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Len(resp, 1)
+	r.Equal(*testResp[0], *resp[0])
+}
@@ -0,0 +1,129 @@
+package options
+
+import (
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/shopspring/decimal"
+)
+
+type UserDataStream struct {
+	*core.Stream[*UserDataEvent]
+}
+
+type UserDataEventType string
+
+const (
+	listenKeyExpired   = "listenKeyExpired"
+	ACCOUNT_UPDATE     = "ACCOUNT_UPDATE"
+	ORDER_TRADE_UPDATE = "ORDER_TRADE_UPDATE"
+	RISK_LEVEL_CHANGE  = "RISK_LEVEL_CHANGE"
+)
+
+type UserDataEvent struct {
+	Event            UserDataEventType `json:"e"`
+	Time             int64             `json:"E"`
+	AccountUpdate    AccountUpdate
+	ListenExpired    ListenExpired
+	OrderTradeUpdate OrderTradeUpdate
+	RiskLevelChange  RiskLevelChange
+}
+
+type ListenExpired struct {
+	ListenKey string `json:"listenKey"`
+}
+
+type UpdateBalance struct {
+	Asset             string          `json:"a"`
+	Balance           decimal.Decimal `json:"b"`
+	PositionValue     decimal.Decimal `json:"m"`
+	UnrealizedPnL     decimal.Decimal `json:"u"`
+	MaintenanceMargin decimal.Decimal `json:"M"`
+	InitialMargin     decimal.Decimal `json:"i"`
+}
+
+type UpdateGreek struct {
+	Underlying string          `json:"ui"`
+	Delta      decimal.Decimal `json:"d"`
+	Theta      decimal.Decimal `json:"t"`
+	Gamma      decimal.Decimal `json:"g"`
+	Vega       decimal.Decimal `json:"v"`
+}
+
+type UpdatePosition struct {
+	Symbol        string          `json:"s"`
+	Quantity      decimal.Decimal `json:"c"`
+	ReducibleQty  decimal.Decimal `json:"r"`
+	PositionValue decimal.Decimal `json:"p"`
+	EntryPrice    decimal.Decimal `json:"a"`
+}
+
+// AccountUpdate Balance, greeks and positions of the account after any change.
+type AccountUpdate struct {
+	Balances  []*UpdateBalance  `json:"B"`
+	Greeks    []*UpdateGreek    `json:"G"`
+	Positions []*UpdatePosition `json:"P"`
+	Uid       int64             `json:"uid"`
+}
+
+type UpdateFill struct {
+	TradeId   json.Number     `json:"t"`
+	Price     decimal.Decimal `json:"p"`
+	Quantity  decimal.Decimal `json:"q"`
+	TradeTime int64           `json:"T"`
+	Liquidity string          `json:"m"` // TAKER or MAKER
+	Fee       decimal.Decimal `json:"f"`
+}
+
+type UpdateOrder struct {
+	CreateTime    int64           `json:"T"`
+	UpdateTime    int64           `json:"t"`
+	Symbol        string          `json:"s"`
+	ClientOrderId string          `json:"c"`
+	OrderId       json.Number     `json:"oid"`
+	Price         decimal.Decimal `json:"p"`
+	Quantity      decimal.Decimal `json:"q"` // negative for sell orders
+	Stp           int             `json:"stp"`
+	ReduceOnly    bool            `json:"r"`
+	PostOnly      bool            `json:"po"`
+	Status        string          `json:"S"`
+	ExecutedQty   decimal.Decimal `json:"e"`
+	ExecutedCost  decimal.Decimal `json:"ec"`
+	Fee           decimal.Decimal `json:"f"`
+	TimeInForce   string          `json:"tif"`
+	OrderType     string          `json:"oty"`
+	Fills         []*UpdateFill   `json:"fi"`
+}
+
+// OrderTradeUpdate Pushed when an order is created, changes status or is filled.
+type OrderTradeUpdate struct {
+	Orders []*UpdateOrder `json:"o"`
+}
+
+// RiskLevelChange Pushed when the margin ratio of the account crosses a risk level.
+type RiskLevelChange struct {
+	RiskLevel         string          `json:"s"` // NORMAL, REDUCE_ONLY
+	MarginBalance     decimal.Decimal `json:"mb"`
+	MaintenanceMargin decimal.Decimal `json:"mm"`
+}
+
+func (s *WebsocketStreams) SubscribeUserData(listenKey string) *UserDataStream {
+	return &UserDataStream{core.NewRawStream(s.c.WsClient, Streams.UserData(listenKey))}
+}
+
+func parseUserEvent(message []byte) (*UserDataEvent, error) {
+	var event *UserDataEvent
+	if err := json.Unmarshal(message, &event); err != nil {
+		return event, err
+	}
+	switch event.Event {
+	case listenKeyExpired:
+		return event, json.Unmarshal(message, &event.ListenExpired)
+	case ACCOUNT_UPDATE:
+		return event, json.Unmarshal(message, &event.AccountUpdate)
+	case ORDER_TRADE_UPDATE:
+		return event, json.Unmarshal(message, &event.OrderTradeUpdate)
+	case RISK_LEVEL_CHANGE:
+		return event, json.Unmarshal(message, &event.RiskLevelChange)
+	}
+	return event, nil
+}
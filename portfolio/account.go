@@ -0,0 +1,210 @@
+package portfolio
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/shopspring/decimal"
+)
+
+// AccountInfo Query Portfolio Margin account information.
+type AccountInfo struct {
+	c *Client
+	r *core.Request
+}
+
+type AccountInfoResponse struct {
+	UniMMR                   decimal.Decimal `json:"uniMMR"` // Portfolio margin account maintenance margin rate
+	AccountEquity            decimal.Decimal `json:"accountEquity"`
+	ActualEquity             decimal.Decimal `json:"actualEquity"`
+	AccountInitialMargin     decimal.Decimal `json:"accountInitialMargin"`
+	AccountMaintMargin       decimal.Decimal `json:"accountMaintMargin"`
+	AccountStatus            string          `json:"accountStatus"` // NORMAL, MARGIN_CALL, SUPPLY_MARGIN, REDUCE_ONLY, ACTIVE_LIQUIDATION, FORCE_LIQUIDATION, BANKRUPTED
+	VirtualMaxWithdrawAmount decimal.Decimal `json:"virtualMaxWithdrawAmount"`
+	TotalAvailableBalance    string          `json:"totalAvailableBalance"`
+	TotalMarginOpenLoss      string          `json:"totalMarginOpenLoss"`
+	UpdateTime               int64           `json:"updateTime"`
+}
+
+func (s *AccountInfo) RecvWindow(recvWindow int64) *AccountInfo {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *AccountInfo) Do(ctx context.Context) (*AccountInfoResponse, error) {
+	resp := new(AccountInfoResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// QueryBalance Query Portfolio Margin account balance across cross margin, UM and CM wallets.
+type QueryBalance struct {
+	c *Client
+	r *core.Request
+}
+
+type BalanceResponse struct {
+	Asset               string          `json:"asset"`
+	TotalWalletBalance  decimal.Decimal `json:"totalWalletBalance"`
+	CrossMarginAsset    decimal.Decimal `json:"crossMarginAsset"`
+	CrossMarginBorrowed decimal.Decimal `json:"crossMarginBorrowed"`
+	CrossMarginFree     decimal.Decimal `json:"crossMarginFree"`
+	CrossMarginInterest decimal.Decimal `json:"crossMarginInterest"`
+	CrossMarginLocked   decimal.Decimal `json:"crossMarginLocked"`
+	UmWalletBalance     decimal.Decimal `json:"umWalletBalance"`
+	UmUnrealizedPNL     decimal.Decimal `json:"umUnrealizedPNL"`
+	CmWalletBalance     decimal.Decimal `json:"cmWalletBalance"`
+	CmUnrealizedPNL     string          `json:"cmUnrealizedPNL"`
+	UpdateTime          int64           `json:"updateTime"`
+	NegativeBalance     decimal.Decimal `json:"negativeBalance"`
+}
+
+func (s *QueryBalance) Asset(asset string) *QueryBalance {
+	s.r.Set("asset", asset)
+	return s
+}
+
+func (s *QueryBalance) RecvWindow(recvWindow int64) *QueryBalance {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *QueryBalance) Do(ctx context.Context) ([]*BalanceResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := make([]*BalanceResponse, 0)
+	if s.r.GetQuery("asset") == "" {
+		return resp, json.Unmarshal(s.c.rawBody(), &resp)
+	}
+	res := new(BalanceResponse)
+	if err := json.Unmarshal(s.c.rawBody(), res); err != nil {
+		return nil, err
+	}
+	resp = append(resp, res)
+	return resp, nil
+}
+
+type TranIdResponse struct {
+	TranId int64 `json:"tranId"`
+}
+
+// MarginLoan Apply for a margin loan.
+type MarginLoan struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *MarginLoan) Asset(asset string) *MarginLoan {
+	s.r.Set("asset", asset)
+	return s
+}
+
+func (s *MarginLoan) Amount(amount string) *MarginLoan {
+	s.r.Set("amount", amount)
+	return s
+}
+
+func (s *MarginLoan) RecvWindow(recvWindow int64) *MarginLoan {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *MarginLoan) Do(ctx context.Context) (*TranIdResponse, error) {
+	resp := new(TranIdResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// RepayLoan Repay for a margin loan.
+type RepayLoan struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *RepayLoan) Asset(asset string) *RepayLoan {
+	s.r.Set("asset", asset)
+	return s
+}
+
+func (s *RepayLoan) Amount(amount string) *RepayLoan {
+	s.r.Set("amount", amount)
+	return s
+}
+
+func (s *RepayLoan) RecvWindow(recvWindow int64) *RepayLoan {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *RepayLoan) Do(ctx context.Context) (*TranIdResponse, error) {
+	resp := new(TranIdResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+type CollectionResponse struct {
+	Msg string `json:"msg"`
+}
+
+// AutoCollection Fund collection for Portfolio Margin, transfers all assets from the futures wallets to the margin wallet.
+type AutoCollection struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *AutoCollection) RecvWindow(recvWindow int64) *AutoCollection {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *AutoCollection) Do(ctx context.Context) (*CollectionResponse, error) {
+	resp := new(CollectionResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// AssetCollection Transfers a specific asset from the futures wallets to the margin wallet.
+type AssetCollection struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *AssetCollection) Asset(asset string) *AssetCollection {
+	s.r.Set("asset", asset)
+	return s
+}
+
+func (s *AssetCollection) RecvWindow(recvWindow int64) *AssetCollection {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *AssetCollection) Do(ctx context.Context) (*CollectionResponse, error) {
+	resp := new(CollectionResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// Ping Test connectivity to the Rest API.
+type Ping struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *Ping) Do(ctx context.Context) error {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return err
+	}
+	return nil
+}
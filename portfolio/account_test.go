@@ -0,0 +1,86 @@
+package portfolio
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type accountTestSuite struct {
+	baseHttpTestSuite
+}
+
+func TestAccount(t *testing.T) {
+	suite.Run(t, new(accountTestSuite))
+}
+
+func (s *accountTestSuite) TestAccountInfo() {
+	msg := []byte(`{
+	  "uniMMR": "5167.92171923",
+	  "accountEquity": "122607.35137903",
+	  "actualEquity": "73.47428058",
+	  "accountInitialMargin": "23.72469206",
+	  "accountMaintMargin": "23.72469206",
+	  "accountStatus": "NORMAL",
+	  "virtualMaxWithdrawAmount": "1627523.32459208",
+	  "totalAvailableBalance": "",
+	  "totalMarginOpenLoss": "",
+	  "updateTime": 1657707212154
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewAccountInfo().Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *AccountInfoResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(*testResp, *resp)
+}
+
+func (s *accountTestSuite) TestQueryBalance() {
+	msg := []byte(`{
+	  "asset": "USDT",
+	  "totalWalletBalance": "122607.35137903",
+	  "crossMarginAsset": "92.27530794",
+	  "crossMarginBorrowed": "10.00000000",
+	  "crossMarginFree": "100.00000000",
+	  "crossMarginInterest": "0.72469206",
+	  "crossMarginLocked": "3.00000000",
+	  "umWalletBalance": "0.00000000",
+	  "umUnrealizedPNL": "23.72469206",
+	  "cmWalletBalance": "23.72469206",
+	  "cmUnrealizedPNL": "",
+	  "updateTime": 1617939110373,
+	  "negativeBalance": "0"
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewQueryBalance().Asset("USDT").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *BalanceResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Len(resp, 1)
+	r.Equal(*testResp, *resp[0])
+}
+
+func (s *accountTestSuite) TestMarginLoan() {
+	msg := []byte(`{"tranId": 100000001}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewMarginLoan().Asset("USDT").Amount("100").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Equal(int64(100000001), resp.TranId)
+}
+
+func (s *accountTestSuite) TestAutoCollection() {
+	msg := []byte(`{"msg": "success"}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewAutoCollection().Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Equal("success", resp.Msg)
+}
@@ -0,0 +1,144 @@
+package portfolio
+
+import (
+	"context"
+	"github.com/jekaxv/go-binance/core"
+	"net/http"
+)
+
+type Client struct {
+	*core.Client
+}
+
+func (c *Client) invoke(r *core.Request, ctx context.Context) error {
+	return c.Invoke(r, ctx)
+}
+
+func (c *Client) rawBody() []byte {
+	return c.RawBody()
+}
+
+// NewPing Test connectivity
+func (c *Client) NewPing() *Ping {
+	return &Ping{c: c, r: c.SetReq("/papi/v1/ping", http.MethodGet)}
+}
+
+// NewAccountInfo Account Information (USER_DATA)
+func (c *Client) NewAccountInfo() *AccountInfo {
+	return &AccountInfo{c: c, r: c.SetReq("/papi/v1/account", http.MethodGet, core.AuthSigned)}
+}
+
+// NewQueryBalance Account Balance (USER_DATA)
+func (c *Client) NewQueryBalance() *QueryBalance {
+	return &QueryBalance{c: c, r: c.SetReq("/papi/v1/balance", http.MethodGet, core.AuthSigned)}
+}
+
+// NewUmCreateOrder New UM Order (TRADE)
+func (c *Client) NewUmCreateOrder() *UmCreateOrder {
+	return &UmCreateOrder{c: c, r: c.SetReq("/papi/v1/um/order", http.MethodPost, core.AuthSigned)}
+}
+
+// NewUmCancelOrder Cancel UM Order (TRADE)
+func (c *Client) NewUmCancelOrder() *UmCancelOrder {
+	return &UmCancelOrder{c: c, r: c.SetReq("/papi/v1/um/order", http.MethodDelete, core.AuthSigned)}
+}
+
+// NewUmQueryOrder Query UM Order (USER_DATA)
+func (c *Client) NewUmQueryOrder() *UmQueryOrder {
+	return &UmQueryOrder{c: c, r: c.SetReq("/papi/v1/um/order", http.MethodGet, core.AuthSigned)}
+}
+
+// NewUmCancelOpenOrder Cancel All UM Open Orders (TRADE)
+func (c *Client) NewUmCancelOpenOrder() *UmCancelOpenOrder {
+	return &UmCancelOpenOrder{c: c, r: c.SetReq("/papi/v1/um/allOpenOrders", http.MethodDelete, core.AuthSigned)}
+}
+
+// NewUmOpenOrder Query All Current UM Open Orders (USER_DATA)
+func (c *Client) NewUmOpenOrder() *UmOpenOrder {
+	return &UmOpenOrder{c: c, r: c.SetReq("/papi/v1/um/openOrders", http.MethodGet, core.AuthSigned)}
+}
+
+// NewUmPositionRisk Query UM Position Information (USER_DATA)
+func (c *Client) NewUmPositionRisk() *UmPositionRisk {
+	return &UmPositionRisk{c: c, r: c.SetReq("/papi/v1/um/positionRisk", http.MethodGet, core.AuthSigned)}
+}
+
+// NewCmCreateOrder New CM Order (TRADE)
+func (c *Client) NewCmCreateOrder() *CmCreateOrder {
+	return &CmCreateOrder{c: c, r: c.SetReq("/papi/v1/cm/order", http.MethodPost, core.AuthSigned)}
+}
+
+// NewCmCancelOrder Cancel CM Order (TRADE)
+func (c *Client) NewCmCancelOrder() *CmCancelOrder {
+	return &CmCancelOrder{c: c, r: c.SetReq("/papi/v1/cm/order", http.MethodDelete, core.AuthSigned)}
+}
+
+// NewCmQueryOrder Query CM Order (USER_DATA)
+func (c *Client) NewCmQueryOrder() *CmQueryOrder {
+	return &CmQueryOrder{c: c, r: c.SetReq("/papi/v1/cm/order", http.MethodGet, core.AuthSigned)}
+}
+
+// NewCmCancelOpenOrder Cancel All CM Open Orders (TRADE)
+func (c *Client) NewCmCancelOpenOrder() *CmCancelOpenOrder {
+	return &CmCancelOpenOrder{c: c, r: c.SetReq("/papi/v1/cm/allOpenOrders", http.MethodDelete, core.AuthSigned)}
+}
+
+// NewCmOpenOrder Query All Current CM Open Orders (USER_DATA)
+func (c *Client) NewCmOpenOrder() *CmOpenOrder {
+	return &CmOpenOrder{c: c, r: c.SetReq("/papi/v1/cm/openOrders", http.MethodGet, core.AuthSigned)}
+}
+
+// NewCmPositionRisk Query CM Position Information (USER_DATA)
+func (c *Client) NewCmPositionRisk() *CmPositionRisk {
+	return &CmPositionRisk{c: c, r: c.SetReq("/papi/v1/cm/positionRisk", http.MethodGet, core.AuthSigned)}
+}
+
+// NewMarginCreateOrder New Margin Order (TRADE)
+func (c *Client) NewMarginCreateOrder() *MarginCreateOrder {
+	return &MarginCreateOrder{c: c, r: c.SetReq("/papi/v1/margin/order", http.MethodPost, core.AuthSigned)}
+}
+
+// NewMarginCancelOrder Cancel Margin Account Order (TRADE)
+func (c *Client) NewMarginCancelOrder() *MarginCancelOrder {
+	return &MarginCancelOrder{c: c, r: c.SetReq("/papi/v1/margin/order", http.MethodDelete, core.AuthSigned)}
+}
+
+// NewMarginQueryOrder Query Margin Account Order (USER_DATA)
+func (c *Client) NewMarginQueryOrder() *MarginQueryOrder {
+	return &MarginQueryOrder{c: c, r: c.SetReq("/papi/v1/margin/order", http.MethodGet, core.AuthSigned)}
+}
+
+// NewMarginLoan Margin Account Borrow (MARGIN)
+func (c *Client) NewMarginLoan() *MarginLoan {
+	return &MarginLoan{c: c, r: c.SetReq("/papi/v1/marginLoan", http.MethodPost, core.AuthSigned)}
+}
+
+// NewRepayLoan Margin Account Repay (MARGIN)
+func (c *Client) NewRepayLoan() *RepayLoan {
+	return &RepayLoan{c: c, r: c.SetReq("/papi/v1/repayLoan", http.MethodPost, core.AuthSigned)}
+}
+
+// NewAutoCollection Fund Auto-collection (TRADE)
+func (c *Client) NewAutoCollection() *AutoCollection {
+	return &AutoCollection{c: c, r: c.SetReq("/papi/v1/auto-collection", http.MethodPost, core.AuthSigned)}
+}
+
+// NewAssetCollection Fund Collection by Asset (TRADE)
+func (c *Client) NewAssetCollection() *AssetCollection {
+	return &AssetCollection{c: c, r: c.SetReq("/papi/v1/asset-collection", http.MethodPost, core.AuthSigned)}
+}
+
+// NewGetListenKey Start User Data Stream (USER_STREAM)
+func (c *Client) NewGetListenKey() *GetListenKey {
+	return &GetListenKey{c: c, r: c.SetReq("/papi/v1/listenKey", http.MethodPost, core.AuthApiKey)}
+}
+
+// NewKeepaliveListenKey Keepalive User Data Stream (USER_STREAM)
+func (c *Client) NewKeepaliveListenKey() *KeepaliveListenKey {
+	return &KeepaliveListenKey{c: c, r: c.SetReq("/papi/v1/listenKey", http.MethodPut, core.AuthApiKey)}
+}
+
+// NewCloseListenKey Close User Data Stream (USER_STREAM)
+func (c *Client) NewCloseListenKey() *CloseListenKey {
+	return &CloseListenKey{c: c, r: c.SetReq("/papi/v1/listenKey", http.MethodDelete, core.AuthApiKey)}
+}
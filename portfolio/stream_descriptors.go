@@ -0,0 +1,16 @@
+package portfolio
+
+import (
+	"github.com/jekaxv/go-binance/core"
+)
+
+// StreamDescriptors Builds descriptors of the portfolio margin user data stream, use it through Streams.
+type StreamDescriptors struct{}
+
+// Streams Describes portfolio margin streams, e.g. portfolio.Streams.UserData(listenKey).
+var Streams StreamDescriptors
+
+// UserData Stream Name: <listenKey>
+func (StreamDescriptors) UserData(listenKey string) core.StreamDescriptor[*UserDataEvent] {
+	return core.NewStreamDescriptorFunc(listenKey, parseUserEvent)
+}
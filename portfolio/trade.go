@@ -0,0 +1,642 @@
+package portfolio
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/delivery"
+	"github.com/jekaxv/go-binance/futures"
+	"github.com/jekaxv/go-binance/spot"
+	"github.com/shopspring/decimal"
+)
+
+// MarginOrderResponse Margin orders answer with the spot order schema plus the borrowed amount of MARGIN_BUY orders.
+type MarginOrderResponse struct {
+	spot.CreateOrderResponse
+	MarginBuyBorrowAmount decimal.Decimal `json:"marginBuyBorrowAmount"`
+	MarginBuyBorrowAsset  string          `json:"marginBuyBorrowAsset"`
+}
+
+// UmCreateOrder Place new UM order, the response schema is the one of USDⓈ-M futures.
+type UmCreateOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *UmCreateOrder) Symbol(symbol string) *UmCreateOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *UmCreateOrder) Side(side core.OrderSideEnum) *UmCreateOrder {
+	s.r.Set("side", side)
+	return s
+}
+
+// PositionSide Default BOTH for One-way Mode; LONG or SHORT for Hedge Mode.
+func (s *UmCreateOrder) PositionSide(positionSide core.PositionSideEnum) *UmCreateOrder {
+	s.r.Set("positionSide", positionSide)
+	return s
+}
+
+func (s *UmCreateOrder) Type(orderType core.OrderTypeEnum) *UmCreateOrder {
+	s.r.Set("type", orderType)
+	return s
+}
+
+func (s *UmCreateOrder) TimeInForce(timeInForce core.TimeInForceEnum) *UmCreateOrder {
+	s.r.Set("timeInForce", timeInForce)
+	return s
+}
+
+func (s *UmCreateOrder) Quantity(quantity string) *UmCreateOrder {
+	s.r.Set("quantity", quantity)
+	return s
+}
+
+// ReduceOnly "true" or "false". default "false". Cannot be sent in Hedge Mode.
+func (s *UmCreateOrder) ReduceOnly(reduceOnly string) *UmCreateOrder {
+	s.r.Set("reduceOnly", reduceOnly)
+	return s
+}
+
+func (s *UmCreateOrder) Price(price string) *UmCreateOrder {
+	s.r.Set("price", price)
+	return s
+}
+
+func (s *UmCreateOrder) NewClientOrderId(newClientOrderId string) *UmCreateOrder {
+	s.r.Set("newClientOrderId", newClientOrderId)
+	return s
+}
+
+// NewOrderRespType "ACK", "RESULT", default "ACK"
+func (s *UmCreateOrder) NewOrderRespType(newOrderRespType core.OrderResponseTypeEnum) *UmCreateOrder {
+	s.r.Set("newOrderRespType", newOrderRespType)
+	return s
+}
+
+func (s *UmCreateOrder) PriceMatch(priceMatch string) *UmCreateOrder {
+	s.r.Set("priceMatch", priceMatch)
+	return s
+}
+
+func (s *UmCreateOrder) SelfTradePreventionMode(selfTradePreventionMode core.STPModeEnum) *UmCreateOrder {
+	s.r.Set("selfTradePreventionMode", selfTradePreventionMode)
+	return s
+}
+
+func (s *UmCreateOrder) GoodTillDate(goodTillDate int64) *UmCreateOrder {
+	s.r.Set("goodTillDate", goodTillDate)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *UmCreateOrder) RecvWindow(recvWindow int64) *UmCreateOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *UmCreateOrder) Do(ctx context.Context) (*futures.OrderResponse, error) {
+	resp := new(futures.OrderResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// UmCancelOrder Cancel an active UM LIMIT order. Either orderId or origClientOrderId must be sent.
+type UmCancelOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *UmCancelOrder) Symbol(symbol string) *UmCancelOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *UmCancelOrder) OrderId(orderId int64) *UmCancelOrder {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *UmCancelOrder) OrigClientOrderId(origClientOrderId string) *UmCancelOrder {
+	s.r.Set("origClientOrderId", origClientOrderId)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *UmCancelOrder) RecvWindow(recvWindow int64) *UmCancelOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *UmCancelOrder) Do(ctx context.Context) (*futures.OrderResponse, error) {
+	resp := new(futures.OrderResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// UmQueryOrder Check an UM order's status.
+type UmQueryOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *UmQueryOrder) Symbol(symbol string) *UmQueryOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *UmQueryOrder) OrderId(orderId int64) *UmQueryOrder {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *UmQueryOrder) OrigClientOrderId(origClientOrderId string) *UmQueryOrder {
+	s.r.Set("origClientOrderId", origClientOrderId)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *UmQueryOrder) RecvWindow(recvWindow int64) *UmQueryOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *UmQueryOrder) Do(ctx context.Context) (*futures.OrderResponse, error) {
+	resp := new(futures.OrderResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// UmCancelOpenOrder Cancel all active UM LIMIT orders on specific symbol.
+type UmCancelOpenOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *UmCancelOpenOrder) Symbol(symbol string) *UmCancelOpenOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *UmCancelOpenOrder) RecvWindow(recvWindow int64) *UmCancelOpenOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *UmCancelOpenOrder) Do(ctx context.Context) (*futures.CancelOpenOrderResponse, error) {
+	resp := new(futures.CancelOpenOrderResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// UmOpenOrder Get all open UM orders on a symbol or all symbols.
+type UmOpenOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *UmOpenOrder) Symbol(symbol string) *UmOpenOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *UmOpenOrder) RecvWindow(recvWindow int64) *UmOpenOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *UmOpenOrder) Do(ctx context.Context) ([]*futures.OrderResponse, error) {
+	resp := make([]*futures.OrderResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// UmPositionRisk Get current UM position information.
+type UmPositionRisk struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *UmPositionRisk) Symbol(symbol string) *UmPositionRisk {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *UmPositionRisk) RecvWindow(recvWindow int64) *UmPositionRisk {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *UmPositionRisk) Do(ctx context.Context) ([]*futures.PositionRiskResponse, error) {
+	resp := make([]*futures.PositionRiskResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// CmCreateOrder Place new CM order, the response schema is the one of COIN-M delivery futures.
+type CmCreateOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *CmCreateOrder) Symbol(symbol string) *CmCreateOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *CmCreateOrder) Side(side core.OrderSideEnum) *CmCreateOrder {
+	s.r.Set("side", side)
+	return s
+}
+
+// PositionSide Default BOTH for One-way Mode; LONG or SHORT for Hedge Mode.
+func (s *CmCreateOrder) PositionSide(positionSide core.PositionSideEnum) *CmCreateOrder {
+	s.r.Set("positionSide", positionSide)
+	return s
+}
+
+func (s *CmCreateOrder) Type(orderType core.OrderTypeEnum) *CmCreateOrder {
+	s.r.Set("type", orderType)
+	return s
+}
+
+func (s *CmCreateOrder) TimeInForce(timeInForce core.TimeInForceEnum) *CmCreateOrder {
+	s.r.Set("timeInForce", timeInForce)
+	return s
+}
+
+func (s *CmCreateOrder) Quantity(quantity string) *CmCreateOrder {
+	s.r.Set("quantity", quantity)
+	return s
+}
+
+// ReduceOnly "true" or "false". default "false". Cannot be sent in Hedge Mode.
+func (s *CmCreateOrder) ReduceOnly(reduceOnly string) *CmCreateOrder {
+	s.r.Set("reduceOnly", reduceOnly)
+	return s
+}
+
+func (s *CmCreateOrder) Price(price string) *CmCreateOrder {
+	s.r.Set("price", price)
+	return s
+}
+
+func (s *CmCreateOrder) NewClientOrderId(newClientOrderId string) *CmCreateOrder {
+	s.r.Set("newClientOrderId", newClientOrderId)
+	return s
+}
+
+// NewOrderRespType "ACK", "RESULT", default "ACK"
+func (s *CmCreateOrder) NewOrderRespType(newOrderRespType core.OrderResponseTypeEnum) *CmCreateOrder {
+	s.r.Set("newOrderRespType", newOrderRespType)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *CmCreateOrder) RecvWindow(recvWindow int64) *CmCreateOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CmCreateOrder) Do(ctx context.Context) (*delivery.OrderResponse, error) {
+	resp := new(delivery.OrderResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// CmCancelOrder Cancel an active CM LIMIT order. Either orderId or origClientOrderId must be sent.
+type CmCancelOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *CmCancelOrder) Symbol(symbol string) *CmCancelOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *CmCancelOrder) OrderId(orderId int64) *CmCancelOrder {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *CmCancelOrder) OrigClientOrderId(origClientOrderId string) *CmCancelOrder {
+	s.r.Set("origClientOrderId", origClientOrderId)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *CmCancelOrder) RecvWindow(recvWindow int64) *CmCancelOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CmCancelOrder) Do(ctx context.Context) (*delivery.OrderResponse, error) {
+	resp := new(delivery.OrderResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// CmQueryOrder Check a CM order's status.
+type CmQueryOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *CmQueryOrder) Symbol(symbol string) *CmQueryOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *CmQueryOrder) OrderId(orderId int64) *CmQueryOrder {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *CmQueryOrder) OrigClientOrderId(origClientOrderId string) *CmQueryOrder {
+	s.r.Set("origClientOrderId", origClientOrderId)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *CmQueryOrder) RecvWindow(recvWindow int64) *CmQueryOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CmQueryOrder) Do(ctx context.Context) (*delivery.OrderResponse, error) {
+	resp := new(delivery.OrderResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// CmCancelOpenOrder Cancel all active CM LIMIT orders on specific symbol.
+type CmCancelOpenOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *CmCancelOpenOrder) Symbol(symbol string) *CmCancelOpenOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *CmCancelOpenOrder) RecvWindow(recvWindow int64) *CmCancelOpenOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CmCancelOpenOrder) Do(ctx context.Context) (*futures.CancelOpenOrderResponse, error) {
+	resp := new(futures.CancelOpenOrderResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// CmOpenOrder Get all open CM orders on a symbol or all symbols.
+type CmOpenOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *CmOpenOrder) Symbol(symbol string) *CmOpenOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *CmOpenOrder) RecvWindow(recvWindow int64) *CmOpenOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CmOpenOrder) Do(ctx context.Context) ([]*delivery.OrderResponse, error) {
+	resp := make([]*delivery.OrderResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// CmPositionRisk Get current CM position information.
+type CmPositionRisk struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *CmPositionRisk) MarginAsset(marginAsset string) *CmPositionRisk {
+	s.r.Set("marginAsset", marginAsset)
+	return s
+}
+
+func (s *CmPositionRisk) Pair(pair string) *CmPositionRisk {
+	s.r.Set("pair", pair)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *CmPositionRisk) RecvWindow(recvWindow int64) *CmPositionRisk {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *CmPositionRisk) Do(ctx context.Context) ([]*delivery.PositionRiskResponse, error) {
+	resp := make([]*delivery.PositionRiskResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// MarginCreateOrder Place new cross margin order.
+type MarginCreateOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *MarginCreateOrder) Symbol(symbol string) *MarginCreateOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *MarginCreateOrder) Side(side core.OrderSideEnum) *MarginCreateOrder {
+	s.r.Set("side", side)
+	return s
+}
+
+func (s *MarginCreateOrder) Type(orderType core.OrderTypeEnum) *MarginCreateOrder {
+	s.r.Set("type", orderType)
+	return s
+}
+
+func (s *MarginCreateOrder) Quantity(quantity string) *MarginCreateOrder {
+	s.r.Set("quantity", quantity)
+	return s
+}
+
+func (s *MarginCreateOrder) QuoteOrderQty(quoteOrderQty string) *MarginCreateOrder {
+	s.r.Set("quoteOrderQty", quoteOrderQty)
+	return s
+}
+
+func (s *MarginCreateOrder) Price(price string) *MarginCreateOrder {
+	s.r.Set("price", price)
+	return s
+}
+
+func (s *MarginCreateOrder) StopPrice(stopPrice string) *MarginCreateOrder {
+	s.r.Set("stopPrice", stopPrice)
+	return s
+}
+
+func (s *MarginCreateOrder) NewClientOrderId(newClientOrderId string) *MarginCreateOrder {
+	s.r.Set("newClientOrderId", newClientOrderId)
+	return s
+}
+
+// NewOrderRespType "ACK", "RESULT", default "ACK"
+func (s *MarginCreateOrder) NewOrderRespType(newOrderRespType core.OrderResponseTypeEnum) *MarginCreateOrder {
+	s.r.Set("newOrderRespType", newOrderRespType)
+	return s
+}
+
+func (s *MarginCreateOrder) IcebergQty(icebergQty string) *MarginCreateOrder {
+	s.r.Set("icebergQty", icebergQty)
+	return s
+}
+
+// SideEffectType NO_SIDE_EFFECT, MARGIN_BUY, AUTO_REPAY; default NO_SIDE_EFFECT.
+func (s *MarginCreateOrder) SideEffectType(sideEffectType core.SideEffectTypeEnum) *MarginCreateOrder {
+	s.r.Set("sideEffectType", sideEffectType)
+	return s
+}
+
+func (s *MarginCreateOrder) TimeInForce(timeInForce core.TimeInForceEnum) *MarginCreateOrder {
+	s.r.Set("timeInForce", timeInForce)
+	return s
+}
+
+func (s *MarginCreateOrder) SelfTradePreventionMode(selfTradePreventionMode core.STPModeEnum) *MarginCreateOrder {
+	s.r.Set("selfTradePreventionMode", selfTradePreventionMode)
+	return s
+}
+
+// AutoRepayAtCancel Only when MARGIN_BUY order takes effect, true means that the debt generated by the order needs to be repaid after the order is cancelled. default true
+func (s *MarginCreateOrder) AutoRepayAtCancel(autoRepayAtCancel bool) *MarginCreateOrder {
+	s.r.Set("autoRepayAtCancel", autoRepayAtCancel)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *MarginCreateOrder) RecvWindow(recvWindow int64) *MarginCreateOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *MarginCreateOrder) Do(ctx context.Context) (*MarginOrderResponse, error) {
+	resp := new(MarginOrderResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// MarginCancelOrder Cancel an active cross margin order.
+type MarginCancelOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *MarginCancelOrder) Symbol(symbol string) *MarginCancelOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *MarginCancelOrder) OrderId(orderId int64) *MarginCancelOrder {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *MarginCancelOrder) OrigClientOrderId(origClientOrderId string) *MarginCancelOrder {
+	s.r.Set("origClientOrderId", origClientOrderId)
+	return s
+}
+
+func (s *MarginCancelOrder) NewClientOrderId(newClientOrderId string) *MarginCancelOrder {
+	s.r.Set("newClientOrderId", newClientOrderId)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *MarginCancelOrder) RecvWindow(recvWindow int64) *MarginCancelOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *MarginCancelOrder) Do(ctx context.Context) (*spot.QueryOrderResponse, error) {
+	resp := new(spot.QueryOrderResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// MarginQueryOrder Check a cross margin order's status.
+type MarginQueryOrder struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *MarginQueryOrder) Symbol(symbol string) *MarginQueryOrder {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *MarginQueryOrder) OrderId(orderId int64) *MarginQueryOrder {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *MarginQueryOrder) OrigClientOrderId(origClientOrderId string) *MarginQueryOrder {
+	s.r.Set("origClientOrderId", origClientOrderId)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *MarginQueryOrder) RecvWindow(recvWindow int64) *MarginQueryOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *MarginQueryOrder) Do(ctx context.Context) (*spot.QueryOrderResponse, error) {
+	resp := new(spot.QueryOrderResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
@@ -0,0 +1,121 @@
+package portfolio
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/delivery"
+	"github.com/jekaxv/go-binance/futures"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type tradeTestSuite struct {
+	baseHttpTestSuite
+}
+
+func TestTrade(t *testing.T) {
+	suite.Run(t, new(tradeTestSuite))
+}
+
+func (s *tradeTestSuite) TestUmCreateOrder() {
+	msg := []byte(`{
+	  "clientOrderId": "testOrder",
+	  "cumQty": "0",
+	  "cumQuote": "0",
+	  "executedQty": "0",
+	  "orderId": 22542179,
+	  "avgPrice": "0.00000",
+	  "origQty": "10",
+	  "price": "0",
+	  "reduceOnly": false,
+	  "side": "BUY",
+	  "positionSide": "SHORT",
+	  "status": "NEW",
+	  "symbol": "BTCUSDT",
+	  "timeInForce": "GTD",
+	  "type": "MARKET",
+	  "selfTradePreventionMode": "NONE",
+	  "goodTillDate": 1693207680000,
+	  "updateTime": 1566818724722,
+	  "priceMatch": "NONE"
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewUmCreateOrder().Symbol("BTCUSDT").Side(core.OrderSideBUY).
+		Type(core.OrderTypeMARKET).Quantity("10").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *futures.OrderResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(*testResp, *resp)
+}
+
+func (s *tradeTestSuite) TestCmCreateOrder() {
+	msg := []byte(`{
+	  "clientOrderId": "testOrder",
+	  "cumQty": "0",
+	  "cumBase": "0",
+	  "executedQty": "0",
+	  "orderId": 22542179,
+	  "avgPrice": "0.0",
+	  "origQty": "10",
+	  "price": "0",
+	  "reduceOnly": false,
+	  "side": "BUY",
+	  "positionSide": "SHORT",
+	  "status": "NEW",
+	  "symbol": "BTCUSD_200925",
+	  "pair": "BTCUSD",
+	  "timeInForce": "GTC",
+	  "type": "MARKET",
+	  "updateTime": 1566818724722
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewCmCreateOrder().Symbol("BTCUSD_200925").Side(core.OrderSideBUY).
+		Type(core.OrderTypeMARKET).Quantity("10").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *delivery.OrderResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(*testResp, *resp)
+}
+
+func (s *tradeTestSuite) TestMarginCreateOrder() {
+	msg := []byte(`{
+	  "symbol": "BTCUSDT",
+	  "orderId": 28,
+	  "clientOrderId": "6gCrw2kRUAF9CvJDGP16IP",
+	  "transactTime": 1507725176595,
+	  "price": "1.00000000",
+	  "origQty": "10.00000000",
+	  "executedQty": "10.00000000",
+	  "cummulativeQuoteQty": "10.00000000",
+	  "status": "FILLED",
+	  "timeInForce": "GTC",
+	  "type": "MARKET",
+	  "side": "SELL",
+	  "marginBuyBorrowAmount": "5",
+	  "marginBuyBorrowAsset": "BTC",
+	  "fills": [
+		{
+		  "price": "4000.00000000",
+		  "qty": "1.00000000",
+		  "commission": "4.00000000",
+		  "commissionAsset": "USDT"
+		}
+	  ]
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewMarginCreateOrder().Symbol("BTCUSDT").Side(core.OrderSideSELL).
+		Type(core.OrderTypeMARKET).Quantity("10").SideEffectType(core.SideEffectTypeMARGIN_BUY).Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Equal("BTCUSDT", resp.Symbol, "Symbol")
+	r.Equal(28, resp.OrderId, "OrderId")
+	r.Equal("5", resp.MarginBuyBorrowAmount.String(), "MarginBuyBorrowAmount")
+	r.Equal("BTC", resp.MarginBuyBorrowAsset, "MarginBuyBorrowAsset")
+	r.Len(resp.Fills, 1)
+}
@@ -0,0 +1,114 @@
+package portfolio
+
+import (
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/futures"
+	"github.com/jekaxv/go-binance/spot"
+	"github.com/shopspring/decimal"
+)
+
+// WebsocketStreams The Portfolio Margin user data stream of fstream.binance.com/pm.
+type WebsocketStreams struct {
+	c *WsClient
+}
+
+type UserDataStream struct {
+	*core.Stream[*UserDataEvent]
+}
+
+type UserDataEventType string
+
+const (
+	listenKeyExpired        = "listenKeyExpired"
+	ACCOUNT_UPDATE          = "ACCOUNT_UPDATE"
+	ORDER_TRADE_UPDATE      = "ORDER_TRADE_UPDATE"
+	ACCOUNT_CONFIG_UPDATE   = "ACCOUNT_CONFIG_UPDATE"
+	executionReport         = "executionReport"
+	outboundAccountPosition = "outboundAccountPosition"
+	balanceUpdate           = "balanceUpdate"
+	liabilityChange         = "liabilityChange"
+	riskLevelChange         = "riskLevelChange"
+	openOrderLoss           = "openOrderLoss"
+)
+
+// UserDataEvent UM and CM events reuse the futures payloads and carry their business unit in BusinessUnit,
+// cross margin events reuse the spot payloads.
+type UserDataEvent struct {
+	Event               UserDataEventType `json:"e"`
+	Time                int64             `json:"E"`
+	BusinessUnit        string            `json:"fs"` // UM or CM
+	AccountUpdate       futures.AccountUpdate
+	OrderTradeUpdate    futures.OrderTradeUpdate
+	AccountConfigUpdate futures.AccountConfigUpdate
+	MarginOrderUpdate   spot.OrderUpdate
+	MarginAccountUpdate spot.AccountUpdate
+	BalanceUpdate       spot.BalanceUpdate
+	LiabilityChange     LiabilityChange
+	RiskLevelChange     RiskLevelChange
+	OpenOrderLoss       OpenOrderLoss
+	ListenExpired       futures.ListenExpired
+}
+
+// LiabilityChange Pushed when the margin liability of an asset changes.
+type LiabilityChange struct {
+	Asset          string          `json:"a"`
+	Type           string          `json:"t"` // BORROW
+	TxId           int64           `json:"T"`
+	Principal      decimal.Decimal `json:"p"`
+	Interest       decimal.Decimal `json:"i"`
+	TotalLiability decimal.Decimal `json:"l"`
+}
+
+// RiskLevelChange Pushed when the uniMMR of the account crosses a risk level.
+type RiskLevelChange struct {
+	UniMMR            decimal.Decimal `json:"u"`
+	Status            string          `json:"s"` // MARGIN_CALL, SUPPLY_MARGIN, REDUCE_ONLY, FORCE_LIQUIDATION
+	AccountEquity     decimal.Decimal `json:"eq"`
+	ActualEquity      decimal.Decimal `json:"ae"`
+	MaintenanceMargin decimal.Decimal `json:"m"`
+}
+
+type OrderLoss struct {
+	Asset  string          `json:"a"`
+	Amount decimal.Decimal `json:"o"`
+}
+
+// OpenOrderLoss Cross margin order margin stream, pushed when the open order loss changes.
+type OpenOrderLoss struct {
+	Losses []*OrderLoss `json:"O"`
+}
+
+func (s *WebsocketStreams) SubscribeUserData(listenKey string) *UserDataStream {
+	return &UserDataStream{core.NewRawStream(s.c.WsClient, Streams.UserData(listenKey))}
+}
+
+func parseUserEvent(message []byte) (*UserDataEvent, error) {
+	var event *UserDataEvent
+	if err := json.Unmarshal(message, &event); err != nil {
+		return event, err
+	}
+	switch event.Event {
+	case listenKeyExpired:
+		return event, json.Unmarshal(message, &event.ListenExpired)
+	case ACCOUNT_UPDATE:
+		return event, json.Unmarshal(message, &event.AccountUpdate)
+	case ORDER_TRADE_UPDATE:
+		return event, json.Unmarshal(message, &event.OrderTradeUpdate)
+	case ACCOUNT_CONFIG_UPDATE:
+		return event, json.Unmarshal(message, &event.AccountConfigUpdate)
+	case executionReport:
+		return event, json.Unmarshal(message, &event.MarginOrderUpdate)
+	case outboundAccountPosition:
+		return event, json.Unmarshal(message, &event.MarginAccountUpdate)
+	case balanceUpdate:
+		return event, json.Unmarshal(message, &event.BalanceUpdate)
+	case liabilityChange:
+		return event, json.Unmarshal(message, &event.LiabilityChange)
+	case riskLevelChange:
+		return event, json.Unmarshal(message, &event.RiskLevelChange)
+	case openOrderLoss:
+		return event, json.Unmarshal(message, &event.OpenOrderLoss)
+	}
+	return event, nil
+}
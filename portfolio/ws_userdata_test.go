@@ -0,0 +1,56 @@
+package portfolio
+
+import (
+	"context"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type userDataStreamTestSuite struct {
+	baseWsTestSuite
+}
+
+func TestUserDataStream(t *testing.T) {
+	suite.Run(t, new(userDataStreamTestSuite))
+}
+
+func (s *userDataStreamTestSuite) subscribe(msg []byte) *UserDataEvent {
+	server := s.setup(msg)
+	defer server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	onMessage, onError := s.client.NewWebsocketStreams().SubscribeUserData("listenKey").Do(ctx)
+	select {
+	case event := <-onMessage:
+		return event
+	case err := <-onError:
+		s.FailNow(err.Error())
+	}
+	return nil
+}
+
+func (s *userDataStreamTestSuite) TestOrderTradeUpdate() {
+	event := s.subscribe([]byte(`{"e":"ORDER_TRADE_UPDATE","E":1568879465651,"T":1568879465650,"fs":"UM","o":{"s":"BTCUSDT","c":"TEST","S":"SELL","o":"TRAILING_STOP_MARKET","f":"GTC","q":"0.001","p":"0","ap":"0","sp":"7103.04","x":"NEW","X":"NEW","i":8886774,"l":"0","z":"0","L":"0","T":1568879465650,"t":0,"b":"0","a":"9.91","m":false,"R":false,"ps":"LONG","rp":"0","V":"EXPIRE_TAKER","pm":"NONE","gtd":0}}`))
+	r := s.r()
+	r.Equal(ORDER_TRADE_UPDATE, string(event.Event), "Event")
+	r.Equal("UM", event.BusinessUnit, "BusinessUnit")
+	r.Equal("BTCUSDT", event.OrderTradeUpdate.O.Symbol, "Symbol")
+	r.Equal(8886774, event.OrderTradeUpdate.O.OrderId, "OrderId")
+}
+
+func (s *userDataStreamTestSuite) TestLiabilityChange() {
+	event := s.subscribe([]byte(`{"e":"liabilityChange","E":1573200697110,"a":"BTC","t":"BORROW","T":1352286576452864727,"p":"1.03453430","i":"0","l":"1.03476851"}`))
+	r := s.r()
+	r.Equal(liabilityChange, string(event.Event), "Event")
+	r.Equal("BTC", event.LiabilityChange.Asset, "Asset")
+	r.Equal(int64(1352286576452864727), event.LiabilityChange.TxId, "TxId")
+	r.Equal("1.03476851", event.LiabilityChange.TotalLiability.String(), "TotalLiability")
+}
+
+func (s *userDataStreamTestSuite) TestExecutionReport() {
+	event := s.subscribe([]byte(`{"e":"executionReport","E":1499405658658,"s":"ETHBTC","c":"mUvoqJxFIILMdfAW5iGSOW","S":"BUY","o":"LIMIT","f":"GTC","q":"1.00000000","p":"0.10264410","P":"0.00000000","F":"0.00000000","g":-1,"C":"","x":"NEW","X":"NEW","r":"NONE","i":4293153,"l":"0.00000000","z":"0.00000000","L":"0.00000000","n":"0","N":null,"T":1499405658657,"t":-1,"I":8641984,"w":true,"m":false,"M":false,"O":1499405658657,"Z":"0.00000000","Y":"0.00000000","Q":"0.00000000","V":"NONE"}`))
+	r := s.r()
+	r.Equal(executionReport, string(event.Event), "Event")
+	r.Equal("ETHBTC", event.MarginOrderUpdate.Symbol, "Symbol")
+	r.Equal(4293153, event.MarginOrderUpdate.OrderId, "OrderId")
+}
@@ -0,0 +1,458 @@
+package risk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/shopspring/decimal"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Rule string
+
+const (
+	RuleKillSwitch    Rule = "KILL_SWITCH"
+	RuleMaxNotional   Rule = "MAX_NOTIONAL"
+	RuleMaxPosition   Rule = "MAX_POSITION"
+	RuleMaxOpenOrders Rule = "MAX_OPEN_ORDERS"
+	RuleMaxOrderRate  Rule = "MAX_ORDER_RATE"
+	RulePriceBand     Rule = "PRICE_BAND"
+	RuleInvalidOrder  Rule = "INVALID_ORDER"
+)
+
+// ErrKillSwitch is matched by errors.Is for every order blocked while the kill switch is engaged.
+var ErrKillSwitch = errors.New("risk: kill switch engaged")
+
+// Error An order rejected by the guard. It never reached Binance.
+type Error struct {
+	Rule   Rule
+	Symbol string
+	Msg    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("risk: %s rejected for %s: %s", e.Rule, e.Symbol, e.Msg)
+}
+
+func (e *Error) Is(target error) bool {
+	return target == ErrKillSwitch && e.Rule == RuleKillSwitch
+}
+
+// Limits Zero values disable the corresponding check.
+// The checks that need a price fail closed: without a reference price the price band rejects every order,
+// and market orders are rejected by MaxNotional, and by MaxPosition when sized in quote.
+type Limits struct {
+	MaxNotional   decimal.Decimal // quantity * price of a single order
+	MaxPosition   decimal.Decimal // absolute position after the order, in base quantity
+	MaxOpenOrders int
+	MaxOrderRate  int             // orders accepted per RateWindow
+	RateWindow    time.Duration   // default one second
+	PriceBand     decimal.Decimal // max deviation from the reference price, 0.05 is 5%
+}
+
+type orderKind int
+
+const (
+	kindNew orderKind = iota
+	// kindReplace takes the place of a resting order, it opens no order and its quantity is not added to the others of the request.
+	kindReplace
+	// kindList opens every order of an OCO, OTO or OTOCO list, each is checked on its own against the position.
+	kindList
+)
+
+// order endpoints checked by the guard, every other signed request passes through untouched.
+var restOrders = map[string]orderKind{
+	http.MethodPost + " /api/v3/order":                   kindNew,
+	http.MethodPost + " /api/v3/order/cancelReplace":     kindReplace,
+	http.MethodPost + " /api/v3/order/oco":               kindList,
+	http.MethodPost + " /api/v3/orderList/oco":           kindList,
+	http.MethodPost + " /api/v3/orderList/oto":           kindList,
+	http.MethodPost + " /api/v3/orderList/otoco":         kindList,
+	http.MethodPost + " /api/v3/sor/order":               kindNew,
+	http.MethodPost + " /fapi/v1/order":                  kindNew,
+	http.MethodPut + " /fapi/v1/order":                   kindReplace,
+	http.MethodPost + " /fapi/v1/batchOrders":            kindNew,
+	http.MethodPut + " /fapi/v1/batchOrders":             kindReplace,
+	http.MethodPost + " /dapi/v1/order":                  kindNew,
+	http.MethodPut + " /dapi/v1/order":                   kindReplace,
+	http.MethodPost + " /dapi/v1/batchOrders":            kindNew,
+	http.MethodPost + " /eapi/v1/order":                  kindNew,
+	http.MethodPost + " /eapi/v1/batchOrders":            kindNew,
+	http.MethodPost + " /papi/v1/um/order":               kindNew,
+	http.MethodPut + " /papi/v1/um/order":                kindReplace,
+	http.MethodPost + " /papi/v1/cm/order":               kindNew,
+	http.MethodPut + " /papi/v1/cm/order":                kindReplace,
+	http.MethodPost + " /papi/v1/margin/order":           kindNew,
+	http.MethodPost + " /papi/v1/margin/order/oco":       kindList,
+	http.MethodPost + " /sapi/v1/margin/order":           kindNew,
+	http.MethodPost + " /sapi/v1/margin/order/oco":       kindList,
+	http.MethodPost + " /sapi/v1/margin/orderList/oto":   kindList,
+	http.MethodPost + " /sapi/v1/margin/orderList/otoco": kindList,
+}
+
+var wsOrders = map[string]orderKind{
+	"order.place":           kindNew,
+	"order.cancelReplace":   kindReplace,
+	"order.modify":          kindReplace,
+	"orderList.place":       kindList,
+	"orderList.place.oco":   kindList,
+	"orderList.place.oto":   kindList,
+	"orderList.place.otoco": kindList,
+	"sor.order.place":       kindNew,
+}
+
+// fixOrders FIX order entry messages by MsgType: NewOrderSingle<D>, OrderCancelRequestAndNewOrderSingle<XCN> and NewOrderList<E>.
+var fixOrders = map[string]orderKind{
+	"D":   kindNew,
+	"XCN": kindReplace,
+	"E":   kindList,
+}
+
+type order struct {
+	symbol        string
+	side          string
+	quantity      decimal.Decimal
+	price         decimal.Decimal
+	quoteOrderQty decimal.Decimal
+	reduceOnly    bool
+	follows       bool // a pending order of a list, it only executes once the working order filled
+}
+
+// Guard Pre-trade risk checks for spot, margin, USDⓈ-M, COIN-M, options and portfolio margin orders,
+// sent over REST, the WebSocket API or FIX. Quantities are taken as sent, in contracts for COIN-M.
+// Install it with core.Options{Guard: guard}; reference prices, positions and open order
+// counts are fed by the caller, usually from market and user data streams.
+type Guard struct {
+	mu         sync.Mutex
+	defaults   Limits
+	limits     map[string]Limits
+	prices     map[string]decimal.Decimal
+	positions  map[string]decimal.Decimal
+	openOrders map[string]int
+	sent       map[string][]time.Time
+	killed     bool
+	now        func() time.Time
+	// CancelAll is called by Kill to cancel resting orders, e.g. with CancelOpenOrder on every traded symbol.
+	CancelAll func(ctx context.Context) error
+}
+
+func NewGuard(defaults Limits) *Guard {
+	return &Guard{
+		defaults:   defaults,
+		limits:     make(map[string]Limits),
+		prices:     make(map[string]decimal.Decimal),
+		positions:  make(map[string]decimal.Decimal),
+		openOrders: make(map[string]int),
+		sent:       make(map[string][]time.Time),
+		now:        time.Now,
+	}
+}
+
+// SetLimits Override the default limits for symbol.
+func (g *Guard) SetLimits(symbol string, limits Limits) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.limits[strings.ToUpper(symbol)] = limits
+}
+
+// UpdatePrice Set the reference price (mark, last or mid book price) used by the price band and market notional checks.
+func (g *Guard) UpdatePrice(symbol string, price decimal.Decimal) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.prices[strings.ToUpper(symbol)] = price
+}
+
+// UpdatePosition Set the signed position of symbol, positive for long.
+func (g *Guard) UpdatePosition(symbol string, position decimal.Decimal) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.positions[strings.ToUpper(symbol)] = position
+}
+
+// UpdateOpenOrders Set the number of open orders of symbol. Orders reported to Accepted increase it until the next update.
+func (g *Guard) UpdateOpenOrders(symbol string, count int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.openOrders[strings.ToUpper(symbol)] = count
+}
+
+// Kill Engage the kill switch and cancel resting orders through CancelAll. New orders are blocked until Resume.
+func (g *Guard) Kill(ctx context.Context) error {
+	g.mu.Lock()
+	g.killed = true
+	cancelAll := g.CancelAll
+	g.mu.Unlock()
+	if cancelAll == nil {
+		return nil
+	}
+	return cancelAll(ctx)
+}
+
+func (g *Guard) Resume() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.killed = false
+}
+
+func (g *Guard) Killed() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.killed
+}
+
+func kindOf(intent *core.OrderIntent) (orderKind, bool) {
+	var kind orderKind
+	var ok bool
+	if intent.Path != "" {
+		kind, ok = restOrders[intent.HttpMethod+" "+intent.Path]
+	} else if intent.MsgType != "" {
+		kind, ok = fixOrders[intent.MsgType]
+	} else {
+		kind, ok = wsOrders[intent.Method]
+	}
+	return kind, ok
+}
+
+// Check implements core.OrderGuard. Nothing is counted here, see Accepted.
+func (g *Guard) Check(intent *core.OrderIntent) error {
+	kind, ok := kindOf(intent)
+	if !ok {
+		return nil
+	}
+	orders, err := parseOrders(kind, intent.Params)
+	if err != nil {
+		return &Error{Rule: RuleInvalidOrder, Symbol: intent.Params["symbol"], Msg: err.Error()}
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.killed {
+		return &Error{Rule: RuleKillSwitch, Symbol: intent.Params["symbol"], Msg: "new orders are blocked"}
+	}
+	now := g.now()
+	pending := make(map[string]int)
+	positions := make(map[string]decimal.Decimal)
+	for _, o := range orders {
+		if err := g.check(o, kind, now, pending, positions); err != nil {
+			return err
+		}
+		pending[o.symbol]++
+	}
+	return nil
+}
+
+// Accepted implements core.OrderTracker: orders Binance acknowledged count towards the order rate,
+// and new ones towards the open orders until the next UpdateOpenOrders.
+// Requests in flight are not counted yet, so concurrent senders can overshoot a limit by the orders they have in flight.
+func (g *Guard) Accepted(intent *core.OrderIntent) {
+	kind, ok := kindOf(intent)
+	if !ok {
+		return
+	}
+	orders, err := parseOrders(kind, intent.Params)
+	if err != nil {
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	now := g.now()
+	for _, o := range orders {
+		g.sent[o.symbol] = append(g.sent[o.symbol], now)
+		if kind != kindReplace {
+			g.openOrders[o.symbol]++
+		}
+	}
+}
+
+func (g *Guard) limitsOf(symbol string) Limits {
+	if limits, ok := g.limits[symbol]; ok {
+		return limits
+	}
+	return g.defaults
+}
+
+func (g *Guard) check(o *order, kind orderKind, now time.Time, pending map[string]int, positions map[string]decimal.Decimal) error {
+	limits := g.limitsOf(o.symbol)
+	reference, hasReference := g.prices[o.symbol]
+	if limits.MaxOrderRate > 0 {
+		window := limits.RateWindow
+		if window <= 0 {
+			window = time.Second
+		}
+		recent := g.sent[o.symbol][:0]
+		for _, t := range g.sent[o.symbol] {
+			if now.Sub(t) < window {
+				recent = append(recent, t)
+			}
+		}
+		g.sent[o.symbol] = recent
+		if len(recent)+pending[o.symbol] >= limits.MaxOrderRate {
+			return &Error{Rule: RuleMaxOrderRate, Symbol: o.symbol, Msg: fmt.Sprintf("more than %d orders in %s", limits.MaxOrderRate, window)}
+		}
+	}
+	if limits.MaxOpenOrders > 0 && kind != kindReplace && g.openOrders[o.symbol]+pending[o.symbol] >= limits.MaxOpenOrders {
+		return &Error{Rule: RuleMaxOpenOrders, Symbol: o.symbol, Msg: fmt.Sprintf("%d open orders", g.openOrders[o.symbol]+pending[o.symbol])}
+	}
+	hasReference = hasReference && reference.IsPositive()
+	if limits.PriceBand.IsPositive() && !hasReference {
+		return &Error{Rule: RuleInvalidOrder, Symbol: o.symbol, Msg: "no reference price for the price band"}
+	}
+	if limits.PriceBand.IsPositive() && o.price.IsPositive() {
+		deviation := o.price.Sub(reference).Abs().Div(reference)
+		if deviation.GreaterThan(limits.PriceBand) {
+			return &Error{Rule: RulePriceBand, Symbol: o.symbol, Msg: fmt.Sprintf("price %s deviates %s from reference %s", o.price, deviation.StringFixed(4), reference)}
+		}
+	}
+	if limits.MaxNotional.IsPositive() {
+		notional := o.quoteOrderQty
+		if notional.IsZero() {
+			price := o.price
+			if price.IsZero() {
+				if !hasReference {
+					return &Error{Rule: RuleInvalidOrder, Symbol: o.symbol, Msg: "no reference price to value the order"}
+				}
+				price = reference
+			}
+			notional = o.quantity.Mul(price)
+		}
+		if notional.GreaterThan(limits.MaxNotional) {
+			return &Error{Rule: RuleMaxNotional, Symbol: o.symbol, Msg: fmt.Sprintf("notional %s exceeds %s", notional, limits.MaxNotional)}
+		}
+	}
+	if limits.MaxPosition.IsPositive() && !o.reduceOnly {
+		position := g.positions[o.symbol]
+		if projected, ok := positions[o.symbol]; ok && (kind == kindNew || o.follows) {
+			position = projected
+		}
+		quantity := o.quantity
+		if quantity.IsZero() && !o.quoteOrderQty.IsZero() {
+			if !hasReference {
+				return &Error{Rule: RuleInvalidOrder, Symbol: o.symbol, Msg: "no reference price to size the order"}
+			}
+			quantity = o.quoteOrderQty.Div(reference)
+		}
+		if o.side == string(core.OrderSideSELL) {
+			quantity = quantity.Neg()
+		}
+		projected := position.Add(quantity)
+		if projected.Abs().GreaterThan(limits.MaxPosition) && projected.Abs().GreaterThan(position.Abs()) {
+			return &Error{Rule: RuleMaxPosition, Symbol: o.symbol, Msg: fmt.Sprintf("position would reach %s, limit %s", projected, limits.MaxPosition)}
+		}
+		if kind != kindReplace && !o.follows {
+			positions[o.symbol] = projected
+		}
+	}
+	return nil
+}
+
+func parseOrders(kind orderKind, params map[string]string) ([]*order, error) {
+	batch, ok := params["batchOrders"]
+	if !ok {
+		// the batch parameter of options
+		batch, ok = params["orders"]
+	}
+	if ok {
+		items := make([]map[string]any, 0)
+		if err := json.Unmarshal([]byte(batch), &items); err != nil {
+			return nil, fmt.Errorf("invalid batchOrders: %w", err)
+		}
+		orders := make([]*order, 0, len(items))
+		for _, item := range items {
+			values := make(map[string]string, len(item))
+			for key, value := range item {
+				values[key] = fmt.Sprintf("%v", value)
+			}
+			o, err := parseOrder(values)
+			if err != nil {
+				return nil, err
+			}
+			orders = append(orders, o)
+		}
+		return orders, nil
+	}
+	if kind == kindList {
+		return parseList(params)
+	}
+	o, err := parseOrder(params)
+	if err != nil {
+		return nil, err
+	}
+	return []*order{o}, nil
+}
+
+// parseList The orders of an OTOCO (working, pendingAbove and pendingBelow), an OTO (working and pending),
+// an OCO (above and below) or a legacy OCO (price, then stopLimitPrice or stopPrice). A leg without a limit price is valued at its stop price.
+func parseList(params map[string]string) ([]*order, error) {
+	leg := func(side, quantity, price, stopPrice string) (*order, error) {
+		if params[price] == "" {
+			price = stopPrice
+		}
+		o, err := parseOrder(map[string]string{"symbol": params["symbol"], "side": params[side], "quantity": params[quantity], "price": params[price]})
+		if err != nil {
+			return nil, err
+		}
+		o.follows = strings.HasPrefix(side, "pending")
+		return o, nil
+	}
+	var legs [][4]string
+	switch {
+	case params["workingType"] != "" && params["pendingAboveType"] != "":
+		legs = [][4]string{
+			{"workingSide", "workingQuantity", "workingPrice", ""},
+			{"pendingSide", "pendingQuantity", "pendingAbovePrice", "pendingAboveStopPrice"},
+			{"pendingSide", "pendingQuantity", "pendingBelowPrice", "pendingBelowStopPrice"},
+		}
+	case params["workingType"] != "":
+		legs = [][4]string{
+			{"workingSide", "workingQuantity", "workingPrice", ""},
+			{"pendingSide", "pendingQuantity", "pendingPrice", "pendingStopPrice"},
+		}
+	case params["aboveType"] != "":
+		legs = [][4]string{
+			{"side", "quantity", "abovePrice", "aboveStopPrice"},
+			{"side", "quantity", "belowPrice", "belowStopPrice"},
+		}
+	default:
+		legs = [][4]string{
+			{"side", "quantity", "price", ""},
+			{"side", "quantity", "stopLimitPrice", "stopPrice"},
+		}
+	}
+	orders := make([]*order, 0, len(legs))
+	for _, l := range legs {
+		o, err := leg(l[0], l[1], l[2], l[3])
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, o)
+	}
+	return orders, nil
+}
+
+func parseOrder(params map[string]string) (*order, error) {
+	o := &order{
+		symbol:     strings.ToUpper(params["symbol"]),
+		side:       strings.ToUpper(params["side"]),
+		reduceOnly: strings.EqualFold(params["reduceOnly"], "true") || strings.EqualFold(params["closePosition"], "true"),
+	}
+	for key, dst := range map[string]*decimal.Decimal{
+		"quantity":      &o.quantity,
+		"price":         &o.price,
+		"quoteOrderQty": &o.quoteOrderQty,
+	} {
+		value, ok := params[key]
+		if !ok || value == "" {
+			continue
+		}
+		d, err := decimal.NewFromString(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q", key, value)
+		}
+		*dst = d
+	}
+	return o, nil
+}
@@ -0,0 +1,334 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/jekaxv/go-binance/futures"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type guardTestSuite struct {
+	suite.Suite
+	guard *Guard
+	now   time.Time
+}
+
+func TestGuard(t *testing.T) {
+	suite.Run(t, new(guardTestSuite))
+}
+
+func (s *guardTestSuite) SetupTest() {
+	s.now = time.UnixMilli(1737443769749)
+	s.guard = NewGuard(Limits{})
+	s.guard.now = func() time.Time { return s.now }
+}
+
+func (s *guardTestSuite) r() *require.Assertions {
+	return s.Require()
+}
+
+// send Checks intent and reports it as accepted when it passes, like a client whose request succeeded.
+func (s *guardTestSuite) send(intent *core.OrderIntent) error {
+	if err := s.guard.Check(intent); err != nil {
+		return err
+	}
+	s.guard.Accepted(intent)
+	return nil
+}
+
+func (s *guardTestSuite) place(params map[string]string) error {
+	return s.send(&core.OrderIntent{Path: "/fapi/v1/order", HttpMethod: http.MethodPost, Params: params})
+}
+
+func (s *guardTestSuite) assertRule(err error, rule Rule) {
+	var riskErr *Error
+	s.r().True(errors.As(err, &riskErr), "expected risk error, got %v", err)
+	s.r().Equal(rule, riskErr.Rule)
+}
+
+func (s *guardTestSuite) TestIgnoresOtherRequests() {
+	s.guard.SetLimits("BTCUSDT", Limits{MaxNotional: decimal.NewFromInt(1)})
+	r := s.r()
+	r.NoError(s.guard.Check(&core.OrderIntent{Path: "/fapi/v1/order", HttpMethod: http.MethodDelete, Params: map[string]string{"symbol": "BTCUSDT"}}))
+	r.NoError(s.guard.Check(&core.OrderIntent{Path: "/fapi/v3/account", HttpMethod: http.MethodGet}))
+	r.NoError(s.guard.Check(&core.OrderIntent{Method: "order.status", Params: map[string]string{"symbol": "BTCUSDT"}}))
+}
+
+func (s *guardTestSuite) TestMaxNotional() {
+	s.guard.SetLimits("BTCUSDT", Limits{MaxNotional: decimal.NewFromInt(1000)})
+	r := s.r()
+	r.NoError(s.place(map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quantity": "0.01", "price": "100000"}))
+	s.assertRule(s.place(map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quantity": "0.02", "price": "100000"}), RuleMaxNotional)
+	// a market order cannot be valued without a reference price
+	s.assertRule(s.place(map[string]string{"symbol": "BTCUSDT", "side": "SELL", "quantity": "0.001", "type": "MARKET"}), RuleInvalidOrder)
+	// market orders are valued at the reference price
+	s.guard.UpdatePrice("BTCUSDT", decimal.NewFromInt(100000))
+	s.assertRule(s.place(map[string]string{"symbol": "BTCUSDT", "side": "SELL", "quantity": "1", "type": "MARKET"}), RuleMaxNotional)
+	s.assertRule(s.guard.Check(&core.OrderIntent{Path: "/api/v3/order", HttpMethod: http.MethodPost,
+		Params: map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quoteOrderQty": "5000"}}), RuleMaxNotional)
+}
+
+func (s *guardTestSuite) TestPriceBand() {
+	s.guard.SetLimits("BTCUSDT", Limits{PriceBand: decimal.RequireFromString("0.05")})
+	r := s.r()
+	// no reference price yet
+	s.assertRule(s.place(map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quantity": "1", "price": "1"}), RuleInvalidOrder)
+	s.guard.UpdatePrice("BTCUSDT", decimal.NewFromInt(100000))
+	r.NoError(s.place(map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quantity": "1", "price": "96000"}))
+	s.assertRule(s.place(map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quantity": "1", "price": "10000"}), RulePriceBand)
+	s.assertRule(s.guard.Check(&core.OrderIntent{Method: "order.modify",
+		Params: map[string]string{"symbol": "BTCUSDT", "side": "SELL", "quantity": "1", "price": "110000"}}), RulePriceBand)
+}
+
+func (s *guardTestSuite) TestMaxPosition() {
+	s.guard.SetLimits("BTCUSDT", Limits{MaxPosition: decimal.NewFromInt(2)})
+	s.guard.UpdatePosition("BTCUSDT", decimal.RequireFromString("1.5"))
+	r := s.r()
+	r.NoError(s.place(map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quantity": "0.5"}))
+	s.assertRule(s.place(map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quantity": "1"}), RuleMaxPosition)
+	r.NoError(s.place(map[string]string{"symbol": "BTCUSDT", "side": "SELL", "quantity": "3"}))
+	s.assertRule(s.place(map[string]string{"symbol": "BTCUSDT", "side": "SELL", "quantity": "4"}), RuleMaxPosition)
+	r.NoError(s.place(map[string]string{"symbol": "BTCUSDT", "side": "SELL", "quantity": "4", "reduceOnly": "true"}))
+	s.assertRule(s.guard.Check(&core.OrderIntent{Path: "/api/v3/order", HttpMethod: http.MethodPost,
+		Params: map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quoteOrderQty": "10"}}), RuleInvalidOrder)
+}
+
+func (s *guardTestSuite) TestMaxOpenOrders() {
+	s.guard.SetLimits("BTCUSDT", Limits{MaxOpenOrders: 2})
+	r := s.r()
+	r.NoError(s.place(map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quantity": "1"}))
+	r.NoError(s.place(map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quantity": "1"}))
+	s.assertRule(s.place(map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quantity": "1"}), RuleMaxOpenOrders)
+	// modifications do not open new orders
+	r.NoError(s.send(&core.OrderIntent{Path: "/fapi/v1/order", HttpMethod: http.MethodPut,
+		Params: map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quantity": "1", "price": "1"}}))
+	s.guard.UpdateOpenOrders("BTCUSDT", 0)
+	r.NoError(s.place(map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quantity": "1"}))
+}
+
+func (s *guardTestSuite) TestMaxOrderRate() {
+	s.guard.SetLimits("BTCUSDT", Limits{MaxOrderRate: 2, RateWindow: time.Second})
+	r := s.r()
+	r.NoError(s.place(map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quantity": "1"}))
+	r.NoError(s.place(map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quantity": "1"}))
+	s.assertRule(s.place(map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quantity": "1"}), RuleMaxOrderRate)
+	s.now = s.now.Add(time.Second)
+	r.NoError(s.place(map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quantity": "1"}))
+}
+
+func (s *guardTestSuite) TestBatchOrders() {
+	s.guard.SetLimits("BTCUSDT", Limits{MaxOpenOrders: 2})
+	s.guard.SetLimits("ETHUSDT", Limits{MaxNotional: decimal.NewFromInt(100)})
+	batch := func(orders string) error {
+		return s.send(&core.OrderIntent{Path: "/fapi/v1/batchOrders", HttpMethod: http.MethodPost,
+			Params: map[string]string{"batchOrders": orders}})
+	}
+	r := s.r()
+	r.NoError(batch(`[{"symbol":"BTCUSDT","side":"BUY","quantity":"1"},{"symbol":"BTCUSDT","side":"SELL","quantity":"1"}]`))
+	s.assertRule(batch(`[{"symbol":"BTCUSDT","side":"BUY","quantity":"1"}]`), RuleMaxOpenOrders)
+	s.assertRule(batch(`[{"symbol":"ETHUSDT","side":"BUY","quantity":"1","price":"50"},{"symbol":"ETHUSDT","side":"BUY","quantity":"1","price":"500"}]`), RuleMaxNotional)
+	s.assertRule(batch(`{`), RuleInvalidOrder)
+}
+
+func (s *guardTestSuite) TestOrderPaths() {
+	s.guard.SetLimits("BTCUSD_PERP", Limits{MaxNotional: decimal.NewFromInt(1000)})
+	r := s.r()
+	params := map[string]string{"symbol": "BTCUSD_PERP", "side": "BUY", "quantity": "1", "price": "100000"}
+	for _, path := range []string{
+		http.MethodPost + " /dapi/v1/order",
+		http.MethodPut + " /dapi/v1/order",
+		http.MethodPost + " /eapi/v1/order",
+		http.MethodPost + " /papi/v1/um/order",
+		http.MethodPost + " /papi/v1/cm/order",
+		http.MethodPost + " /papi/v1/margin/order",
+		http.MethodPost + " /sapi/v1/margin/order",
+	} {
+		method, path, _ := strings.Cut(path, " ")
+		s.assertRule(s.guard.Check(&core.OrderIntent{Path: path, HttpMethod: method, Params: params}), RuleMaxNotional)
+	}
+	orders := `[{"symbol":"BTCUSD_PERP","side":"BUY","quantity":"1","price":"100000"}]`
+	s.assertRule(s.guard.Check(&core.OrderIntent{Path: "/dapi/v1/batchOrders", HttpMethod: http.MethodPost,
+		Params: map[string]string{"batchOrders": orders}}), RuleMaxNotional)
+	s.assertRule(s.guard.Check(&core.OrderIntent{Path: "/eapi/v1/batchOrders", HttpMethod: http.MethodPost,
+		Params: map[string]string{"orders": orders}}), RuleMaxNotional)
+	for _, msgType := range []string{"D", "XCN"} {
+		s.assertRule(s.guard.Check(&core.OrderIntent{MsgType: msgType, Params: params}), RuleMaxNotional)
+	}
+	s.assertRule(s.guard.Check(&core.OrderIntent{MsgType: "E", Params: map[string]string{"symbol": "BTCUSD_PERP", "batchOrders": orders}}), RuleMaxNotional)
+	r.NoError(s.guard.Check(&core.OrderIntent{MsgType: "F", Params: params}), "cancels pass")
+}
+
+func (s *guardTestSuite) TestListAndModifyPaths() {
+	legacyOco := map[string]string{"side": "SELL", "quantity": "1", "price": "100", "stopPrice": "99", "stopLimitPrice": "98"}
+	legacyStopOco := map[string]string{"side": "SELL", "quantity": "1", "price": "100", "stopPrice": "99"}
+	oco := map[string]string{"side": "SELL", "quantity": "1", "aboveType": "LIMIT_MAKER", "abovePrice": "101", "belowType": "STOP_LOSS", "belowStopPrice": "99"}
+	oto := map[string]string{"workingType": "LIMIT", "workingSide": "BUY", "workingPrice": "100", "workingQuantity": "1",
+		"pendingType": "LIMIT", "pendingSide": "SELL", "pendingPrice": "101", "pendingQuantity": "1"}
+	otoco := map[string]string{"workingType": "LIMIT", "workingSide": "BUY", "workingPrice": "100", "workingQuantity": "1",
+		"pendingSide": "SELL", "pendingQuantity": "1", "pendingAboveType": "LIMIT_MAKER", "pendingAbovePrice": "101",
+		"pendingBelowType": "STOP_LOSS", "pendingBelowStopPrice": "99"}
+	modify := map[string]string{"side": "BUY", "quantity": "1", "price": "100"}
+	for _, tc := range []struct {
+		endpoint string
+		params   map[string]string
+		spoil    string
+		opened   int
+	}{
+		{http.MethodPost + " /api/v3/order/oco", legacyOco, "stopLimitPrice", 2},
+		{http.MethodPost + " /api/v3/orderList/oco", oco, "belowStopPrice", 2},
+		{http.MethodPost + " /api/v3/orderList/oto", oto, "pendingPrice", 2},
+		{http.MethodPost + " /api/v3/orderList/otoco", otoco, "pendingAbovePrice", 3},
+		{http.MethodPost + " /sapi/v1/margin/order/oco", legacyOco, "price", 2},
+		{http.MethodPost + " /sapi/v1/margin/orderList/oto", oto, "workingPrice", 2},
+		{http.MethodPost + " /sapi/v1/margin/orderList/otoco", otoco, "pendingBelowStopPrice", 3},
+		{http.MethodPost + " /papi/v1/margin/order/oco", legacyStopOco, "stopPrice", 2},
+		{http.MethodPut + " /papi/v1/um/order", modify, "price", 0},
+		{http.MethodPut + " /papi/v1/cm/order", modify, "price", 0},
+		{"orderList.place", legacyOco, "stopLimitPrice", 2},
+		{"orderList.place.oco", oco, "abovePrice", 2},
+		{"orderList.place.oto", oto, "pendingPrice", 2},
+		{"orderList.place.otoco", otoco, "workingPrice", 3},
+	} {
+		s.Run(tc.endpoint, func() {
+			s.SetupTest()
+			s.guard.SetLimits("BTCUSDT", Limits{PriceBand: decimal.RequireFromString("0.05")})
+			s.guard.UpdatePrice("BTCUSDT", decimal.NewFromInt(100))
+			intent := func(params map[string]string) *core.OrderIntent {
+				params["symbol"] = "BTCUSDT"
+				if method, path, ok := strings.Cut(tc.endpoint, " "); ok {
+					return &core.OrderIntent{Path: path, HttpMethod: method, Params: params}
+				}
+				return &core.OrderIntent{Method: tc.endpoint, Params: params}
+			}
+			spoiled := map[string]string{tc.spoil: "200"}
+			params := make(map[string]string)
+			for key, value := range tc.params {
+				params[key] = value
+				if key != tc.spoil {
+					spoiled[key] = value
+				}
+			}
+			s.assertRule(s.send(intent(spoiled)), RulePriceBand)
+			s.r().NoError(s.send(intent(params)))
+			s.r().Equal(tc.opened, s.guard.openOrders["BTCUSDT"], "open orders")
+		})
+	}
+}
+
+func (s *guardTestSuite) TestListAndModifyPosition() {
+	s.guard.SetLimits("BTCUSDT", Limits{MaxPosition: decimal.NewFromInt(2)})
+	s.guard.UpdatePosition("BTCUSDT", decimal.RequireFromString("1.5"))
+	r := s.r()
+	orders := `[{"symbol":"BTCUSDT","side":"BUY","quantity":"0.5"},{"symbol":"BTCUSDT","side":"BUY","quantity":"0.5"}]`
+	// modified orders take the place of resting ones, their quantities do not add up
+	r.NoError(s.send(&core.OrderIntent{Path: "/fapi/v1/batchOrders", HttpMethod: http.MethodPut, Params: map[string]string{"batchOrders": orders}}))
+	s.assertRule(s.send(&core.OrderIntent{Path: "/fapi/v1/batchOrders", HttpMethod: http.MethodPost, Params: map[string]string{"batchOrders": orders}}), RuleMaxPosition)
+	s.assertRule(s.send(&core.OrderIntent{Path: "/fapi/v1/order", HttpMethod: http.MethodPut,
+		Params: map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quantity": "1"}}), RuleMaxPosition)
+	// only one leg of an OCO executes, the pending order of an OTO closes what the working order opened
+	r.NoError(s.send(&core.OrderIntent{Path: "/api/v3/orderList/oco", HttpMethod: http.MethodPost,
+		Params: map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quantity": "0.5", "aboveType": "STOP_LOSS", "aboveStopPrice": "110", "belowType": "LIMIT_MAKER", "belowPrice": "90"}}))
+	r.NoError(s.send(&core.OrderIntent{Path: "/api/v3/orderList/oto", HttpMethod: http.MethodPost,
+		Params: map[string]string{"symbol": "BTCUSDT", "workingType": "LIMIT", "workingSide": "SELL", "workingPrice": "100", "workingQuantity": "3",
+			"pendingType": "LIMIT", "pendingSide": "BUY", "pendingPrice": "90", "pendingQuantity": "3"}}))
+	s.assertRule(s.send(&core.OrderIntent{Path: "/api/v3/orderList/oto", HttpMethod: http.MethodPost,
+		Params: map[string]string{"symbol": "BTCUSDT", "workingType": "LIMIT", "workingSide": "SELL", "workingPrice": "100", "workingQuantity": "1",
+			"pendingType": "LIMIT", "pendingSide": "BUY", "pendingPrice": "90", "pendingQuantity": "3"}}), RuleMaxPosition)
+}
+
+func (s *guardTestSuite) TestKillSwitch() {
+	canceled := 0
+	s.guard.CancelAll = func(ctx context.Context) error {
+		canceled++
+		return nil
+	}
+	r := s.r()
+	r.NoError(s.guard.Kill(context.Background()))
+	r.Equal(1, canceled)
+	r.True(s.guard.Killed())
+	err := s.place(map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quantity": "1"})
+	r.ErrorIs(err, ErrKillSwitch)
+	s.guard.Resume()
+	r.NoError(s.place(map[string]string{"symbol": "BTCUSDT", "side": "BUY", "quantity": "1"}))
+}
+
+func (s *guardTestSuite) TestClientIntegration() {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"orderId": 1}`))
+	}))
+	defer server.Close()
+	s.guard.SetLimits("BTCUSDT", Limits{MaxNotional: decimal.NewFromInt(1000)})
+	client := &futures.Client{Client: &core.Client{
+		Opt: &core.Options{
+			Endpoint:  server.URL,
+			ApiKey:    "YOUR_API_KEY",
+			ApiSecret: "YOUR_API_SECRET",
+			Logger:    slog.Default(),
+			Guard:     s.guard,
+		},
+		HttpClient: http.DefaultClient,
+	}}
+	r := s.r()
+	_, err := client.NewCreateOrder().Symbol("BTCUSDT").Side(core.OrderSideBUY).
+		Type(core.OrderTypeLIMIT).Quantity("1").Price("100000").Do(context.Background())
+	s.assertRule(err, RuleMaxNotional)
+	r.Equal(0, requests)
+	_, err = client.NewCreateOrder().Symbol("BTCUSDT").Side(core.OrderSideBUY).
+		Type(core.OrderTypeLIMIT).Quantity("0.001").Price("100000").Do(context.Background())
+	r.NoError(err)
+	r.Equal(1, requests)
+}
+
+func (s *guardTestSuite) TestRetriedOrderCountedOnce() {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"code": -1001, "msg": "Internal error; unable to process your request. Please try again."}`))
+			return
+		}
+		w.Write([]byte(`{"orderId": 1}`))
+	}))
+	defer server.Close()
+	refused := httptest.NewServer(http.NotFoundHandler())
+	refused.Close()
+	s.guard.SetLimits("BTCUSDT", Limits{MaxOpenOrders: 2, MaxOrderRate: 3})
+	client := &futures.Client{Client: &core.Client{
+		Opt: &core.Options{
+			Endpoint:  server.URL,
+			ApiKey:    "YOUR_API_KEY",
+			ApiSecret: "YOUR_API_SECRET",
+			Logger:    slog.Default(),
+			Guard:     s.guard,
+		},
+		HttpClient: http.DefaultClient,
+	}}
+	place := func() error {
+		_, err := client.NewCreateOrder().Symbol("BTCUSDT").Side(core.OrderSideBUY).
+			Type(core.OrderTypeLIMIT).Quantity("1").Price("100000").Do(context.Background())
+		return err
+	}
+	r := s.r()
+	r.Error(place(), "failed on the exchange")
+	r.NoError(place(), "retried by the caller")
+	// sent to the next endpoint after the first one refused the connection
+	client.Opt.Failover = core.NewFailover(refused.URL, server.URL)
+	r.NoError(place())
+	r.Equal(3, requests)
+	s.assertRule(place(), RuleMaxOpenOrders)
+	s.guard.UpdateOpenOrders("BTCUSDT", 0)
+	r.NoError(place())
+	s.assertRule(place(), RuleMaxOrderRate)
+}
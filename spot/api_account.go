@@ -51,27 +51,12 @@ func (s *AccountInformation) RecvWindow(recvWindow int) *AccountInformation {
 }
 
 func (s *AccountInformation) Do(ctx context.Context) (*AccountInformationResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *AccountInformationResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *AccountInformationResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // UnfilledOrder Query your current unfilled order count for all intervals.
@@ -98,27 +83,12 @@ func (s *UnfilledOrder) RecvWindow(recvWindow int) *UnfilledOrder {
 }
 
 func (s *UnfilledOrder) Do(ctx context.Context) (*UnfilledOrderResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *UnfilledOrderResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *UnfilledOrderResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // AccountOrderHistory Query information about all your orders – active, canceled, filled – filtered by time range.
@@ -184,27 +154,12 @@ func (s *AccountOrderHistory) RecvWindow(recvWindow int) *AccountOrderHistory {
 	return s
 }
 func (s *AccountOrderHistory) Do(ctx context.Context) (*AccountOrderHistoryResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *AccountOrderHistoryResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *AccountOrderHistoryResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // AllOrderList Query information about all your order lists, filtered by time range.
@@ -251,27 +206,12 @@ func (s *AllOrderList) RecvWindow(recvWindow int) *AllOrderList {
 	return s
 }
 func (s *AllOrderList) Do(ctx context.Context) (*AllOrderListResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *AllOrderListResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *AllOrderListResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // AccountTradeHistory Query information about all your trades, filtered by time range.
@@ -328,27 +268,12 @@ func (s *AccountTradeHistory) RecvWindow(recvWindow int) *AccountTradeHistory {
 	return s
 }
 func (s *AccountTradeHistory) Do(ctx context.Context) (*AccountTradeHistoryResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *AccountTradeHistoryResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *AccountTradeHistoryResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // AccountPreventedMatches Displays the list of orders that were expired due to STP.
@@ -399,27 +324,12 @@ func (s *AccountPreventedMatches) RecvWindow(recvWindow int) *AccountPreventedMa
 }
 
 func (s *AccountPreventedMatches) Do(ctx context.Context) (*AccountPreventedMatchesResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *AccountPreventedMatchesResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *AccountPreventedMatchesResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // AccountAllocations Retrieves allocations resulting from SOR order placement.
@@ -477,27 +387,12 @@ func (s *AccountAllocations) RecvWindow(recvWindow int) *AccountAllocations {
 	return s
 }
 func (s *AccountAllocations) Do(ctx context.Context) (*AccountAllocationsResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *AccountAllocationsResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *AccountAllocationsResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 type AccountCommission struct {
@@ -522,25 +417,10 @@ func (s *AccountCommission) Symbol(symbol string) *AccountCommission {
 }
 
 func (s *AccountCommission) Do(ctx context.Context) (*AccountCommissionResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *AccountCommissionResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *AccountCommissionResponse
+	return resp, json.Unmarshal(message, &resp)
 }
@@ -36,27 +36,12 @@ func (s *WsDepth) Limit(limit int) *WsDepth {
 }
 
 func (s *WsDepth) Do(ctx context.Context) (*WsDepthResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsDepthResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsDepthResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsTradesRecent Get recent trades
@@ -91,27 +76,12 @@ func (s *WsTradesRecent) Limit(limit int) *WsTradesRecent {
 	return s
 }
 func (s *WsTradesRecent) Do(ctx context.Context) (*WsTradesRecentResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsTradesRecentResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsTradesRecentResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsTradesHistorical Get historical trades.
@@ -143,27 +113,12 @@ func (s *WsTradesHistorical) Limit(limit int) *WsTradesHistorical {
 }
 
 func (s *WsTradesHistorical) Do(ctx context.Context) (*WsTradesHistoricalResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsTradesHistoricalResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsTradesHistoricalResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsTradesAggregate Get aggregate trades.
@@ -212,27 +167,12 @@ func (s *WsTradesAggregate) Limit(limit int) *WsTradesAggregate {
 }
 
 func (s *WsTradesAggregate) Do(ctx context.Context) (*WsTradesAggregateResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsTradesAggregateResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsTradesAggregateResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsKline Get klines (candlestick bars).
@@ -291,33 +231,18 @@ func (s *WsKline) Limit(limit int) *WsKline {
 }
 
 func (s *WsKline) Do(ctx context.Context) (*WsKlineResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var raw *KlineRawResult
-			if err := json.Unmarshal(message, &raw); err != nil {
-				return nil, err
-			}
-			resp := new(WsKlineResponse)
-			resp.ApiResponse = raw.ApiResponse
-			resp.Result = parseKlineData(raw.Result)
-			return resp, nil
-		case err := <-onError:
-			return nil, err
-		}
+	var raw *KlineRawResult
+	if err := json.Unmarshal(message, &raw); err != nil {
+		return nil, err
 	}
+	resp := new(WsKlineResponse)
+	resp.ApiResponse = raw.ApiResponse
+	resp.Result = parseKlineData(raw.Result)
+	return resp, nil
 }
 
 // WsUiKlines Get klines (candlestick bars) optimized for presentation.
@@ -352,33 +277,18 @@ func (s *WsUiKlines) Limit(limit int) *WsUiKlines {
 }
 
 func (s *WsUiKlines) Do(ctx context.Context) (*WsKlineResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var raw *KlineRawResult
-			if err := json.Unmarshal(message, &raw); err != nil {
-				return nil, err
-			}
-			resp := new(WsKlineResponse)
-			resp.ApiResponse = raw.ApiResponse
-			resp.Result = parseKlineData(raw.Result)
-			return resp, nil
-		case err := <-onError:
-			return nil, err
-		}
+	var raw *KlineRawResult
+	if err := json.Unmarshal(message, &raw); err != nil {
+		return nil, err
 	}
+	resp := new(WsKlineResponse)
+	resp.ApiResponse = raw.ApiResponse
+	resp.Result = parseKlineData(raw.Result)
+	return resp, nil
 }
 
 // WsAveragePrice Get current average price for a symbol.
@@ -404,27 +314,12 @@ func (s *WsAveragePrice) Symbol(symbol string) *WsAveragePrice {
 }
 
 func (s *WsAveragePrice) Do(ctx context.Context) (*WsAveragePriceResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsAveragePriceResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsAveragePriceResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsTicker24h Get 24-hour rolling window price change statistics.
@@ -486,36 +381,21 @@ func (s *WsTicker24h) Type(type_ core.TickerTypeEnum) *WsTicker24h {
 }
 
 func (s *WsTicker24h) Do(ctx context.Context) (*WsTicker24hResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			resp := new(WsTicker24hResponse)
-			if s.r.Get("symbols") != nil {
-				return resp, json.Unmarshal(message, &resp)
-			}
-			single := new(WsTicker24hSingleResponse)
-			if err := json.Unmarshal(message, &single); err != nil {
-				return nil, err
-			}
-			resp.ApiResponse = single.ApiResponse
-			resp.Result = append(resp.Result, single.Result)
-			return resp, nil
-		case err := <-onError:
-			return nil, err
-		}
+	resp := new(WsTicker24hResponse)
+	if s.r.Get("symbols") != nil {
+		return resp, json.Unmarshal(message, &resp)
+	}
+	single := new(WsTicker24hSingleResponse)
+	if err := json.Unmarshal(message, &single); err != nil {
+		return nil, err
 	}
+	resp.ApiResponse = single.ApiResponse
+	resp.Result = append(resp.Result, single.Result)
+	return resp, nil
 }
 
 // WsTickerTradingDay Price change statistics for a trading day.
@@ -558,36 +438,21 @@ func (s *WsTickerTradingDay) Type(type_ core.TickerTypeEnum) *WsTickerTradingDay
 }
 
 func (s *WsTickerTradingDay) Do(ctx context.Context) (*WsTickerTradingDayResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			resp := new(WsTickerTradingDayResponse)
-			if s.r.Get("symbols") != nil {
-				return resp, json.Unmarshal(message, &resp)
-			}
-			single := new(WsTickerTradingDaySingleResponse)
-			if err := json.Unmarshal(message, &single); err != nil {
-				return nil, err
-			}
-			resp.ApiResponse = single.ApiResponse
-			resp.Result = append(resp.Result, single.Result)
-			return resp, nil
-		case err := <-onError:
-			return nil, err
-		}
+	resp := new(WsTickerTradingDayResponse)
+	if s.r.Get("symbols") != nil {
+		return resp, json.Unmarshal(message, &resp)
 	}
+	single := new(WsTickerTradingDaySingleResponse)
+	if err := json.Unmarshal(message, &single); err != nil {
+		return nil, err
+	}
+	resp.ApiResponse = single.ApiResponse
+	resp.Result = append(resp.Result, single.Result)
+	return resp, nil
 }
 
 // WsTicker Get rolling window price change statistics with a custom window.
@@ -649,36 +514,21 @@ func (s *WsTicker) WindowSize(windowSize string) *WsTicker {
 }
 
 func (s *WsTicker) Do(ctx context.Context) (*WsTickerResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			resp := new(WsTickerResponse)
-			if s.r.Get("symbols") != nil {
-				return resp, json.Unmarshal(message, &resp)
-			}
-			single := new(TickerSingleResponse)
-			if err := json.Unmarshal(message, &single); err != nil {
-				return nil, err
-			}
-			resp.ApiResponse = single.ApiResponse
-			resp.Result = append(resp.Result, single.Result)
-			return resp, nil
-		case err := <-onError:
-			return nil, err
-		}
+	resp := new(WsTickerResponse)
+	if s.r.Get("symbols") != nil {
+		return resp, json.Unmarshal(message, &resp)
+	}
+	single := new(TickerSingleResponse)
+	if err := json.Unmarshal(message, &single); err != nil {
+		return nil, err
 	}
+	resp.ApiResponse = single.ApiResponse
+	resp.Result = append(resp.Result, single.Result)
+	return resp, nil
 }
 
 // WsTickerPrice Get the latest market price for a symbol.
@@ -713,36 +563,21 @@ func (s *WsTickerPrice) Symbols(symbols []string) *WsTickerPrice {
 }
 
 func (s *WsTickerPrice) Do(ctx context.Context) (*WsTickerPriceResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			resp := new(WsTickerPriceResponse)
-			if s.r.Get("symbols") != nil {
-				return resp, json.Unmarshal(message, &resp)
-			}
-			single := new(WsTickerPriceSingleResponse)
-			if err := json.Unmarshal(message, &single); err != nil {
-				return nil, err
-			}
-			resp.ApiResponse = single.ApiResponse
-			resp.Result = append(resp.Result, single.Result)
-			return resp, nil
-		case err := <-onError:
-			return nil, err
-		}
+	resp := new(WsTickerPriceResponse)
+	if s.r.Get("symbols") != nil {
+		return resp, json.Unmarshal(message, &resp)
 	}
+	single := new(WsTickerPriceSingleResponse)
+	if err := json.Unmarshal(message, &single); err != nil {
+		return nil, err
+	}
+	resp.ApiResponse = single.ApiResponse
+	resp.Result = append(resp.Result, single.Result)
+	return resp, nil
 }
 
 // WsTickerBook Get the current best price and quantity on the order book.
@@ -780,34 +615,19 @@ func (s *WsTickerBook) Symbols(symbols []string) *WsTickerBook {
 }
 
 func (s *WsTickerBook) Do(ctx context.Context) (*WsTickerBookResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			resp := new(WsTickerBookResponse)
-			if s.r.Get("symbols") != nil {
-				return resp, json.Unmarshal(message, &resp)
-			}
-			single := new(WsTickerBookSingleResponse)
-			if err := json.Unmarshal(message, &single); err != nil {
-				return nil, err
-			}
-			resp.ApiResponse = single.ApiResponse
-			resp.Result = append(resp.Result, single.Result)
-			return resp, nil
-		case err := <-onError:
-			return nil, err
-		}
+	resp := new(WsTickerBookResponse)
+	if s.r.Get("symbols") != nil {
+		return resp, json.Unmarshal(message, &resp)
+	}
+	single := new(WsTickerBookSingleResponse)
+	if err := json.Unmarshal(message, &single); err != nil {
+		return nil, err
 	}
+	resp.ApiResponse = single.ApiResponse
+	resp.Result = append(resp.Result, single.Result)
+	return resp, nil
 }
@@ -117,27 +117,12 @@ func (s *WsCreateOrder) RecvWindow(recvWindow int) *WsCreateOrder {
 }
 
 func (s *WsCreateOrder) Do(ctx context.Context) (*WsCreateOrderResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsCreateOrderResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsCreateOrderResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 type WsCreateTestOrder struct {
@@ -228,27 +213,12 @@ func (s *WsCreateTestOrder) ComputeCommissionRates(computeCommissionRates bool)
 }
 
 func (s *WsCreateTestOrder) Do(ctx context.Context) (*WsCreateOrderTestResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsCreateOrderTestResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsCreateOrderTestResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsQueryOrder Check execution status of an order.
@@ -310,27 +280,12 @@ func (s *WsQueryOrder) RecvWindow(recvWindow int) *WsQueryOrder {
 }
 
 func (s *WsQueryOrder) Do(ctx context.Context) (*WsQueryOrderResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsQueryOrderResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsQueryOrderResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsCancelOrder Cancel an active order.
@@ -408,27 +363,12 @@ func (s *WsCancelOrder) RecvWindow(recvWindow int) *WsCancelOrder {
 }
 
 func (s *WsCancelOrder) Do(ctx context.Context) (*WsCancelOrderResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsCancelOrderResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsCancelOrderResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsCancelReplaceOrder Cancel an existing order and immediately place a new order instead of the canceled one.
@@ -569,27 +509,69 @@ func (s *WsCancelReplaceOrder) RecvWindow(recvWindow int) *WsCancelReplaceOrder
 }
 
 func (s *WsCancelReplaceOrder) Do(ctx context.Context) (*WsCancelReplaceOrderResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsCancelReplaceOrderResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
+	var resp *WsCancelReplaceOrderResponse
+	return resp, json.Unmarshal(message, &resp)
+}
+
+// WsOrderAmendKeepPriority Reduce the quantity of an existing open order while keeping its priority in the order book.
+type WsOrderAmendKeepPriority struct {
+	c *WsClient
+	r *core.WsRequest
+}
+
+type WsOrderAmendKeepPriorityResponse struct {
+	Id         string                          `json:"id"`
+	Status     int                             `json:"status"`
+	RateLimits []*ApiRateLimit                 `json:"rateLimits,omitempty"`
+	Error      *ApiOrderError                  `json:"error,omitempty"`
+	Result     *OrderAmendKeepPriorityResponse `json:"result"`
+}
+
+func (s *WsOrderAmendKeepPriority) Symbol(symbol string) *WsOrderAmendKeepPriority {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// OrderId orderId or origClientOrderId must be sent
+func (s *WsOrderAmendKeepPriority) OrderId(orderId int64) *WsOrderAmendKeepPriority {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *WsOrderAmendKeepPriority) OrigClientOrderId(origClientOrderId string) *WsOrderAmendKeepPriority {
+	s.r.Set("origClientOrderId", origClientOrderId)
+	return s
+}
+
+// NewClientOrderId The new client order ID for the order after being amended. If not sent, one will be randomly generated.
+func (s *WsOrderAmendKeepPriority) NewClientOrderId(newClientOrderId string) *WsOrderAmendKeepPriority {
+	s.r.Set("newClientOrderId", newClientOrderId)
+	return s
+}
+
+// NewQty The new quantity, must be greater than 0 and less than the order's quantity.
+func (s *WsOrderAmendKeepPriority) NewQty(newQty string) *WsOrderAmendKeepPriority {
+	s.r.Set("newQty", newQty)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *WsOrderAmendKeepPriority) RecvWindow(recvWindow int) *WsOrderAmendKeepPriority {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *WsOrderAmendKeepPriority) Do(ctx context.Context) (*WsOrderAmendKeepPriorityResponse, error) {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
+		return nil, err
 	}
+	var resp *WsOrderAmendKeepPriorityResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsOpenOrdersStatus Query execution status of all open orders.
@@ -634,27 +616,12 @@ func (s *WsOpenOrdersStatus) RecvWindow(recvWindow int) *WsOpenOrdersStatus {
 	return s
 }
 func (s *WsOpenOrdersStatus) Do(ctx context.Context) (*WsOpenOrdersStatusResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsOpenOrdersStatusResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsOpenOrdersStatusResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsCancelOpenOrder Cancel all open orders on a symbol. This includes orders that are part of an order list.
@@ -716,27 +683,12 @@ func (s *WsCancelOpenOrder) RecvWindow(recvWindow int) *WsCancelOpenOrder {
 }
 
 func (s *WsCancelOpenOrder) Do(ctx context.Context) (*WsCancelOpenOrderResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsCancelOpenOrderResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsCancelOpenOrderResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsCreateOCOOrder Send in an one-cancels the other (OCO) pair, where activation of one order immediately cancels the other.
@@ -884,27 +836,12 @@ func (s *WsCreateOCOOrder) RecvWindow(recvWindow int) *WsCreateOCOOrder {
 }
 
 func (s *WsCreateOCOOrder) Do(ctx context.Context) (*OrderResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *OrderResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *OrderResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsCreateOTOOrder Places an OTO.
@@ -1044,27 +981,12 @@ func (s *WsCreateOTOOrder) RecvWindow(recvWindow int) *WsCreateOTOOrder {
 }
 
 func (s *WsCreateOTOOrder) Do(ctx context.Context) (*OrderResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *OrderResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *OrderResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsCreateOTOCOOrder Place an OTOCO.
@@ -1236,27 +1158,12 @@ func (s *WsCreateOTOCOOrder) RecvWindow(recvWindow int) *WsCreateOTOCOOrder {
 }
 
 func (s *WsCreateOTOCOOrder) Do(ctx context.Context) (*OrderResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *OrderResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *OrderResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsQueryOrderList Check execution status of an Order list.
@@ -1295,27 +1202,12 @@ func (s *WsQueryOrderList) RecvWindow(recvWindow int) *WsQueryOrderList {
 	return s
 }
 func (s *WsQueryOrderList) Do(ctx context.Context) (*WsOrderListResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsOrderListResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsOrderListResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsCancelOrderList Cancel an active order list.
@@ -1351,27 +1243,12 @@ func (s *WsCancelOrderList) RecvWindow(recvWindow int) *WsCancelOrderList {
 	return s
 }
 func (s *WsCancelOrderList) Do(ctx context.Context) (*WsOrderListResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsOrderListResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsOrderListResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsQueryOpenOrder Query execution status of all open order lists.
@@ -1390,27 +1267,12 @@ func (s *WsQueryOpenOrder) RecvWindow(recvWindow int) *WsQueryOpenOrder {
 	return s
 }
 func (s *WsQueryOpenOrder) Do(ctx context.Context) (*WsQueryOpenOrderResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsQueryOpenOrderResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsQueryOpenOrderResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsCreateSOROrder Places an order using smart order routing (SOR).
@@ -1510,27 +1372,12 @@ func (s *WsCreateSOROrder) RecvWindow(recvWindow int) *WsCreateSOROrder {
 }
 
 func (s *WsCreateSOROrder) Do(ctx context.Context) (*WsCreateSOROrderResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsCreateSOROrderResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsCreateSOROrderResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsCreateTestSOROrder Test new order creation and signature/recvWindow using smart order routing (SOR). Creates and validates a new order but does not send it into the matching engine.
@@ -1604,25 +1451,10 @@ func (s *WsCreateTestSOROrder) ComputeCommissionRates(computeCommissionRates boo
 }
 
 func (s *WsCreateTestSOROrder) Do(ctx context.Context) (*WsCreateTestSOROrderResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsCreateTestSOROrderResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsCreateTestSOROrderResponse
+	return resp, json.Unmarshal(message, &resp)
 }
@@ -1362,3 +1362,75 @@ func (s *apiTradeTestSuite) assertTestCreateTestSOROrder(r1, r2 *WsCreateTestSOR
 	r.Equal(r1.Result.Discount.DiscountAsset, r2.Result.Discount.DiscountAsset, "Discount.discountAsset")
 	r.Equal(r1.Result.Discount.Discount, r2.Result.Discount.Discount, "Discount.discount")
 }
+
+func (s *apiTradeTestSuite) TestOrderAmendKeepPriority() {
+	msg := []byte(`{
+  "id": "56374a46-3261-486b-a211-99ed972eb648",
+  "status": 200,
+  "result": {
+    "transactTime": 1741923284382,
+    "executionId": 16,
+    "amendedOrder": {
+      "symbol": "BTCUSDT",
+      "orderId": 12,
+      "orderListId": 1,
+      "origClientOrderId": "Z2IMlR79XNY5LU0tOxrWyW",
+      "clientOrderId": "6BqzW6XwHA4YwtYBeMYhEj",
+      "price": "0.00000000",
+      "qty": "5.00000000",
+      "executedQty": "0.00000000",
+      "preventedQty": "0.00000000",
+      "quoteOrderQty": "0.00000000",
+      "cumulativeQuoteQty": "0.00000000",
+      "status": "PENDING_NEW",
+      "timeInForce": "GTC",
+      "type": "MARKET",
+      "side": "BUY",
+      "selfTradePreventionMode": "NONE"
+    },
+    "listStatus": {
+      "orderListId": 1,
+      "contingencyType": "OTO",
+      "listOrderStatus": "EXECUTING",
+      "listClientOrderId": "AT7FTxZXylVSwRoZs52mt3",
+      "symbol": "BTCUSDT",
+      "orders": [
+        {
+          "symbol": "BTCUSDT",
+          "orderId": 11,
+          "clientOrderId": "Z2IMlR79XNY5LU0tOxrWyW"
+        },
+        {
+          "symbol": "BTCUSDT",
+          "orderId": 12,
+          "clientOrderId": "6BqzW6XwHA4YwtYBeMYhEj"
+        }
+      ]
+    }
+  },
+  "rateLimits": [
+    {
+      "rateLimitType": "ORDERS",
+      "interval": "SECOND",
+      "intervalNum": 10,
+      "limit": 50,
+      "count": 1
+    }
+  ]
+}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewOrderAmendKeepPriority().Symbol("BTCUSDT").
+		OrderId(12).
+		NewQty("5").
+		Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *WsOrderAmendKeepPriorityResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(testResp.Id, resp.Id, "id")
+	r.Equal(testResp.Status, resp.Status, "status")
+	r.Equal(testResp.Result.AmendedOrder, resp.Result.AmendedOrder, "amendedOrder")
+	r.Equal("OTO", resp.Result.ListStatus.ContingencyType, "contingencyType")
+	r.Len(resp.Result.ListStatus.Orders, 2)
+}
@@ -0,0 +1,180 @@
+package spot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/stretchr/testify/suite"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// lockedBuffer A buffer written by the background goroutines of a connection.
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func decodeAuditRecords(s *suite.Suite, buf *bytes.Buffer) []*core.AuditRecord {
+	var records []*core.AuditRecord
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		record := new(core.AuditRecord)
+		s.Require().NoError(json.Unmarshal([]byte(line), record))
+		records = append(records, record)
+	}
+	return records
+}
+
+type auditTestSuite struct {
+	baseHttpTestSuite
+	audit *bytes.Buffer
+	debug *bytes.Buffer
+}
+
+func TestAudit(t *testing.T) {
+	suite.Run(t, new(auditTestSuite))
+}
+
+func (s *auditTestSuite) SetupTest() {
+	s.baseHttpTestSuite.SetupTest()
+	s.audit, s.debug = new(bytes.Buffer), new(bytes.Buffer)
+	s.client.Opt.Audit = core.NewJsonlAuditSink(s.audit)
+	s.client.Opt.Logger = slog.New(slog.NewTextHandler(s.debug, &slog.HandlerOptions{Level: slog.LevelDebug}))
+}
+
+func (s *auditTestSuite) serve(body string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body))
+	}))
+	s.client.Opt.Endpoint = server.URL
+	return server
+}
+
+func (s *auditTestSuite) TestSignedRequest() {
+	server := s.serve(`{"symbol":"BTCUSDT","orderId":28,"clientOrderId":"grid-1"}`)
+	defer server.Close()
+	r := s.r()
+	r.NoError(s.client.NewPing().Do(context.Background()))
+	_, err := s.client.NewCreateOrder().Symbol("BTCUSDT").Side(core.OrderSideBUY).Type(core.OrderTypeMARKET).
+		Quantity("1").NewClientOrderId("grid-1").Do(context.Background())
+	r.NoError(err)
+	records := decodeAuditRecords(&s.Suite, s.audit)
+	r.Len(records, 1, "only signed requests are audited")
+	record := records[0]
+	r.Equal(core.CallRest, record.Kind)
+	r.Equal("/api/v3/order", record.Endpoint)
+	r.Equal(http.MethodPost, record.HttpMethod)
+	r.Equal("grid-1", record.ClientOrderId)
+	r.Equal("BTCUSDT", record.Params["symbol"])
+	r.NotEmpty(record.Params["timestamp"])
+	r.Equal(http.StatusOK, record.Status)
+	r.JSONEq(`{"symbol":"BTCUSDT","orderId":28,"clientOrderId":"grid-1"}`, string(record.Response))
+	r.NotContains(s.audit.String(), "signature")
+	r.NotContains(s.audit.String(), "YOUR_API_KEY")
+	r.NotContains(s.debug.String(), "signature=", "debug logging leaves out the signed url")
+}
+
+func (s *auditTestSuite) TestServerClientOrderId() {
+	server := s.serve(`{"symbol":"BTCUSDT","orderId":28,"clientOrderId":"6gCrw2kRUAF9CvJDGP16IP"}`)
+	defer server.Close()
+	_, err := s.client.NewCreateOrder().Symbol("BTCUSDT").Side(core.OrderSideBUY).Type(core.OrderTypeMARKET).Quantity("1").Do(context.Background())
+	s.r().NoError(err)
+	s.r().Equal("6gCrw2kRUAF9CvJDGP16IP", decodeAuditRecords(&s.Suite, s.audit)[0].ClientOrderId)
+}
+
+func (s *auditTestSuite) TestRejected() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":-2010,"msg":"Account has insufficient balance for requested action."}`))
+	}))
+	defer server.Close()
+	s.client.Opt.Endpoint = server.URL
+	_, err := s.client.NewCreateOrder().Symbol("BTCUSDT").Side(core.OrderSideBUY).Type(core.OrderTypeMARKET).Quantity("1").Do(context.Background())
+	s.r().Error(err)
+	record := decodeAuditRecords(&s.Suite, s.audit)[0]
+	s.r().Equal(http.StatusBadRequest, record.Status)
+	s.r().Equal(-2010, record.Code)
+	s.r().Contains(record.Error, "insufficient balance")
+}
+
+func (s *auditTestSuite) TestSlogSink() {
+	server := s.serve(`{"symbol":"BTCUSDT","orderId":28}`)
+	defer server.Close()
+	s.client.Opt.Audit = core.NewSlogAuditSink(slog.New(slog.NewJSONHandler(s.audit, nil)))
+	_, err := s.client.NewCreateOrder().Symbol("BTCUSDT").Side(core.OrderSideBUY).Type(core.OrderTypeMARKET).
+		Quantity("1").NewClientOrderId("grid-2").Do(context.Background())
+	s.r().NoError(err)
+	var line map[string]any
+	s.r().NoError(json.Unmarshal(s.audit.Bytes(), &line))
+	s.r().Equal("audit", line["msg"])
+	s.r().Equal("grid-2", line["client_order_id"])
+	s.r().Equal("/api/v3/order", line["endpoint"])
+}
+
+type wsAuditTestSuite struct {
+	baseWsTestSuite
+	audit *bytes.Buffer
+	debug *lockedBuffer
+}
+
+func TestWsAudit(t *testing.T) {
+	suite.Run(t, new(wsAuditTestSuite))
+}
+
+func (s *wsAuditTestSuite) SetupTest() {
+	s.baseWsTestSuite.SetupTest()
+	s.audit, s.debug = new(bytes.Buffer), new(lockedBuffer)
+	s.client.Opt.Audit = core.NewJsonlAuditSink(s.audit)
+	s.client.Opt.Logger = slog.New(slog.NewTextHandler(s.debug, &slog.HandlerOptions{Level: slog.LevelDebug}))
+}
+
+func (s *wsAuditTestSuite) TestSignedRequest() {
+	server := s.setup([]byte(`{"id":"1","status":200,"result":{"symbol":"BTCUSDT","orderId":28,"clientOrderId":"grid-1"}}`))
+	defer server.Close()
+	_, err := s.client.NewCreateOrder().Symbol("BTCUSDT").Side(core.OrderSideBUY).Type(core.OrderTypeMARKET).
+		Quantity("1").NewClientOrderId("grid-1").Do(context.Background())
+	r := s.r()
+	r.NoError(err)
+	records := decodeAuditRecords(&s.Suite, s.audit)
+	r.Len(records, 1)
+	record := records[0]
+	r.Equal(core.CallWsApi, record.Kind)
+	r.Equal("order.place", record.Endpoint)
+	r.NotEmpty(record.RequestId)
+	r.Equal("grid-1", record.ClientOrderId)
+	r.NotContains(record.Params, "apiKey")
+	r.NotContains(record.Params, "signature")
+	r.Equal(http.StatusOK, record.Status)
+	r.NotContains(s.audit.String(), "YOUR_API_KEY")
+	r.NotContains(s.debug.String(), "YOUR_API_KEY", "debug logging leaves out the signed params")
+}
+
+func (s *wsAuditTestSuite) TestRedactResponse() {
+	server := s.setup([]byte(`{"id":"1","status":200,"result":{"apiKey":"YOUR_API_KEY","authorizedSince":1649729878532}}`))
+	defer server.Close()
+	_, err := s.client.NewSessionStatus().Do(context.Background())
+	s.r().NoError(err)
+	record := decodeAuditRecords(&s.Suite, s.audit)[0]
+	s.r().JSONEq(`{"id":"1","status":200,"result":{"apiKey":"[REDACTED]","authorizedSince":1649729878532}}`, string(record.Response))
+	s.r().NotContains(s.audit.String(), "YOUR_API_KEY")
+}
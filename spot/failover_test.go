@@ -0,0 +1,123 @@
+package spot
+
+import (
+	"context"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type failoverTestSuite struct {
+	baseHttpTestSuite
+}
+
+func TestFailover(t *testing.T) {
+	suite.Run(t, new(failoverTestSuite))
+}
+
+// countingServer Answers every request with status and an empty object after delay, counting the requests in hits.
+func (s *failoverTestSuite) countingServer(status int, delay time.Duration, hits *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		time.Sleep(delay)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+}
+
+func (s *failoverTestSuite) createOrder() error {
+	_, err := s.client.NewCreateOrder().Symbol("BTCUSDT").Side(core.OrderSideBUY).Type(core.OrderTypeMARKET).Quantity("1").Do(context.Background())
+	return err
+}
+
+func (s *failoverTestSuite) TestEnvironment() {
+	r := s.r()
+	r.Equal(core.BaseURL, core.NewOptions().Endpoint)
+	r.Equal(core.TestnetURL, core.NewOptions(core.Options{Environment: core.EnvironmentTestnet}).Endpoint)
+	r.Equal(core.ApiTestnetURL, core.NewWsApiOptions(core.Options{Environment: core.EnvironmentTestnet}).Endpoint)
+	r.Equal(core.WsTestnetURL, core.NewWsOptions(core.Options{Environment: core.EnvironmentTestnet}).Endpoint)
+	r.Equal(core.DemoURL, core.NewOptions(core.Options{Environment: core.EnvironmentDemo}).Endpoint)
+	r.Equal(core.FuturesDemoUrl, core.NewFuturesOptions(core.Options{Environment: core.EnvironmentDemo}).Endpoint)
+	r.Equal(core.FuturesStreamTestnetUrl, core.NewFuturesWsOptions(core.Options{Environment: core.EnvironmentTestnet}).Endpoint)
+	r.Equal("http://localhost", core.NewOptions(core.Options{Environment: core.EnvironmentTestnet, Endpoint: "http://localhost"}).Endpoint)
+	r.Equal("demo", core.EnvironmentDemo.String())
+	r.Equal(core.DeliveryTestnetUrl, core.NewDeliveryOptions(core.Options{Environment: core.EnvironmentTestnet}).Endpoint)
+	r.Equal(core.DeliveryStreamTestnetUrl, core.NewDeliveryWsOptions(core.Options{Environment: core.EnvironmentTestnet}).Endpoint)
+	r.Equal(core.FixOrderEntryTestnetUrl, core.NewFixOptions(core.Options{Environment: core.EnvironmentTestnet}).Endpoint)
+	r.Equal(core.FixMarketDataTestnetUrl, core.NewFixMarketDataOptions(core.Options{Environment: core.EnvironmentTestnet}).Endpoint)
+	r.Equal(core.PortfolioUrl, core.NewPortfolioOptions().Endpoint)
+	// no options or portfolio margin testnet, production is never used instead
+	r.Empty(core.NewOptionsOptions(core.Options{Environment: core.EnvironmentTestnet}).Endpoint)
+	r.Empty(core.NewPortfolioWsOptions(core.Options{Environment: core.EnvironmentTestnet}).Endpoint)
+	r.Empty(core.NewDeliveryOptions(core.Options{Environment: core.EnvironmentDemo}).Endpoint)
+}
+
+func (s *failoverTestSuite) TestFailoverGet() {
+	var downHits, upHits atomic.Int32
+	down := s.countingServer(http.StatusServiceUnavailable, 0, &downHits)
+	defer down.Close()
+	up := s.countingServer(http.StatusOK, 0, &upHits)
+	defer up.Close()
+	failover := core.NewFailover(down.URL, up.URL)
+	s.client.Opt.Failover = failover
+	r := s.r()
+	r.NoError(s.client.NewPing().Do(context.Background()))
+	r.Equal([]string{up.URL, down.URL}, failover.Endpoints())
+	r.NoError(s.client.NewPing().Do(context.Background()))
+	r.Equal(int32(1), downHits.Load(), "down is skipped during the cooldown")
+	r.Equal(int32(2), upHits.Load())
+	r.Positive(failover.Latency(up.URL))
+}
+
+func (s *failoverTestSuite) TestFailoverCooldown() {
+	var downHits, upHits atomic.Int32
+	down := s.countingServer(http.StatusServiceUnavailable, 0, &downHits)
+	defer down.Close()
+	up := s.countingServer(http.StatusOK, 0, &upHits)
+	defer up.Close()
+	failover := core.NewFailover(down.URL, up.URL).Cooldown(50 * time.Millisecond)
+	s.client.Opt.Failover = failover
+	s.r().NoError(s.client.NewPing().Do(context.Background()))
+	time.Sleep(100 * time.Millisecond)
+	s.r().Equal([]string{down.URL, up.URL}, failover.Endpoints())
+}
+
+func (s *failoverTestSuite) TestFailoverOrderNotRetried() {
+	var downHits, upHits atomic.Int32
+	down := s.countingServer(http.StatusServiceUnavailable, 0, &downHits)
+	defer down.Close()
+	up := s.countingServer(http.StatusOK, 0, &upHits)
+	defer up.Close()
+	s.client.Opt.Failover = core.NewFailover(down.URL, up.URL)
+	s.r().Error(s.createOrder())
+	s.r().Equal(int32(0), upHits.Load(), "an order that reached a host is not sent again")
+	s.r().NoError(s.createOrder())
+	s.r().Equal(int32(1), upHits.Load(), "the next order skips the failed host")
+}
+
+func (s *failoverTestSuite) TestFailoverOrderDialError() {
+	var hits atomic.Int32
+	closed := s.countingServer(http.StatusOK, 0, &hits)
+	closed.Close()
+	up := s.countingServer(http.StatusOK, 0, &hits)
+	defer up.Close()
+	s.client.Opt.Failover = core.NewFailover(closed.URL, up.URL)
+	s.r().NoError(s.createOrder())
+	s.r().Equal(int32(1), hits.Load())
+}
+
+func (s *failoverTestSuite) TestFailoverLatency() {
+	var hits atomic.Int32
+	slow := s.countingServer(http.StatusOK, 50*time.Millisecond, &hits)
+	defer slow.Close()
+	fast := s.countingServer(http.StatusOK, 0, &hits)
+	defer fast.Close()
+	failover := core.NewFailover(slow.URL, fast.URL).Selection(core.SelectLatency)
+	s.r().Equal([]string{slow.URL, fast.URL}, failover.Endpoints())
+	failover.Probe(context.Background(), http.DefaultClient, "/api/v3/ping")
+	s.r().Equal([]string{fast.URL, slow.URL}, failover.Endpoints())
+}
@@ -175,6 +175,16 @@ func (c *Client) NewCancelReplace() *CancelReplace {
 	return &CancelReplace{c: c, r: c.SetReq("/api/v3/order/cancelReplace", http.MethodPost, core.AuthSigned)}
 }
 
+// NewOrderAmendKeepPriority Order Amend Keep Priority (TRADE)
+func (c *Client) NewOrderAmendKeepPriority() *OrderAmendKeepPriority {
+	return &OrderAmendKeepPriority{c: c, r: c.SetReq("/api/v3/order/amend/keepPriority", http.MethodPut, core.AuthSigned)}
+}
+
+// NewOrderAmendments Query Order Amendments (USER_DATA)
+func (c *Client) NewOrderAmendments() *OrderAmendments {
+	return &OrderAmendments{c: c, r: c.SetReq("/api/v3/order/amendments", http.MethodGet, core.AuthSigned)}
+}
+
 // NewOpenOrders Current open orders (USER_DATA)
 func (c *Client) NewOpenOrders() *OpenOrders {
 	return &OpenOrders{c: c, r: c.SetReq("/api/v3/openOrders", http.MethodGet, core.AuthSigned)}
@@ -259,3 +269,98 @@ func (c *Client) NewCloseUserDataStream() *CloseUserDataStream {
 func (c *Client) NewPingUserDataStream() *PingUserDataStream {
 	return &PingUserDataStream{c: c, r: c.SetReq("/api/v3/userDataStream", http.MethodPut, core.AuthApiKey)}
 }
+
+// NewConvertExchangeInfo List All Convert Pairs
+func (c *Client) NewConvertExchangeInfo() *ConvertExchangeInfo {
+	return &ConvertExchangeInfo{c: c, r: c.SetReq("/sapi/v1/convert/exchangeInfo", http.MethodGet)}
+}
+
+// NewConvertAssetInfo Query order quantity precision per asset (USER_DATA)
+func (c *Client) NewConvertAssetInfo() *ConvertAssetInfo {
+	return &ConvertAssetInfo{c: c, r: c.SetReq("/sapi/v1/convert/assetInfo", http.MethodGet, core.AuthSigned)}
+}
+
+// NewGetQuote Send Quote Request (USER_DATA)
+func (c *Client) NewGetQuote() *GetQuote {
+	return &GetQuote{c: c, r: c.SetReq("/sapi/v1/convert/getQuote", http.MethodPost, core.AuthSigned)}
+}
+
+// NewAcceptQuote Accept Quote (TRADE)
+func (c *Client) NewAcceptQuote() *AcceptQuote {
+	return &AcceptQuote{c: c, r: c.SetReq("/sapi/v1/convert/acceptQuote", http.MethodPost, core.AuthSigned)}
+}
+
+// NewConvertOrderStatus Order status (USER_DATA)
+func (c *Client) NewConvertOrderStatus() *ConvertOrderStatus {
+	return &ConvertOrderStatus{c: c, r: c.SetReq("/sapi/v1/convert/orderStatus", http.MethodGet, core.AuthSigned)}
+}
+
+// NewConvertTradeFlow Get Convert Trade History (USER_DATA)
+func (c *Client) NewConvertTradeFlow() *ConvertTradeFlow {
+	return &ConvertTradeFlow{c: c, r: c.SetReq("/sapi/v1/convert/tradeFlow", http.MethodGet, core.AuthSigned)}
+}
+
+// NewConvertLimitPlaceOrder Place limit order (USER_DATA)
+func (c *Client) NewConvertLimitPlaceOrder() *ConvertLimitPlaceOrder {
+	return &ConvertLimitPlaceOrder{c: c, r: c.SetReq("/sapi/v1/convert/limit/placeOrder", http.MethodPost, core.AuthSigned)}
+}
+
+// NewConvertLimitCancelOrder Cancel limit order (USER_DATA)
+func (c *Client) NewConvertLimitCancelOrder() *ConvertLimitCancelOrder {
+	return &ConvertLimitCancelOrder{c: c, r: c.SetReq("/sapi/v1/convert/limit/cancelOrder", http.MethodPost, core.AuthSigned)}
+}
+
+// NewConvertLimitOpenOrders Query limit open orders (USER_DATA)
+func (c *Client) NewConvertLimitOpenOrders() *ConvertLimitOpenOrders {
+	return &ConvertLimitOpenOrders{c: c, r: c.SetReq("/sapi/v1/convert/limit/queryOpenOrders", http.MethodGet, core.AuthSigned)}
+}
+
+// NewFlexibleProductList Get Simple Earn Flexible Product List (USER_DATA)
+func (c *Client) NewFlexibleProductList() *FlexibleProductList {
+	return &FlexibleProductList{c: c, r: c.SetReq("/sapi/v1/simple-earn/flexible/list", http.MethodGet, core.AuthSigned)}
+}
+
+// NewLockedProductList Get Simple Earn Locked Product List (USER_DATA)
+func (c *Client) NewLockedProductList() *LockedProductList {
+	return &LockedProductList{c: c, r: c.SetReq("/sapi/v1/simple-earn/locked/list", http.MethodGet, core.AuthSigned)}
+}
+
+// NewSubscribeFlexible Subscribe Flexible Product (TRADE)
+func (c *Client) NewSubscribeFlexible() *SubscribeFlexible {
+	return &SubscribeFlexible{c: c, r: c.SetReq("/sapi/v1/simple-earn/flexible/subscribe", http.MethodPost, core.AuthSigned)}
+}
+
+// NewSubscribeLocked Subscribe Locked Product (TRADE)
+func (c *Client) NewSubscribeLocked() *SubscribeLocked {
+	return &SubscribeLocked{c: c, r: c.SetReq("/sapi/v1/simple-earn/locked/subscribe", http.MethodPost, core.AuthSigned)}
+}
+
+// NewRedeemFlexible Redeem Flexible Product (TRADE)
+func (c *Client) NewRedeemFlexible() *RedeemFlexible {
+	return &RedeemFlexible{c: c, r: c.SetReq("/sapi/v1/simple-earn/flexible/redeem", http.MethodPost, core.AuthSigned)}
+}
+
+// NewRedeemLocked Redeem Locked Product (TRADE)
+func (c *Client) NewRedeemLocked() *RedeemLocked {
+	return &RedeemLocked{c: c, r: c.SetReq("/sapi/v1/simple-earn/locked/redeem", http.MethodPost, core.AuthSigned)}
+}
+
+// NewFlexiblePosition Get Flexible Product Position (USER_DATA)
+func (c *Client) NewFlexiblePosition() *FlexiblePosition {
+	return &FlexiblePosition{c: c, r: c.SetReq("/sapi/v1/simple-earn/flexible/position", http.MethodGet, core.AuthSigned)}
+}
+
+// NewLockedPosition Get Locked Product Position (USER_DATA)
+func (c *Client) NewLockedPosition() *LockedPosition {
+	return &LockedPosition{c: c, r: c.SetReq("/sapi/v1/simple-earn/locked/position", http.MethodGet, core.AuthSigned)}
+}
+
+// NewFlexibleRewardsHistory Get Flexible Rewards History (USER_DATA)
+func (c *Client) NewFlexibleRewardsHistory() *FlexibleRewardsHistory {
+	return &FlexibleRewardsHistory{c: c, r: c.SetReq("/sapi/v1/simple-earn/flexible/history/rewardsRecord", http.MethodGet, core.AuthSigned)}
+}
+
+// NewLockedRewardsHistory Get Locked Rewards History (USER_DATA)
+func (c *Client) NewLockedRewardsHistory() *LockedRewardsHistory {
+	return &LockedRewardsHistory{c: c, r: c.SetReq("/sapi/v1/simple-earn/locked/history/rewardsRecord", http.MethodGet, core.AuthSigned)}
+}
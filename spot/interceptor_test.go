@@ -0,0 +1,206 @@
+package spot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/gorilla/websocket"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+type interceptorTestSuite struct {
+	baseHttpTestSuite
+}
+
+func TestInterceptor(t *testing.T) {
+	suite.Run(t, new(interceptorTestSuite))
+}
+
+func (s *interceptorTestSuite) TestChain() {
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{"symbol":"BTCUSDT","orderId":1}`))
+	}))
+	defer server.Close()
+	s.client.Opt.Endpoint = server.URL
+	var calls []string
+	trace := func(name string) core.Interceptor {
+		return func(ctx context.Context, r *core.Request, next core.Invoker) (*core.Response, error) {
+			calls = append(calls, name+" "+r.Method()+" "+r.Path())
+			resp, err := next(ctx, r)
+			calls = append(calls, name+" "+http.StatusText(resp.Status))
+			return resp, err
+		}
+	}
+	tag := func(ctx context.Context, r *core.Request, next core.Invoker) (*core.Response, error) {
+		if r.AuthType() == core.AuthSigned {
+			r.Set("newClientOrderId", "grid-"+r.Params()["symbol"])
+		}
+		return next(ctx, r)
+	}
+	s.client.Use(trace("outer"), core.ChainInterceptors(trace("inner"), tag))
+	resp, err := s.client.NewCreateOrder().Symbol("BTCUSDT").Side(core.OrderSideBUY).Type(core.OrderTypeMARKET).Quantity("1").Do(context.Background())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(1, resp.OrderId)
+	r.Equal([]string{"outer POST /api/v3/order", "inner POST /api/v3/order", "inner OK", "outer OK"}, calls)
+	r.Equal("grid-BTCUSDT", query.Get("newClientOrderId"))
+	r.NotEmpty(query.Get("signature"))
+}
+
+func (s *interceptorTestSuite) TestShortCircuit() {
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+	}))
+	defer server.Close()
+	s.client.Opt.Endpoint = server.URL
+	errOpen := errors.New("circuit open")
+	s.client.Use(func(ctx context.Context, r *core.Request, next core.Invoker) (*core.Response, error) {
+		if r.Path() == "/api/v3/time" {
+			return &core.Response{Status: http.StatusOK, Body: []byte(`{"serverTime":1499827319559}`)}, nil
+		}
+		return nil, errOpen
+	})
+	resp, err := s.client.NewServerTime().Do(context.Background())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int64(1499827319559), resp.ServerTime)
+	r.ErrorIs(s.client.NewPing().Do(context.Background()), errOpen)
+	r.Equal(0, hits)
+}
+
+func (s *interceptorTestSuite) TestUseCopiesChain() {
+	pass := func(ctx context.Context, r *core.Request, next core.Invoker) (*core.Response, error) {
+		return next(ctx, r)
+	}
+	shared := make([]core.Interceptor, 1, 4)
+	shared[0] = pass
+	s.client.Opt.Interceptors = shared
+	s.client.Use(pass)
+	r := s.r()
+	r.Len(s.client.Opt.Interceptors, 2)
+	r.Equal(1, len(shared))
+	r.Nil(shared[:2][1], "the backing array of the caller's slice is not written")
+}
+
+func (s *interceptorTestSuite) TestGuardAfterChain() {
+	errRejected := errors.New("rejected")
+	s.client.Opt.Guard = guardFunc(func(intent *core.OrderIntent) error {
+		if intent.Params["quantity"] != "1" {
+			return errRejected
+		}
+		return nil
+	})
+	s.client.Use(func(ctx context.Context, r *core.Request, next core.Invoker) (*core.Response, error) {
+		r.Set("quantity", "100")
+		return next(ctx, r)
+	})
+	_, err := s.client.NewCreateOrder().Symbol("BTCUSDT").Side(core.OrderSideBUY).Type(core.OrderTypeMARKET).Quantity("1").Do(context.Background())
+	s.r().ErrorIs(err, errRejected, "the guard checks the request as modified by interceptors")
+}
+
+type guardFunc func(intent *core.OrderIntent) error
+
+func (f guardFunc) Check(intent *core.OrderIntent) error {
+	return f(intent)
+}
+
+type wsInterceptorTestSuite struct {
+	baseWsTestSuite
+}
+
+func TestWsInterceptor(t *testing.T) {
+	suite.Run(t, new(wsInterceptorTestSuite))
+}
+
+func (s *wsInterceptorTestSuite) TestObserve() {
+	server := s.setup([]byte(`{"id":"1","status":200,"result":{}}`))
+	defer server.Close()
+	var method string
+	var response []byte
+	s.client.Use(func(ctx context.Context, r *core.WsRequest, next core.WsInvoker) ([]byte, error) {
+		method = r.Method
+		message, err := next(ctx, r)
+		response = message
+		return message, err
+	})
+	resp, err := s.client.NewPing().Do(context.Background())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(200, resp.Status)
+	r.Equal("ping", method)
+	r.JSONEq(`{"id":"1","status":200,"result":{}}`, string(response))
+}
+
+func (s *wsInterceptorTestSuite) TestShortCircuit() {
+	s.mockClient("ws://127.0.0.1:1")
+	s.client.Use(func(ctx context.Context, r *core.WsRequest, next core.WsInvoker) ([]byte, error) {
+		return []byte(`{"id":"1","status":200,"result":{}}`), nil
+	})
+	resp, err := s.client.NewPing().Do(context.Background())
+	s.r().NoError(err)
+	s.r().Equal(200, resp.Status)
+}
+
+func (s *wsInterceptorTestSuite) TestRetrySignsAfresh() {
+	received := make(chan *core.WsRequest, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{CheckOrigin: func(r *http.Request) bool { return true }}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var req core.WsRequest
+		decoder := json.NewDecoder(bytes.NewReader(message))
+		decoder.UseNumber()
+		if err := decoder.Decode(&req); err != nil {
+			return
+		}
+		received <- &req
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"id":"1","status":200,"result":{"symbol":"BTCUSDT","orderId":28}}`))
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+	s.mockClient("ws" + server.URL[4:])
+	var seen []map[string]any
+	s.client.Use(func(ctx context.Context, r *core.WsRequest, next core.WsInvoker) ([]byte, error) {
+		if _, err := next(ctx, r); err != nil {
+			return nil, err
+		}
+		seen = append(seen, r.Params)
+		return next(ctx, r)
+	})
+	_, err := s.client.NewCreateOrder().Symbol("BTCUSDT").Side(core.OrderSideBUY).Type(core.OrderTypeMARKET).
+		Quantity("1").Do(context.Background())
+	r := s.r()
+	r.NoError(err)
+	r.Len(seen, 1)
+	r.NotContains(seen[0], "apiKey", "interceptors do not see the api key")
+	r.NotContains(seen[0], "signature", "interceptors do not see the signature")
+	for i := 0; i < 2; i++ {
+		req := <-received
+		sign := req.Params["signature"]
+		delete(req.Params, "signature")
+		expected, err := core.HmacSign("YOUR_API_SECRET", core.SortMap(req.Params))
+		r.NoError(err)
+		r.Equal(expected, sign, "request %d signed over its own params", i)
+		r.Equal("YOUR_API_KEY", req.Params["apiKey"])
+	}
+}
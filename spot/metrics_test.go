@@ -0,0 +1,183 @@
+package spot
+
+import (
+	"context"
+	"fmt"
+	"github.com/gorilla/websocket"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recordedMessage struct {
+	stream string
+	lag    time.Duration
+	queued int
+}
+
+type recordingMetrics struct {
+	mu        sync.Mutex
+	calls     []*core.Call
+	results   []*core.CallResult
+	connected []bool
+	streams   []string
+	messages  []recordedMessage
+}
+
+func (m *recordingMetrics) StartCall(ctx context.Context, call *core.Call) (context.Context, func(*core.CallResult)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, call)
+	return ctx, func(result *core.CallResult) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.results = append(m.results, result)
+	}
+}
+
+func (m *recordingMetrics) StreamConnected(stream string, reconnect bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.streams = append(m.streams, stream)
+	m.connected = append(m.connected, reconnect)
+}
+
+func (m *recordingMetrics) StreamMessage(stream string, lag time.Duration, queued int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages = append(m.messages, recordedMessage{stream: stream, lag: lag, queued: queued})
+}
+
+func (m *recordingMetrics) recorded() []recordedMessage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]recordedMessage(nil), m.messages...)
+}
+
+type metricsTestSuite struct {
+	baseHttpTestSuite
+	metrics *recordingMetrics
+}
+
+func TestMetrics(t *testing.T) {
+	suite.Run(t, new(metricsTestSuite))
+}
+
+func (s *metricsTestSuite) SetupTest() {
+	s.baseHttpTestSuite.SetupTest()
+	s.metrics = new(recordingMetrics)
+	s.client.Opt.Metrics = s.metrics
+}
+
+func (s *metricsTestSuite) TestRestCall() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":-1121,"msg":"Invalid symbol."}`))
+	}))
+	defer server.Close()
+	s.client.Opt.Endpoint = server.URL
+	r := s.r()
+	r.Error(s.client.NewPing().Do(context.Background()))
+	r.Equal([]*core.Call{{Kind: core.CallRest, Endpoint: "/api/v3/ping", HttpMethod: http.MethodGet, AuthType: core.AuthNone}}, s.metrics.calls)
+	r.Len(s.metrics.results, 1)
+	result := s.metrics.results[0]
+	r.Equal(http.StatusBadRequest, result.Status)
+	r.Equal(-1121, result.Code)
+	r.Error(result.Err)
+	r.Positive(result.Duration)
+}
+
+type wsMetricsTestSuite struct {
+	baseWsTestSuite
+	metrics *recordingMetrics
+}
+
+func TestWsMetrics(t *testing.T) {
+	suite.Run(t, new(wsMetricsTestSuite))
+}
+
+func (s *wsMetricsTestSuite) SetupTest() {
+	s.baseWsTestSuite.SetupTest()
+	s.metrics = new(recordingMetrics)
+	s.client.Opt.Metrics = s.metrics
+}
+
+// onceServer Sends msgs once on every connection, then waits for the client to go away.
+func (s *wsMetricsTestSuite) onceServer(msgs ...string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{CheckOrigin: func(r *http.Request) bool { return true }}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for _, msg := range msgs {
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
+				return
+			}
+		}
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	s.mockClient("ws" + server.URL[4:])
+	return server
+}
+
+func (s *wsMetricsTestSuite) TestWsApiCall() {
+	server := s.setup([]byte(`{"id":"1","status":400,"error":{"code":-1100,"msg":"Illegal characters found in parameter."}}`))
+	defer server.Close()
+	_, err := s.client.NewPing().Do(context.Background())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*core.Call{{Kind: core.CallWsApi, Endpoint: "ping", AuthType: core.AuthNone}}, s.metrics.calls)
+	r.Len(s.metrics.results, 1)
+	r.Equal(http.StatusBadRequest, s.metrics.results[0].Status)
+	r.Equal(-1100, s.metrics.results[0].Code)
+}
+
+func (s *wsMetricsTestSuite) TestStream() {
+	eventTime := time.Now().Add(-time.Second).UnixMilli()
+	server := s.onceServer(fmt.Sprintf(`{"e":"aggTrade","E":%d,"s":"BTCUSDT","a":1}`, eventTime))
+	defer server.Close()
+	r := s.r()
+	for i := 0; i < 2; i++ {
+		stream := s.client.NewWebsocketStreams().SubscribeAggTrade("BTCUSDT")
+		messages, _ := stream.Do(context.Background())
+		<-messages
+		r.Eventually(func() bool { return len(s.metrics.recorded()) == i+1 }, 5*time.Second, 10*time.Millisecond)
+		r.NoError(stream.Close())
+		r.NoError(stream.Wait())
+	}
+	r.Equal([]string{"btcusdt@aggTrade", "btcusdt@aggTrade"}, s.metrics.streams)
+	r.Equal([]bool{false, true}, s.metrics.connected, "the second connection is a reconnect")
+	message := s.metrics.recorded()[0]
+	r.Equal("btcusdt@aggTrade", message.stream)
+	r.GreaterOrEqual(message.lag, time.Second)
+	r.Less(message.lag, time.Minute)
+}
+
+func (s *wsMetricsTestSuite) TestCombinedStream() {
+	eventTime := time.Now().Add(-time.Second).UnixMilli()
+	server := s.onceServer(
+		fmt.Sprintf(`{"stream":"btcusdt@aggTrade","data":{"e":"aggTrade","E":%d,"s":"BTCUSDT","a":1}}`, eventTime),
+		fmt.Sprintf(`{"stream":"ethusdt@aggTrade","data":{"e":"aggTrade","E":%d,"s":"ETHUSDT","a":2}}`, eventTime),
+	)
+	defer server.Close()
+	stream := s.client.NewWebsocketStreams().SubscribeCombinedAggTrade([]string{"BTCUSDT", "ETHUSDT"})
+	messages, _ := stream.Do(context.Background())
+	defer stream.Close()
+	r := s.r()
+	r.Eventually(func() bool { return len(s.metrics.recorded()) == 2 }, 5*time.Second, 10*time.Millisecond)
+	recorded := s.metrics.recorded()
+	r.Equal("btcusdt@aggTrade/ethusdt@aggTrade", recorded[0].stream)
+	r.GreaterOrEqual(recorded[0].lag, time.Second)
+	r.Equal(2, recorded[1].queued, "nothing consumed yet")
+	<-messages
+}
@@ -0,0 +1,424 @@
+package spot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/shopspring/decimal"
+	"time"
+)
+
+// ErrQuoteExpired is returned by AcceptQuote when the quote passed to Quote is past its validTimestamp.
+var ErrQuoteExpired = errors.New("convert: quote expired")
+
+// ConvertExchangeInfo Query for all convertible token pairs and the tokens’ respective upper/lower limits.
+type ConvertExchangeInfo struct {
+	c *Client
+	r *core.Request
+}
+
+type ConvertExchangeInfoResponse struct {
+	FromAsset          string          `json:"fromAsset"`
+	ToAsset            string          `json:"toAsset"`
+	FromAssetMinAmount decimal.Decimal `json:"fromAssetMinAmount"`
+	FromAssetMaxAmount decimal.Decimal `json:"fromAssetMaxAmount"`
+	ToAssetMinAmount   decimal.Decimal `json:"toAssetMinAmount"`
+	ToAssetMaxAmount   decimal.Decimal `json:"toAssetMaxAmount"`
+}
+
+func (s *ConvertExchangeInfo) FromAsset(fromAsset string) *ConvertExchangeInfo {
+	s.r.Set("fromAsset", fromAsset)
+	return s
+}
+
+func (s *ConvertExchangeInfo) ToAsset(toAsset string) *ConvertExchangeInfo {
+	s.r.Set("toAsset", toAsset)
+	return s
+}
+
+func (s *ConvertExchangeInfo) Do(ctx context.Context) ([]*ConvertExchangeInfoResponse, error) {
+	resp := make([]*ConvertExchangeInfoResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// ConvertAssetInfo Query for supported asset’s precision information.
+type ConvertAssetInfo struct {
+	c *Client
+	r *core.Request
+}
+
+type ConvertAssetInfoResponse struct {
+	Asset    string `json:"asset"`
+	Fraction int    `json:"fraction"`
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *ConvertAssetInfo) RecvWindow(recvWindow int64) *ConvertAssetInfo {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *ConvertAssetInfo) Do(ctx context.Context) ([]*ConvertAssetInfoResponse, error) {
+	resp := make([]*ConvertAssetInfoResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// GetQuote Request a quote for the requested token pairs. Either fromAmount or toAmount should be sent.
+type GetQuote struct {
+	c *Client
+	r *core.Request
+}
+
+type GetQuoteResponse struct {
+	QuoteId        string          `json:"quoteId"`
+	Ratio          decimal.Decimal `json:"ratio"`
+	InverseRatio   decimal.Decimal `json:"inverseRatio"`
+	ValidTimestamp int64           `json:"validTimestamp"`
+	ToAmount       decimal.Decimal `json:"toAmount"`
+	FromAmount     decimal.Decimal `json:"fromAmount"`
+}
+
+// Expired Reports whether the quote can no longer be accepted at now.
+func (r *GetQuoteResponse) Expired(now time.Time) bool {
+	return now.UnixMilli() >= r.ValidTimestamp
+}
+
+// TimeLeft The time remaining until the quote expires, zero or negative once it has.
+func (r *GetQuoteResponse) TimeLeft(now time.Time) time.Duration {
+	return time.UnixMilli(r.ValidTimestamp).Sub(now)
+}
+
+func (s *GetQuote) FromAsset(fromAsset string) *GetQuote {
+	s.r.Set("fromAsset", fromAsset)
+	return s
+}
+
+func (s *GetQuote) ToAsset(toAsset string) *GetQuote {
+	s.r.Set("toAsset", toAsset)
+	return s
+}
+
+// FromAmount When specified, it is the amount you will be debited after the conversion
+func (s *GetQuote) FromAmount(fromAmount string) *GetQuote {
+	s.r.Set("fromAmount", fromAmount)
+	return s
+}
+
+// ToAmount When specified, it is the amount you will be credited after the conversion
+func (s *GetQuote) ToAmount(toAmount string) *GetQuote {
+	s.r.Set("toAmount", toAmount)
+	return s
+}
+
+// WalletType SPOT, FUNDING or SPOT_FUNDING. Default is SPOT
+func (s *GetQuote) WalletType(walletType string) *GetQuote {
+	s.r.Set("walletType", walletType)
+	return s
+}
+
+// ValidTime 10s, 30s, 1m, default 10s
+func (s *GetQuote) ValidTime(validTime string) *GetQuote {
+	s.r.Set("validTime", validTime)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *GetQuote) RecvWindow(recvWindow int64) *GetQuote {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *GetQuote) Do(ctx context.Context) (*GetQuoteResponse, error) {
+	resp := new(GetQuoteResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// AcceptQuote Accept the offered quote by quote ID.
+type AcceptQuote struct {
+	c              *Client
+	r              *core.Request
+	validTimestamp int64
+}
+
+type AcceptQuoteResponse struct {
+	OrderId     string `json:"orderId"`
+	CreateTime  int64  `json:"createTime"`
+	OrderStatus string `json:"orderStatus"` // PROCESS, ACCEPT_SUCCESS, SUCCESS, FAIL
+}
+
+func (s *AcceptQuote) QuoteId(quoteId string) *AcceptQuote {
+	s.r.Set("quoteId", quoteId)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *AcceptQuote) RecvWindow(recvWindow int64) *AcceptQuote {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+// Quote Accept the given quote, Do fails with ErrQuoteExpired without calling the API once it has expired.
+func (s *AcceptQuote) Quote(quote *GetQuoteResponse) *AcceptQuote {
+	s.r.Set("quoteId", quote.QuoteId)
+	s.validTimestamp = quote.ValidTimestamp
+	return s
+}
+
+func (s *AcceptQuote) Do(ctx context.Context) (*AcceptQuoteResponse, error) {
+	if s.validTimestamp > 0 && time.Now().UnixMilli() >= s.validTimestamp {
+		return nil, ErrQuoteExpired
+	}
+	resp := new(AcceptQuoteResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// ConvertOrderStatus Query order status by order ID or quote ID.
+type ConvertOrderStatus struct {
+	c *Client
+	r *core.Request
+}
+
+type ConvertOrderStatusResponse struct {
+	OrderId      int64           `json:"orderId"`
+	OrderStatus  string          `json:"orderStatus"`
+	FromAsset    string          `json:"fromAsset"`
+	FromAmount   decimal.Decimal `json:"fromAmount"`
+	ToAsset      string          `json:"toAsset"`
+	ToAmount     decimal.Decimal `json:"toAmount"`
+	Ratio        decimal.Decimal `json:"ratio"`
+	InverseRatio decimal.Decimal `json:"inverseRatio"`
+	CreateTime   int64           `json:"createTime"`
+}
+
+func (s *ConvertOrderStatus) OrderId(orderId string) *ConvertOrderStatus {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *ConvertOrderStatus) QuoteId(quoteId string) *ConvertOrderStatus {
+	s.r.Set("quoteId", quoteId)
+	return s
+}
+
+func (s *ConvertOrderStatus) Do(ctx context.Context) (*ConvertOrderStatusResponse, error) {
+	resp := new(ConvertOrderStatusResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// ConvertTradeFlow Get Convert Trade History. The max interval between startTime and endTime is 30 days.
+type ConvertTradeFlow struct {
+	c *Client
+	r *core.Request
+}
+
+type ConvertTradeFlowResponse struct {
+	List      []*ConvertTrade `json:"list"`
+	StartTime int64           `json:"startTime"`
+	EndTime   int64           `json:"endTime"`
+	Limit     int             `json:"limit"`
+	MoreData  bool            `json:"moreData"`
+}
+
+type ConvertTrade struct {
+	QuoteId      string          `json:"quoteId"`
+	OrderId      int64           `json:"orderId"`
+	OrderStatus  string          `json:"orderStatus"`
+	FromAsset    string          `json:"fromAsset"`
+	FromAmount   decimal.Decimal `json:"fromAmount"`
+	ToAsset      string          `json:"toAsset"`
+	ToAmount     decimal.Decimal `json:"toAmount"`
+	Ratio        decimal.Decimal `json:"ratio"`
+	InverseRatio decimal.Decimal `json:"inverseRatio"`
+	CreateTime   int64           `json:"createTime"`
+}
+
+func (s *ConvertTradeFlow) StartTime(startTime int64) *ConvertTradeFlow {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *ConvertTradeFlow) EndTime(endTime int64) *ConvertTradeFlow {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Limit Default 100, Max 1000
+func (s *ConvertTradeFlow) Limit(limit int) *ConvertTradeFlow {
+	s.r.Set("limit", limit)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *ConvertTradeFlow) RecvWindow(recvWindow int64) *ConvertTradeFlow {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *ConvertTradeFlow) Do(ctx context.Context) (*ConvertTradeFlowResponse, error) {
+	resp := new(ConvertTradeFlowResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// ConvertLimitPlaceOrder Enable users to place a limit order. Either baseAmount or quoteAmount should be sent.
+type ConvertLimitPlaceOrder struct {
+	c *Client
+	r *core.Request
+}
+
+type ConvertLimitOrderResponse struct {
+	QuoteId        string          `json:"quoteId"`
+	OrderId        int64           `json:"orderId"`
+	Status         string          `json:"status"`
+	Ratio          decimal.Decimal `json:"ratio"`
+	InverseRatio   decimal.Decimal `json:"inverseRatio"`
+	ValidTimestamp int64           `json:"validTimestamp"`
+	ToAmount       decimal.Decimal `json:"toAmount"`
+	FromAmount     decimal.Decimal `json:"fromAmount"`
+}
+
+func (s *ConvertLimitPlaceOrder) BaseAsset(baseAsset string) *ConvertLimitPlaceOrder {
+	s.r.Set("baseAsset", baseAsset)
+	return s
+}
+
+func (s *ConvertLimitPlaceOrder) QuoteAsset(quoteAsset string) *ConvertLimitPlaceOrder {
+	s.r.Set("quoteAsset", quoteAsset)
+	return s
+}
+
+// LimitPrice Symbol limit price (from baseAsset to quoteAsset)
+func (s *ConvertLimitPlaceOrder) LimitPrice(limitPrice string) *ConvertLimitPlaceOrder {
+	s.r.Set("limitPrice", limitPrice)
+	return s
+}
+
+// BaseAmount Base asset amount
+func (s *ConvertLimitPlaceOrder) BaseAmount(baseAmount string) *ConvertLimitPlaceOrder {
+	s.r.Set("baseAmount", baseAmount)
+	return s
+}
+
+// QuoteAmount Quote asset amount
+func (s *ConvertLimitPlaceOrder) QuoteAmount(quoteAmount string) *ConvertLimitPlaceOrder {
+	s.r.Set("quoteAmount", quoteAmount)
+	return s
+}
+
+// Side BUY or SELL
+func (s *ConvertLimitPlaceOrder) Side(side core.OrderSideEnum) *ConvertLimitPlaceOrder {
+	s.r.Set("side", side)
+	return s
+}
+
+// WalletType SPOT, FUNDING or SPOT_FUNDING. Default is SPOT
+func (s *ConvertLimitPlaceOrder) WalletType(walletType string) *ConvertLimitPlaceOrder {
+	s.r.Set("walletType", walletType)
+	return s
+}
+
+// ExpiredType 1_D, 3_D, 7_D, 30_D
+func (s *ConvertLimitPlaceOrder) ExpiredType(expiredType string) *ConvertLimitPlaceOrder {
+	s.r.Set("expiredType", expiredType)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *ConvertLimitPlaceOrder) RecvWindow(recvWindow int64) *ConvertLimitPlaceOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *ConvertLimitPlaceOrder) Do(ctx context.Context) (*ConvertLimitOrderResponse, error) {
+	resp := new(ConvertLimitOrderResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// ConvertLimitCancelOrder Enable users to cancel a limit order.
+type ConvertLimitCancelOrder struct {
+	c *Client
+	r *core.Request
+}
+
+type ConvertLimitCancelResponse struct {
+	OrderId int64  `json:"orderId"`
+	Status  string `json:"status"`
+}
+
+func (s *ConvertLimitCancelOrder) OrderId(orderId int64) *ConvertLimitCancelOrder {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *ConvertLimitCancelOrder) RecvWindow(recvWindow int64) *ConvertLimitCancelOrder {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *ConvertLimitCancelOrder) Do(ctx context.Context) (*ConvertLimitCancelResponse, error) {
+	resp := new(ConvertLimitCancelResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// ConvertLimitOpenOrders Request a limit order list of the user.
+type ConvertLimitOpenOrders struct {
+	c *Client
+	r *core.Request
+}
+
+type ConvertLimitOpenOrdersResponse struct {
+	List []*ConvertLimitOrder `json:"list"`
+}
+
+type ConvertLimitOrder struct {
+	QuoteId          string          `json:"quoteId"`
+	OrderId          int64           `json:"orderId"`
+	OrderStatus      string          `json:"orderStatus"`
+	FromAsset        string          `json:"fromAsset"`
+	FromAmount       decimal.Decimal `json:"fromAmount"`
+	ToAsset          string          `json:"toAsset"`
+	ToAmount         decimal.Decimal `json:"toAmount"`
+	Ratio            decimal.Decimal `json:"ratio"`
+	InverseRatio     decimal.Decimal `json:"inverseRatio"`
+	CreateTime       int64           `json:"createTime"`
+	ExpiredTimestamp int64           `json:"expiredTimestamp"`
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *ConvertLimitOpenOrders) RecvWindow(recvWindow int64) *ConvertLimitOpenOrders {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *ConvertLimitOpenOrders) Do(ctx context.Context) (*ConvertLimitOpenOrdersResponse, error) {
+	resp := new(ConvertLimitOpenOrdersResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
@@ -0,0 +1,153 @@
+package spot
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type spotConvertTestSuite struct {
+	baseHttpTestSuite
+}
+
+func TestSpotConvert(t *testing.T) {
+	suite.Run(t, new(spotConvertTestSuite))
+}
+
+func (s *spotConvertTestSuite) TestConvertExchangeInfo() {
+	msg := []byte(`[
+  {
+    "fromAsset": "BTC",
+    "toAsset": "USDT",
+    "fromAssetMinAmount": "0.0004",
+    "fromAssetMaxAmount": "50",
+    "toAssetMinAmount": "20",
+    "toAssetMaxAmount": "2500000"
+  }
+]`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewConvertExchangeInfo().FromAsset("BTC").ToAsset("USDT").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp []*ConvertExchangeInfoResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(*testResp[0], *resp[0])
+}
+
+func (s *spotConvertTestSuite) TestGetQuote() {
+	msg := []byte(`{
+  "quoteId": "12415572564",
+  "ratio": "38163.7",
+  "inverseRatio": "0.0000262",
+  "validTimestamp": 1623319461670,
+  "toAmount": "3816.37",
+  "fromAmount": "0.1"
+}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewGetQuote().FromAsset("BTC").ToAsset("USDT").FromAmount("0.1").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *GetQuoteResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(*testResp, *resp)
+	r.False(resp.Expired(time.UnixMilli(1623319461669)))
+	r.True(resp.Expired(time.UnixMilli(1623319461670)))
+	r.Equal(time.Second, resp.TimeLeft(time.UnixMilli(1623319460670)))
+}
+
+func (s *spotConvertTestSuite) TestAcceptQuote() {
+	msg := []byte(`{
+  "orderId": "933256278426274426",
+  "createTime": 1623381330472,
+  "orderStatus": "PROCESS"
+}`)
+	server := s.setup(msg)
+	defer server.Close()
+	quote := &GetQuoteResponse{QuoteId: "12415572564", ValidTimestamp: time.Now().Add(10 * time.Second).UnixMilli()}
+	resp, err := s.client.NewAcceptQuote().Quote(quote).Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *AcceptQuoteResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(*testResp, *resp)
+}
+
+func (s *spotConvertTestSuite) TestAcceptExpiredQuote() {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer server.Close()
+	s.client.Opt.Endpoint = server.URL
+	quote := &GetQuoteResponse{QuoteId: "12415572564", ValidTimestamp: time.Now().Add(-time.Second).UnixMilli()}
+	_, err := s.client.NewAcceptQuote().Quote(quote).Do(context.Background())
+	r := s.r()
+	r.ErrorIs(err, ErrQuoteExpired)
+	r.Zero(calls)
+}
+
+func (s *spotConvertTestSuite) TestConvertTradeFlow() {
+	msg := []byte(`{
+  "list": [
+    {
+      "quoteId": "f3b91c525b2644c7bc1e1cd31b6e1aa6",
+      "orderId": 940708407462087195,
+      "orderStatus": "SUCCESS",
+      "fromAsset": "USDT",
+      "fromAmount": "20",
+      "toAsset": "BNB",
+      "toAmount": "0.06154036",
+      "ratio": "0.00307702",
+      "inverseRatio": "324.99",
+      "createTime": 1624248872184
+    }
+  ],
+  "startTime": 1623824139000,
+  "endTime": 1626416139000,
+  "limit": 100,
+  "moreData": false
+}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewConvertTradeFlow().StartTime(1623824139000).EndTime(1626416139000).Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *ConvertTradeFlowResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(*testResp.List[0], *resp.List[0])
+	r.False(resp.MoreData)
+}
+
+func (s *spotConvertTestSuite) TestConvertLimitOpenOrders() {
+	msg := []byte(`{
+  "list": [
+    {
+      "quoteId": "18sdf87kh9df",
+      "orderId": 1150901289839,
+      "orderStatus": "SUCCESS",
+      "fromAsset": "BNB",
+      "fromAmount": "10",
+      "toAsset": "USDT",
+      "toAmount": "2317.89",
+      "ratio": "231.789",
+      "inverseRatio": "0.00431427",
+      "createTime": 1614089498000,
+      "expiredTimestamp": 1614099498000
+    }
+  ]
+}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewConvertLimitOpenOrders().Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *ConvertLimitOpenOrdersResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(*testResp.List[0], *resp.List[0])
+}
@@ -0,0 +1,580 @@
+package spot
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/shopspring/decimal"
+)
+
+// FlexibleProductList Get available Simple Earn flexible product list.
+type FlexibleProductList struct {
+	c *Client
+	r *core.Request
+}
+
+type FlexibleProductListResponse struct {
+	Rows  []*FlexibleProduct `json:"rows"`
+	Total int                `json:"total"`
+}
+
+type FlexibleProduct struct {
+	Asset                      string                     `json:"asset"`
+	LatestAnnualPercentageRate decimal.Decimal            `json:"latestAnnualPercentageRate"`
+	TierAnnualPercentageRate   map[string]decimal.Decimal `json:"tierAnnualPercentageRate"`
+	AirDropPercentageRate      decimal.Decimal            `json:"airDropPercentageRate"`
+	CanPurchase                bool                       `json:"canPurchase"`
+	CanRedeem                  bool                       `json:"canRedeem"`
+	IsSoldOut                  bool                       `json:"isSoldOut"`
+	Hot                        bool                       `json:"hot"`
+	MinPurchaseAmount          decimal.Decimal            `json:"minPurchaseAmount"`
+	ProductId                  string                     `json:"productId"`
+	SubscriptionStartTime      int64                      `json:"subscriptionStartTime"`
+	Status                     string                     `json:"status"`
+}
+
+func (s *FlexibleProductList) Asset(asset string) *FlexibleProductList {
+	s.r.Set("asset", asset)
+	return s
+}
+
+// Current Currently querying page. Start from 1. Default:1
+func (s *FlexibleProductList) Current(current int64) *FlexibleProductList {
+	s.r.Set("current", current)
+	return s
+}
+
+// Size Default:10, Max:100
+func (s *FlexibleProductList) Size(size int64) *FlexibleProductList {
+	s.r.Set("size", size)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *FlexibleProductList) RecvWindow(recvWindow int64) *FlexibleProductList {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *FlexibleProductList) Do(ctx context.Context) (*FlexibleProductListResponse, error) {
+	resp := new(FlexibleProductListResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// LockedProductList Get available Simple Earn locked product list.
+type LockedProductList struct {
+	c *Client
+	r *core.Request
+}
+
+type LockedProductListResponse struct {
+	Rows  []*LockedProduct `json:"rows"`
+	Total int              `json:"total"`
+}
+
+type LockedProduct struct {
+	ProjectId string               `json:"projectId"`
+	Detail    *LockedProductDetail `json:"detail"`
+	Quota     *LockedProductQuota  `json:"quota"`
+}
+
+type LockedProductDetail struct {
+	Asset                 string          `json:"asset"`
+	RewardAsset           string          `json:"rewardAsset"`
+	Duration              int             `json:"duration"`
+	Renewable             bool            `json:"renewable"`
+	IsSoldOut             bool            `json:"isSoldOut"`
+	Apr                   decimal.Decimal `json:"apr"`
+	Status                string          `json:"status"`
+	SubscriptionStartTime int64           `json:"subscriptionStartTime"`
+	ExtraRewardAsset      string          `json:"extraRewardAsset"`
+	ExtraRewardAPR        decimal.Decimal `json:"extraRewardAPR"`
+}
+
+type LockedProductQuota struct {
+	TotalPersonalQuota decimal.Decimal `json:"totalPersonalQuota"`
+	Minimum            decimal.Decimal `json:"minimum"`
+}
+
+func (s *LockedProductList) Asset(asset string) *LockedProductList {
+	s.r.Set("asset", asset)
+	return s
+}
+
+// Current Currently querying page. Start from 1. Default:1
+func (s *LockedProductList) Current(current int64) *LockedProductList {
+	s.r.Set("current", current)
+	return s
+}
+
+// Size Default:10, Max:100
+func (s *LockedProductList) Size(size int64) *LockedProductList {
+	s.r.Set("size", size)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *LockedProductList) RecvWindow(recvWindow int64) *LockedProductList {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *LockedProductList) Do(ctx context.Context) (*LockedProductListResponse, error) {
+	resp := new(LockedProductListResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// SubscribeFlexible Subscribe to a Simple Earn flexible product.
+type SubscribeFlexible struct {
+	c *Client
+	r *core.Request
+}
+
+type SubscribeResponse struct {
+	PurchaseId int64           `json:"purchaseId"`
+	PositionId int64           `json:"positionId"` // Only for locked products
+	Success    bool            `json:"success"`
+	Amount     decimal.Decimal `json:"amount"`
+}
+
+func (s *SubscribeFlexible) ProductId(productId string) *SubscribeFlexible {
+	s.r.Set("productId", productId)
+	return s
+}
+
+func (s *SubscribeFlexible) Amount(amount string) *SubscribeFlexible {
+	s.r.Set("amount", amount)
+	return s
+}
+
+// AutoSubscribe true or false, default true
+func (s *SubscribeFlexible) AutoSubscribe(autoSubscribe bool) *SubscribeFlexible {
+	s.r.Set("autoSubscribe", autoSubscribe)
+	return s
+}
+
+// SourceAccount SPOT, FUND, ALL, default SPOT
+func (s *SubscribeFlexible) SourceAccount(sourceAccount string) *SubscribeFlexible {
+	s.r.Set("sourceAccount", sourceAccount)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *SubscribeFlexible) RecvWindow(recvWindow int64) *SubscribeFlexible {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *SubscribeFlexible) Do(ctx context.Context) (*SubscribeResponse, error) {
+	resp := new(SubscribeResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// SubscribeLocked Subscribe to a Simple Earn locked product.
+type SubscribeLocked struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *SubscribeLocked) ProjectId(projectId string) *SubscribeLocked {
+	s.r.Set("projectId", projectId)
+	return s
+}
+
+func (s *SubscribeLocked) Amount(amount string) *SubscribeLocked {
+	s.r.Set("amount", amount)
+	return s
+}
+
+// AutoSubscribe true or false, default true
+func (s *SubscribeLocked) AutoSubscribe(autoSubscribe bool) *SubscribeLocked {
+	s.r.Set("autoSubscribe", autoSubscribe)
+	return s
+}
+
+// SourceAccount SPOT, FUND, ALL, default SPOT
+func (s *SubscribeLocked) SourceAccount(sourceAccount string) *SubscribeLocked {
+	s.r.Set("sourceAccount", sourceAccount)
+	return s
+}
+
+// RedeemTo SPOT or FLEXIBLE, default SPOT
+func (s *SubscribeLocked) RedeemTo(redeemTo string) *SubscribeLocked {
+	s.r.Set("redeemTo", redeemTo)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *SubscribeLocked) RecvWindow(recvWindow int64) *SubscribeLocked {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *SubscribeLocked) Do(ctx context.Context) (*SubscribeResponse, error) {
+	resp := new(SubscribeResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// RedeemFlexible Redeem a Simple Earn flexible product. Either amount or redeemAll must be sent.
+type RedeemFlexible struct {
+	c *Client
+	r *core.Request
+}
+
+type RedeemResponse struct {
+	RedeemId int64 `json:"redeemId"`
+	Success  bool  `json:"success"`
+}
+
+func (s *RedeemFlexible) ProductId(productId string) *RedeemFlexible {
+	s.r.Set("productId", productId)
+	return s
+}
+
+// RedeemAll true or false, default to false
+func (s *RedeemFlexible) RedeemAll(redeemAll bool) *RedeemFlexible {
+	s.r.Set("redeemAll", redeemAll)
+	return s
+}
+
+// Amount If redeemAll is false, amount is mandatory
+func (s *RedeemFlexible) Amount(amount string) *RedeemFlexible {
+	s.r.Set("amount", amount)
+	return s
+}
+
+// DestAccount SPOT, FUND, default SPOT
+func (s *RedeemFlexible) DestAccount(destAccount string) *RedeemFlexible {
+	s.r.Set("destAccount", destAccount)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *RedeemFlexible) RecvWindow(recvWindow int64) *RedeemFlexible {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *RedeemFlexible) Do(ctx context.Context) (*RedeemResponse, error) {
+	resp := new(RedeemResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// RedeemLocked Redeem a Simple Earn locked product.
+type RedeemLocked struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *RedeemLocked) PositionId(positionId string) *RedeemLocked {
+	s.r.Set("positionId", positionId)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *RedeemLocked) RecvWindow(recvWindow int64) *RedeemLocked {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *RedeemLocked) Do(ctx context.Context) (*RedeemResponse, error) {
+	resp := new(RedeemResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// FlexiblePosition Get Simple Earn flexible product positions.
+type FlexiblePosition struct {
+	c *Client
+	r *core.Request
+}
+
+type FlexiblePositionResponse struct {
+	Rows  []*FlexiblePositionRecord `json:"rows"`
+	Total int                       `json:"total"`
+}
+
+type FlexiblePositionRecord struct {
+	TotalAmount                    decimal.Decimal            `json:"totalAmount"`
+	TierAnnualPercentageRate       map[string]decimal.Decimal `json:"tierAnnualPercentageRate"`
+	LatestAnnualPercentageRate     decimal.Decimal            `json:"latestAnnualPercentageRate"`
+	YesterdayAirdropPercentageRate decimal.Decimal            `json:"yesterdayAirdropPercentageRate"`
+	Asset                          string                     `json:"asset"`
+	AirDropAsset                   string                     `json:"airDropAsset"`
+	CanRedeem                      bool                       `json:"canRedeem"`
+	CollateralAmount               decimal.Decimal            `json:"collateralAmount"`
+	ProductId                      string                     `json:"productId"`
+	YesterdayRealTimeRewards       decimal.Decimal            `json:"yesterdayRealTimeRewards"`
+	CumulativeBonusRewards         decimal.Decimal            `json:"cumulativeBonusRewards"`
+	CumulativeRealTimeRewards      decimal.Decimal            `json:"cumulativeRealTimeRewards"`
+	CumulativeTotalRewards         decimal.Decimal            `json:"cumulativeTotalRewards"`
+	AutoSubscribe                  bool                       `json:"autoSubscribe"`
+}
+
+func (s *FlexiblePosition) Asset(asset string) *FlexiblePosition {
+	s.r.Set("asset", asset)
+	return s
+}
+
+func (s *FlexiblePosition) ProductId(productId string) *FlexiblePosition {
+	s.r.Set("productId", productId)
+	return s
+}
+
+// Current Currently querying page. Start from 1. Default:1
+func (s *FlexiblePosition) Current(current int64) *FlexiblePosition {
+	s.r.Set("current", current)
+	return s
+}
+
+// Size Default:10, Max:100
+func (s *FlexiblePosition) Size(size int64) *FlexiblePosition {
+	s.r.Set("size", size)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *FlexiblePosition) RecvWindow(recvWindow int64) *FlexiblePosition {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *FlexiblePosition) Do(ctx context.Context) (*FlexiblePositionResponse, error) {
+	resp := new(FlexiblePositionResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// LockedPosition Get Simple Earn locked product positions.
+type LockedPosition struct {
+	c *Client
+	r *core.Request
+}
+
+type LockedPositionResponse struct {
+	Rows  []*LockedPositionRecord `json:"rows"`
+	Total int                     `json:"total"`
+}
+
+type LockedPositionRecord struct {
+	PositionId        int64           `json:"positionId"`
+	ParentPositionId  int64           `json:"parentPositionId"`
+	ProjectId         string          `json:"projectId"`
+	Asset             string          `json:"asset"`
+	Amount            decimal.Decimal `json:"amount"`
+	PurchaseTime      string          `json:"purchaseTime"`
+	Duration          string          `json:"duration"`
+	AccrualDays       string          `json:"accrualDays"`
+	RewardAsset       string          `json:"rewardAsset"`
+	APY               decimal.Decimal `json:"APY"`
+	RewardAmt         decimal.Decimal `json:"rewardAmt"`
+	ExtraRewardAsset  string          `json:"extraRewardAsset"`
+	ExtraRewardAPR    decimal.Decimal `json:"extraRewardAPR"`
+	EstExtraRewardAmt decimal.Decimal `json:"estExtraRewardAmt"`
+	NextPay           decimal.Decimal `json:"nextPay"`
+	NextPayDate       string          `json:"nextPayDate"`
+	RewardsEndDate    string          `json:"rewardsEndDate"`
+	DeliverDate       string          `json:"deliverDate"`
+	RedeemTo          string          `json:"redeemTo"`
+	CanRedeemEarly    bool            `json:"canRedeemEarly"`
+	AutoSubscribe     bool            `json:"autoSubscribe"`
+	Type              string          `json:"type"`
+	Status            string          `json:"status"`
+}
+
+func (s *LockedPosition) Asset(asset string) *LockedPosition {
+	s.r.Set("asset", asset)
+	return s
+}
+
+func (s *LockedPosition) PositionId(positionId int64) *LockedPosition {
+	s.r.Set("positionId", positionId)
+	return s
+}
+
+func (s *LockedPosition) ProjectId(projectId string) *LockedPosition {
+	s.r.Set("projectId", projectId)
+	return s
+}
+
+// Current Currently querying page. Start from 1. Default:1
+func (s *LockedPosition) Current(current int64) *LockedPosition {
+	s.r.Set("current", current)
+	return s
+}
+
+// Size Default:10, Max:100
+func (s *LockedPosition) Size(size int64) *LockedPosition {
+	s.r.Set("size", size)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *LockedPosition) RecvWindow(recvWindow int64) *LockedPosition {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *LockedPosition) Do(ctx context.Context) (*LockedPositionResponse, error) {
+	resp := new(LockedPositionResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// FlexibleRewardsHistory Get Simple Earn flexible rewards history.
+type FlexibleRewardsHistory struct {
+	c *Client
+	r *core.Request
+}
+
+type FlexibleRewardsHistoryResponse struct {
+	Rows  []*FlexibleReward `json:"rows"`
+	Total int               `json:"total"`
+}
+
+type FlexibleReward struct {
+	Asset     string          `json:"asset"`
+	Rewards   decimal.Decimal `json:"rewards"`
+	ProjectId string          `json:"projectId"`
+	Type      string          `json:"type"`
+	Time      int64           `json:"time"`
+}
+
+func (s *FlexibleRewardsHistory) ProductId(productId string) *FlexibleRewardsHistory {
+	s.r.Set("productId", productId)
+	return s
+}
+
+func (s *FlexibleRewardsHistory) Asset(asset string) *FlexibleRewardsHistory {
+	s.r.Set("asset", asset)
+	return s
+}
+
+func (s *FlexibleRewardsHistory) StartTime(startTime int64) *FlexibleRewardsHistory {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *FlexibleRewardsHistory) EndTime(endTime int64) *FlexibleRewardsHistory {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Type BONUS, REALTIME, REWARDS
+func (s *FlexibleRewardsHistory) Type(typ string) *FlexibleRewardsHistory {
+	s.r.Set("type", typ)
+	return s
+}
+
+// Current Currently querying page. Start from 1. Default:1
+func (s *FlexibleRewardsHistory) Current(current int64) *FlexibleRewardsHistory {
+	s.r.Set("current", current)
+	return s
+}
+
+// Size Default:10, Max:100
+func (s *FlexibleRewardsHistory) Size(size int64) *FlexibleRewardsHistory {
+	s.r.Set("size", size)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *FlexibleRewardsHistory) RecvWindow(recvWindow int64) *FlexibleRewardsHistory {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *FlexibleRewardsHistory) Do(ctx context.Context) (*FlexibleRewardsHistoryResponse, error) {
+	resp := new(FlexibleRewardsHistoryResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// LockedRewardsHistory Get Simple Earn locked rewards history.
+type LockedRewardsHistory struct {
+	c *Client
+	r *core.Request
+}
+
+type LockedRewardsHistoryResponse struct {
+	Rows  []*LockedReward `json:"rows"`
+	Total int             `json:"total"`
+}
+
+type LockedReward struct {
+	PositionId string          `json:"positionId"`
+	Time       int64           `json:"time"`
+	Asset      string          `json:"asset"`
+	LockPeriod string          `json:"lockPeriod"`
+	Amount     decimal.Decimal `json:"amount"`
+	Type       string          `json:"type"`
+}
+
+func (s *LockedRewardsHistory) PositionId(positionId int64) *LockedRewardsHistory {
+	s.r.Set("positionId", positionId)
+	return s
+}
+
+func (s *LockedRewardsHistory) Asset(asset string) *LockedRewardsHistory {
+	s.r.Set("asset", asset)
+	return s
+}
+
+func (s *LockedRewardsHistory) StartTime(startTime int64) *LockedRewardsHistory {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *LockedRewardsHistory) EndTime(endTime int64) *LockedRewardsHistory {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Current Currently querying page. Start from 1. Default:1
+func (s *LockedRewardsHistory) Current(current int64) *LockedRewardsHistory {
+	s.r.Set("current", current)
+	return s
+}
+
+// Size Default:10, Max:100
+func (s *LockedRewardsHistory) Size(size int64) *LockedRewardsHistory {
+	s.r.Set("size", size)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *LockedRewardsHistory) RecvWindow(recvWindow int64) *LockedRewardsHistory {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *LockedRewardsHistory) Do(ctx context.Context) (*LockedRewardsHistoryResponse, error) {
+	resp := new(LockedRewardsHistoryResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
@@ -0,0 +1,168 @@
+package spot
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type spotEarnTestSuite struct {
+	baseHttpTestSuite
+}
+
+func TestSpotEarn(t *testing.T) {
+	suite.Run(t, new(spotEarnTestSuite))
+}
+
+func (s *spotEarnTestSuite) TestFlexibleProductList() {
+	msg := []byte(`{
+  "rows": [
+    {
+      "asset": "BTC",
+      "latestAnnualPercentageRate": "0.05000000",
+      "tierAnnualPercentageRate": {
+        "0-5BTC": "0.05",
+        "5-10BTC": "0.03"
+      },
+      "airDropPercentageRate": "0.05000000",
+      "canPurchase": true,
+      "canRedeem": true,
+      "isSoldOut": true,
+      "hot": true,
+      "minPurchaseAmount": "0.01000000",
+      "productId": "BTC001",
+      "subscriptionStartTime": 1646182276000,
+      "status": "PURCHASING"
+    }
+  ],
+  "total": 1
+}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewFlexibleProductList().Asset("BTC").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *FlexibleProductListResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(1, resp.Total)
+	r.Equal(testResp.Rows[0].ProductId, resp.Rows[0].ProductId)
+	r.Equal("0.03", resp.Rows[0].TierAnnualPercentageRate["5-10BTC"].String())
+}
+
+func (s *spotEarnTestSuite) TestLockedProductList() {
+	msg := []byte(`{
+  "rows": [
+    {
+      "projectId": "Axs*90",
+      "detail": {
+        "asset": "AXS",
+        "rewardAsset": "AXS",
+        "duration": 90,
+        "renewable": true,
+        "isSoldOut": true,
+        "apr": "1.2069",
+        "status": "CREATED",
+        "subscriptionStartTime": 1646182276000,
+        "extraRewardAsset": "BNB",
+        "extraRewardAPR": "0.23"
+      },
+      "quota": {
+        "totalPersonalQuota": "2",
+        "minimum": "0.001"
+      }
+    }
+  ],
+  "total": 1
+}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewLockedProductList().Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *LockedProductListResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(*testResp.Rows[0].Detail, *resp.Rows[0].Detail)
+	r.Equal(*testResp.Rows[0].Quota, *resp.Rows[0].Quota)
+}
+
+func (s *spotEarnTestSuite) TestSubscribeFlexible() {
+	msg := []byte(`{"purchaseId": 40607, "success": true}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewSubscribeFlexible().ProductId("BTC001").Amount("0.1").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Equal(int64(40607), resp.PurchaseId)
+	r.True(resp.Success)
+}
+
+func (s *spotEarnTestSuite) TestRedeemFlexible() {
+	msg := []byte(`{"redeemId": 40607, "success": true}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewRedeemFlexible().ProductId("BTC001").RedeemAll(true).Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Equal(int64(40607), resp.RedeemId)
+	r.True(resp.Success)
+}
+
+func (s *spotEarnTestSuite) TestFlexiblePosition() {
+	msg := []byte(`{
+  "rows": [
+    {
+      "totalAmount": "75.46000000",
+      "tierAnnualPercentageRate": {
+        "0-5BTC": "0.05",
+        "5-10BTC": "0.03"
+      },
+      "latestAnnualPercentageRate": "0.02599895",
+      "yesterdayAirdropPercentageRate": "0.02599895",
+      "asset": "USDT",
+      "airDropAsset": "BETH",
+      "canRedeem": true,
+      "collateralAmount": "232.23123213",
+      "productId": "USDT001",
+      "yesterdayRealTimeRewards": "0.10293829",
+      "cumulativeBonusRewards": "0.22759183",
+      "cumulativeRealTimeRewards": "0.22759183",
+      "cumulativeTotalRewards": "0.45459183",
+      "autoSubscribe": true
+    }
+  ],
+  "total": 1
+}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewFlexiblePosition().Asset("USDT").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *FlexiblePositionResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(testResp.Rows[0].TotalAmount, resp.Rows[0].TotalAmount)
+	r.Equal(testResp.Rows[0].CumulativeTotalRewards, resp.Rows[0].CumulativeTotalRewards)
+}
+
+func (s *spotEarnTestSuite) TestFlexibleRewardsHistory() {
+	msg := []byte(`{
+  "rows": [
+    {
+      "asset": "BUSD",
+      "rewards": "0.00006408",
+      "projectId": "USDT001",
+      "type": "BONUS",
+      "time": 1577233578000
+    }
+  ],
+  "total": 1
+}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewFlexibleRewardsHistory().Type("BONUS").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *FlexibleRewardsHistoryResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(*testResp.Rows[0], *resp.Rows[0])
+}
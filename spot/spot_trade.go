@@ -570,6 +570,144 @@ func (s *CancelReplace) Do(ctx context.Context) (*CancelReplaceResponse, error)
 	return resp, json.Unmarshal(s.c.rawBody(), &resp)
 }
 
+// OrderAmendKeepPriority Reduce the quantity of an existing open order.
+// The amended order keeps its priority in the order book; the new quantity must be greater than 0 and less than the current order quantity.
+// Either orderId or origClientOrderId must be sent.
+type OrderAmendKeepPriority struct {
+	c *Client
+	r *core.Request
+}
+
+type OrderAmendKeepPriorityResponse struct {
+	TransactTime int64             `json:"transactTime"`
+	ExecutionId  int64             `json:"executionId"`
+	AmendedOrder *AmendedOrder     `json:"amendedOrder"`
+	ListStatus   *AmendedOrderList `json:"listStatus,omitempty"`
+}
+
+type AmendedOrder struct {
+	Symbol                  string          `json:"symbol"`
+	OrderId                 int64           `json:"orderId"`
+	OrderListId             int             `json:"orderListId"`
+	OrigClientOrderId       string          `json:"origClientOrderId"`
+	ClientOrderId           string          `json:"clientOrderId"`
+	Price                   decimal.Decimal `json:"price"`
+	Qty                     decimal.Decimal `json:"qty"`
+	ExecutedQty             decimal.Decimal `json:"executedQty"`
+	PreventedQty            decimal.Decimal `json:"preventedQty"`
+	QuoteOrderQty           decimal.Decimal `json:"quoteOrderQty"`
+	CumulativeQuoteQty      decimal.Decimal `json:"cumulativeQuoteQty"`
+	Status                  string          `json:"status"`
+	TimeInForce             string          `json:"timeInForce"`
+	Type                    string          `json:"type"`
+	Side                    string          `json:"side"`
+	WorkingTime             int64           `json:"workingTime"`
+	SelfTradePreventionMode string          `json:"selfTradePreventionMode"`
+}
+
+// AmendedOrderList is only present when the amended order is part of an order list.
+type AmendedOrderList struct {
+	OrderListId       int      `json:"orderListId"`
+	ContingencyType   string   `json:"contingencyType"`
+	ListOrderStatus   string   `json:"listOrderStatus"`
+	ListClientOrderId string   `json:"listClientOrderId"`
+	Symbol            string   `json:"symbol"`
+	Orders            []*Order `json:"orders"`
+}
+
+func (s *OrderAmendKeepPriority) Symbol(symbol string) *OrderAmendKeepPriority {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *OrderAmendKeepPriority) OrderId(orderId int64) *OrderAmendKeepPriority {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *OrderAmendKeepPriority) OrigClientOrderId(origClientOrderId string) *OrderAmendKeepPriority {
+	s.r.Set("origClientOrderId", origClientOrderId)
+	return s
+}
+
+// NewClientOrderId The new client order ID for the order after being amended. If not sent, one will be randomly generated.
+func (s *OrderAmendKeepPriority) NewClientOrderId(newClientOrderId string) *OrderAmendKeepPriority {
+	s.r.Set("newClientOrderId", newClientOrderId)
+	return s
+}
+
+// NewQty The new quantity, must be greater than 0 and less than the order's quantity.
+func (s *OrderAmendKeepPriority) NewQty(newQty string) *OrderAmendKeepPriority {
+	s.r.Set("newQty", newQty)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *OrderAmendKeepPriority) RecvWindow(recvWindow int) *OrderAmendKeepPriority {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *OrderAmendKeepPriority) Do(ctx context.Context) (*OrderAmendKeepPriorityResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := new(OrderAmendKeepPriorityResponse)
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// OrderAmendments Queries all amendments of a single order.
+type OrderAmendments struct {
+	c *Client
+	r *core.Request
+}
+
+type OrderAmendmentsResponse struct {
+	Symbol            string          `json:"symbol"`
+	OrderId           int64           `json:"orderId"`
+	ExecutionId       int64           `json:"executionId"`
+	OrigClientOrderId string          `json:"origClientOrderId"`
+	NewClientOrderId  string          `json:"newClientOrderId"`
+	OrigQty           decimal.Decimal `json:"origQty"`
+	NewQty            decimal.Decimal `json:"newQty"`
+	Time              int64           `json:"time"`
+}
+
+func (s *OrderAmendments) Symbol(symbol string) *OrderAmendments {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *OrderAmendments) OrderId(orderId int64) *OrderAmendments {
+	s.r.Set("orderId", orderId)
+	return s
+}
+
+func (s *OrderAmendments) FromExecutionId(fromExecutionId int64) *OrderAmendments {
+	s.r.Set("fromExecutionId", fromExecutionId)
+	return s
+}
+
+// Limit Default:500; Maximum: 1000
+func (s *OrderAmendments) Limit(limit int) *OrderAmendments {
+	s.r.Set("limit", limit)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *OrderAmendments) RecvWindow(recvWindow int) *OrderAmendments {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *OrderAmendments) Do(ctx context.Context) ([]*OrderAmendmentsResponse, error) {
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return nil, err
+	}
+	resp := make([]*OrderAmendmentsResponse, 0)
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
 // OpenOrders Get all open orders on a symbol. Careful when accessing this with no symbol.
 type OpenOrders struct {
 	c *Client
@@ -899,3 +899,81 @@ func (s *spotTradeTestSuite) assertTestCreateTestSOROrderResponse(r1, r2 *TestCr
 	r.Equal(r1.Discount.DiscountAsset, r2.Discount.DiscountAsset, "Discount.discountAsset")
 	r.Equal(r1.Discount.Discount, r2.Discount.Discount, "Discount.discount")
 }
+
+func (s *spotTradeTestSuite) TestNewOrderAmendKeepPriority() {
+	msg := []byte(`{
+  "transactTime": 1741926410255,
+  "executionId": 75,
+  "amendedOrder": {
+    "symbol": "BTCUSDT",
+    "orderId": 33,
+    "orderListId": -1,
+    "origClientOrderId": "5xrgbMyg6z36NzBn2pbT8H",
+    "clientOrderId": "PFaq6hIHxqFENGfdtn4J6Q",
+    "price": "6.00000000",
+    "qty": "5.00000000",
+    "executedQty": "0.00000000",
+    "preventedQty": "0.00000000",
+    "quoteOrderQty": "0.00000000",
+    "cumulativeQuoteQty": "0.00000000",
+    "status": "NEW",
+    "timeInForce": "GTC",
+    "type": "LIMIT",
+    "side": "SELL",
+    "workingTime": 1741926410242,
+    "selfTradePreventionMode": "NONE"
+  }
+}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewOrderAmendKeepPriority().Symbol("BTCUSDT").
+		OrderId(33).
+		NewQty("5").
+		Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Equal(int64(75), resp.ExecutionId, "executionId")
+	r.Equal(int64(33), resp.AmendedOrder.OrderId, "orderId")
+	r.Equal("5", resp.AmendedOrder.Qty.String(), "qty")
+	r.Equal("5xrgbMyg6z36NzBn2pbT8H", resp.AmendedOrder.OrigClientOrderId, "origClientOrderId")
+	r.Nil(resp.ListStatus, "listStatus")
+}
+
+func (s *spotTradeTestSuite) TestNewOrderAmendments() {
+	msg := []byte(`[
+  {
+    "symbol": "BTCUSDT",
+    "orderId": 9,
+    "executionId": 22,
+    "origClientOrderId": "W0fJ9fiLKHOJutovPK3oJp",
+    "newClientOrderId": "UQ1Np3bmQ71jJzsSDW9Vpi",
+    "origQty": "5.00000000",
+    "newQty": "4.00000000",
+    "time": 1741669661670
+  },
+  {
+    "symbol": "BTCUSDT",
+    "orderId": 9,
+    "executionId": 25,
+    "origClientOrderId": "UQ1Np3bmQ71jJzsSDW9Vpi",
+    "newClientOrderId": "5uS0r35ohuQyDlCzZuYXq2",
+    "origQty": "4.00000000",
+    "newQty": "3.00000000",
+    "time": 1741672924895
+  }
+]`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewOrderAmendments().Symbol("BTCUSDT").
+		OrderId(9).
+		Limit(10).
+		Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Len(resp, 2)
+	var testResp []*OrderAmendmentsResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	for i := range testResp {
+		r.Equal(testResp[i], resp[i])
+	}
+}
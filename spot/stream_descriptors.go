@@ -0,0 +1,87 @@
+package spot
+
+import (
+	"fmt"
+	"github.com/jekaxv/go-binance/core"
+	"strings"
+	"time"
+)
+
+// StreamDescriptors Builds descriptors of the spot market and user data streams, use it through Streams.
+type StreamDescriptors struct{}
+
+// Streams Describes spot streams, e.g. spot.Streams.AggTrade("BTCUSDT").
+// Subscribe a descriptor with core.Subscribe or mix several into one connection with core.SubscribeCombined.
+var Streams StreamDescriptors
+
+// AggTrade Stream Name: <symbol>@aggTrade
+func (StreamDescriptors) AggTrade(symbol string) core.StreamDescriptor[*AggTradeEvent] {
+	return core.NewStreamDescriptor[*AggTradeEvent](strings.ToLower(symbol) + "@aggTrade")
+}
+
+// Trade Stream Name: <symbol>@trade
+func (StreamDescriptors) Trade(symbol string) core.StreamDescriptor[*TradeEvent] {
+	return core.NewStreamDescriptor[*TradeEvent](strings.ToLower(symbol) + "@trade")
+}
+
+// Kline Stream Name: <symbol>@kline_<interval>
+func (StreamDescriptors) Kline(symbol string, interval core.IntervalEnum) core.StreamDescriptor[*KlineEvent] {
+	return core.NewStreamDescriptor[*KlineEvent](fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval))
+}
+
+// MiniTicker Stream Name: <symbol>@miniTicker
+func (StreamDescriptors) MiniTicker(symbol string) core.StreamDescriptor[*MiniTickerEvent] {
+	return core.NewStreamDescriptor[*MiniTickerEvent](strings.ToLower(symbol) + "@miniTicker")
+}
+
+// MiniTickerArr Stream Name: !miniTicker@arr
+func (StreamDescriptors) MiniTickerArr() core.StreamDescriptor[[]*MiniTickerEvent] {
+	return core.NewStreamDescriptor[[]*MiniTickerEvent]("!miniTicker@arr")
+}
+
+// Ticker Stream Name: <symbol>@ticker
+func (StreamDescriptors) Ticker(symbol string) core.StreamDescriptor[*TickerEvent] {
+	return core.NewStreamDescriptor[*TickerEvent](strings.ToLower(symbol) + "@ticker")
+}
+
+// TickerArr Stream Name: !ticker@arr
+func (StreamDescriptors) TickerArr() core.StreamDescriptor[[]*TickerEvent] {
+	return core.NewStreamDescriptor[[]*TickerEvent]("!ticker@arr")
+}
+
+// TickerWindowSize Stream Name: <symbol>@ticker_<window_size>, window sizes 1h, 4h, 1d.
+func (StreamDescriptors) TickerWindowSize(symbol, windowSize string) core.StreamDescriptor[*TickerWindowSizeEvent] {
+	return core.NewStreamDescriptor[*TickerWindowSizeEvent](fmt.Sprintf("%s@ticker_%s", strings.ToLower(symbol), windowSize))
+}
+
+// TickerWindowSizeArr Stream Name: !ticker_<window-size>@arr
+func (StreamDescriptors) TickerWindowSizeArr(windowSize string) core.StreamDescriptor[[]*TickerWindowSizeEvent] {
+	return core.NewStreamDescriptor[[]*TickerWindowSizeEvent](fmt.Sprintf("!ticker_%s@arr", windowSize))
+}
+
+// BookTicker Stream Name: <symbol>@bookTicker
+func (StreamDescriptors) BookTicker(symbol string) core.StreamDescriptor[*BookTickerEvent] {
+	return core.NewStreamDescriptor[*BookTickerEvent](strings.ToLower(symbol) + "@bookTicker")
+}
+
+// AvgPrice Stream Name: <symbol>@avgPrice
+func (StreamDescriptors) AvgPrice(symbol string) core.StreamDescriptor[*AvgPriceEvent] {
+	return core.NewStreamDescriptor[*AvgPriceEvent](strings.ToLower(symbol) + "@avgPrice")
+}
+
+// DepthLevel Stream Name: <symbol>@depth<levels> OR <symbol>@depth<levels>@100ms, levels 5, 10 or 20.
+// Update Speed: 1000ms (the default) or 100ms.
+func (StreamDescriptors) DepthLevel(symbol string, level int, interval ...time.Duration) core.StreamDescriptor[*DepthLevelEvent] {
+	return core.NewStreamDescriptor[*DepthLevelEvent](fmt.Sprintf("%s@depth%d%s", strings.ToLower(symbol), level, core.UpdateSpeed(time.Second, interval...)))
+}
+
+// Depth Stream Name: <symbol>@depth OR <symbol>@depth@100ms
+// Update Speed: 1000ms (the default) or 100ms.
+func (StreamDescriptors) Depth(symbol string, interval ...time.Duration) core.StreamDescriptor[*DepthEvent] {
+	return core.NewStreamDescriptor[*DepthEvent](fmt.Sprintf("%s@depth%s", strings.ToLower(symbol), core.UpdateSpeed(time.Second, interval...)))
+}
+
+// UserData Stream Name: <listenKey>
+func (StreamDescriptors) UserData(listenKey string) core.StreamDescriptor[*UserDataEvent] {
+	return core.NewStreamDescriptorFunc(listenKey, parseUserEvent)
+}
@@ -0,0 +1,381 @@
+package spot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/gorilla/websocket"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type streamDescriptorsTestSuite struct {
+	baseWsTestSuite
+}
+
+func TestStreamDescriptors(t *testing.T) {
+	suite.Run(t, new(streamDescriptorsTestSuite))
+}
+
+// echoServer Answers every connection with events whose type is the requested uri,
+// wrapped for the first stream name on combined connections.
+func (s *streamDescriptorsTestSuite) echoServer() *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{CheckOrigin: func(r *http.Request) bool { return true }}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		msg := fmt.Sprintf(`{"e":"%s"}`, r.URL.RequestURI())
+		if streams := r.URL.Query().Get("streams"); streams != "" {
+			msg = fmt.Sprintf(`{"stream":"%s","data":%s}`, strings.Split(streams, "/")[0], msg)
+		}
+		for {
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
+				return
+			}
+		}
+	}))
+	s.mockClient("ws" + server.URL[4:])
+	return server
+}
+
+// sequenceServer Sends msgs once on every connection, then waits for the client to go away.
+func (s *streamDescriptorsTestSuite) sequenceServer(msgs ...string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{CheckOrigin: func(r *http.Request) bool { return true }}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for _, msg := range msgs {
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
+				return
+			}
+		}
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	s.mockClient("ws" + server.URL[4:])
+	return server
+}
+
+func (s *streamDescriptorsTestSuite) TestNames() {
+	r := s.r()
+	r.Equal("btcusdt@aggTrade", Streams.AggTrade("BTCUSDT").Name())
+	r.Equal("btcusdt@kline_1m", Streams.Kline("BTCUSDT", core.Interval1m).Name())
+	r.Equal("!ticker_1h@arr", Streams.TickerWindowSizeArr("1h").Name())
+	r.Equal("btcusdt@depth", Streams.Depth("BTCUSDT").Name())
+	r.Equal("btcusdt@depth", Streams.Depth("BTCUSDT", time.Second).Name())
+	r.Equal("btcusdt@depth@100ms", Streams.Depth("BTCUSDT", 100*time.Millisecond).Name())
+	r.Equal("btcusdt@depth5@100ms", Streams.DepthLevel("BTCUSDT", 5, 100*time.Millisecond).Name())
+	r.Equal("listenKey", Streams.UserData("listenKey").Name())
+}
+
+func (s *streamDescriptorsTestSuite) TestConcurrentSubscriptions() {
+	server := s.echoServer()
+	defer server.Close()
+	endpoint := s.client.Opt.Endpoint
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	streams := s.client.NewWebsocketStreams()
+	aggTrade := streams.SubscribeAggTrade("BTCUSDT")
+	trade := streams.SubscribeCombinedTrade([]string{"ETHUSDT", "BNBUSDT"})
+	onAggTrade, onAggTradeError := aggTrade.Do(ctx)
+	onTrade, onTradeError := trade.Do(ctx)
+	onDepth, onDepthError := core.Subscribe(ctx, s.client.WsClient.WsClient, Streams.Depth("BTCUSDT", 100*time.Millisecond))
+
+	r := s.r()
+	select {
+	case event := <-onAggTrade:
+		r.Equal("/ws/btcusdt@aggTrade", event.Event)
+	case err := <-onAggTradeError:
+		s.FailNow(err.Error())
+	}
+	select {
+	case event := <-onTrade:
+		r.Equal("ethusdt@trade", event.Stream)
+		r.Equal("/stream?streams=ethusdt@trade/bnbusdt@trade", event.Data.Event)
+	case err := <-onTradeError:
+		s.FailNow(err.Error())
+	}
+	select {
+	case event := <-onDepth:
+		r.Equal("/ws/btcusdt@depth@100ms", event.Event)
+	case err := <-onDepthError:
+		s.FailNow(err.Error())
+	}
+	r.Equal(endpoint, s.client.Opt.Endpoint, "endpoint")
+}
+
+func (s *streamDescriptorsTestSuite) TestSubscribeCombined() {
+	server := s.echoServer()
+	defer server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	onMessage, onError := core.SubscribeCombined(ctx, s.client.WsClient.WsClient, Streams.BookTicker("BTCUSDT"), Streams.BookTicker("ETHUSDT"))
+	select {
+	case event := <-onMessage:
+		s.r().Equal("btcusdt@bookTicker", event.Stream)
+		s.r().NotNil(event.Data)
+	case err := <-onError:
+		s.FailNow(err.Error())
+	}
+}
+
+var mixedStreamMessages = []string{
+	`{"stream":"btcusdt@depth@100ms","data":{"e":"depthUpdate","E":1737450000000,"s":"BTCUSDT","U":157,"u":160,"b":[["0.0024","10"]],"a":[["0.0026","100"]]}}`,
+	`{"stream":"ethusdt@aggTrade","data":{"e":"aggTrade","E":1737443769749,"s":"ETHUSDT","a":1019485,"p":"3342.24","q":"0.254","f":1071934,"l":1071934,"T":1737443769749,"m":false,"M":true}}`,
+	`{"stream":"listenKey","data":{"e":"balanceUpdate","E":1573200697110,"a":"BTC","d":"100.00000000","T":1573200697068}}`,
+	`{"stream":"bnbusdt@trade","data":{}}`,
+	`{"stream":"ethusdt@aggTrade","data":{"e":"aggTrade","p":"not a number"}}`,
+	`{"stream":"bnbusdt@aggTrade","data":{"e":"aggTrade","E":1737443769750,"s":"BNBUSDT","a":1,"p":"600","q":"1","f":1,"l":1,"T":1737443769750,"m":true,"M":true}}`,
+}
+
+func (s *streamDescriptorsTestSuite) TestDispatcherDo() {
+	server := s.sequenceServer(mixedStreamMessages...)
+	defer server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dispatcher := core.NewDispatcher(s.client.WsClient.WsClient, Streams.Depth("BTCUSDT", 100*time.Millisecond), Streams.AggTrade("ETHUSDT"), Streams.UserData("listenKey"))
+	r := s.r()
+	r.Equal([]string{"btcusdt@depth@100ms", "ethusdt@aggTrade", "listenKey"}, dispatcher.Streams())
+	events, errs := dispatcher.Do(ctx)
+	var received []*core.StreamEvent
+	var decodeErr *core.DecodeError
+	for len(received) < 5 || decodeErr == nil {
+		select {
+		case event := <-events:
+			received = append(received, event)
+		case err := <-errs:
+			r.ErrorAs(err, &decodeErr)
+		case <-time.After(5 * time.Second):
+			s.FailNow("no event received")
+		}
+	}
+	r.Equal("ethusdt@aggTrade", decodeErr.Stream)
+	depth, ok := received[0].Data.(*DepthEvent)
+	r.True(ok, "DepthEvent")
+	r.Equal("BTCUSDT", depth.Symbol, "Symbol")
+	aggTrade, ok := received[1].Data.(*AggTradeEvent)
+	r.True(ok, "AggTradeEvent")
+	r.Equal("3342.24", aggTrade.Price.String(), "Price")
+	userData, ok := received[2].Data.(*UserDataEvent)
+	r.True(ok, "UserDataEvent")
+	r.Equal("BTC", userData.BalanceUpdate.Asset, "Asset")
+	r.Equal("bnbusdt@trade", received[3].Stream)
+	r.IsType(json.RawMessage{}, received[3].Data)
+	r.Equal("bnbusdt@aggTrade", received[4].Stream)
+	r.IsType(json.RawMessage{}, received[4].Data)
+}
+
+func (s *streamDescriptorsTestSuite) TestDispatcherRun() {
+	server := s.sequenceServer(mixedStreamMessages...)
+	defer server.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	dispatcher := core.NewDispatcher(s.client.WsClient.WsClient, Streams.AggTrade("ETHUSDT"), Streams.AggTrade("BNBUSDT"))
+	var depths, decodeErrors int
+	var symbols []string
+	core.Handle(dispatcher, Streams.Depth("BTCUSDT", 100*time.Millisecond), func(e *DepthEvent) {
+		depths++
+	})
+	core.Handle(dispatcher, Streams.UserData("listenKey"), func(e *UserDataEvent) {
+		s.r().Equal(balanceUpdate, string(e.Event))
+	})
+	core.On(dispatcher, func(stream string, e *AggTradeEvent) {
+		symbols = append(symbols, e.Symbol)
+		if e.Symbol == "BNBUSDT" {
+			cancel()
+		}
+	})
+	dispatcher.OnDecodeError(func(err *core.DecodeError) {
+		decodeErrors++
+	})
+	r := s.r()
+	r.ErrorIs(dispatcher.Run(ctx), context.Canceled)
+	r.Equal(1, depths, "depths")
+	r.Equal(1, decodeErrors, "decodeErrors")
+	r.Equal([]string{"ETHUSDT", "BNBUSDT"}, symbols)
+}
+
+func (s *streamDescriptorsTestSuite) TestStreamLifecycle() {
+	server := s.echoServer()
+	defer server.Close()
+	r := s.r()
+	stream := s.client.NewWebsocketStreams().SubscribeAggTrade("BTCUSDT").Buffer(1)
+	r.Nil(stream.Messages(), "Messages before Start")
+	r.NoError(stream.Start(context.Background()))
+	r.ErrorIs(stream.Start(context.Background()), core.ErrStreamStarted)
+	select {
+	case event := <-stream.Messages():
+		r.Equal("/ws/btcusdt@aggTrade", event.Event)
+	case <-time.After(5 * time.Second):
+		s.FailNow("no event received")
+	}
+	r.NoError(stream.Close())
+	select {
+	case <-stream.Done():
+	case <-time.After(5 * time.Second):
+		s.FailNow("stream not closed")
+	}
+	r.NoError(stream.Err())
+	for range stream.Messages() {
+	}
+	_, ok := <-stream.Errors()
+	r.False(ok, "Errors closed")
+}
+
+func (s *streamDescriptorsTestSuite) TestStreamCallbacks() {
+	server := s.echoServer()
+	defer server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var events int
+	stream := core.NewRawStream(s.client.WsClient.WsClient, Streams.Trade("ETHUSDT")).
+		Decoder(func(message []byte) (*TradeEvent, error) {
+			return &TradeEvent{Event: "decoded " + string(message)}, nil
+		}).
+		OnMessage(func(event *TradeEvent) {
+			s.r().Equal(`decoded {"e":"/ws/ethusdt@trade"}`, event.Event)
+			if events++; events == 3 {
+				cancel()
+			}
+		})
+	s.r().NoError(stream.Start(ctx))
+	select {
+	case <-stream.Done():
+	case <-time.After(5 * time.Second):
+		s.FailNow("stream not closed")
+	}
+	s.r().Equal(3, events, "events")
+	s.r().ErrorIs(stream.Err(), context.Canceled)
+}
+
+func (s *streamDescriptorsTestSuite) TestStreamConnectionError() {
+	server := s.echoServer()
+	server.Close()
+	var errs []error
+	stream := s.client.NewWebsocketStreams().SubscribeBookTicker("BTCUSDT")
+	stream.OnError(func(err error) {
+		errs = append(errs, err)
+	})
+	s.r().NoError(stream.Start(context.Background()))
+	select {
+	case <-stream.Done():
+	case <-time.After(5 * time.Second):
+		s.FailNow("stream not closed")
+	}
+	s.r().Error(stream.Err())
+	s.r().Len(errs, 1)
+	s.r().Equal(stream.Err(), errs[0])
+}
+
+func (s *streamDescriptorsTestSuite) TestStreamCallbackServerGone() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{CheckOrigin: func(r *http.Request) bool { return true }}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"e":"aggTrade","a":1}`))
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`not json`))
+		_ = conn.Close()
+	}))
+	defer server.Close()
+	s.mockClient("ws" + server.URL[4:])
+	stream := s.client.NewWebsocketStreams().SubscribeAggTrade("BTCUSDT").
+		OnMessage(func(event *AggTradeEvent) {})
+	s.r().NoError(stream.Start(context.Background()))
+	waited := make(chan error, 1)
+	go func() {
+		waited <- stream.Wait()
+	}()
+	select {
+	case err := <-waited:
+		s.r().Error(err)
+		s.r().Equal(stream.Err(), err)
+	case <-time.After(5 * time.Second):
+		s.FailNow("Wait did not return after the server went away")
+	}
+}
+
+func (s *streamDescriptorsTestSuite) aggTrades(n int) []string {
+	msgs := make([]string, 0, n)
+	for i := 1; i <= n; i++ {
+		msgs = append(msgs, fmt.Sprintf(`{"e":"aggTrade","s":"BTCUSDT","a":%d}`, i))
+	}
+	return msgs
+}
+
+func (s *streamDescriptorsTestSuite) receive(messages <-chan *AggTradeEvent, n int) []int64 {
+	var ids []int64
+	for len(ids) < n {
+		select {
+		case event := <-messages:
+			ids = append(ids, event.AggTradeID)
+		case <-time.After(5 * time.Second):
+			s.FailNow("no event received")
+		}
+	}
+	return ids
+}
+
+func (s *streamDescriptorsTestSuite) TestOverflowDropNewest() {
+	server := s.sequenceServer(s.aggTrades(5)...)
+	defer server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := s.client.NewWebsocketStreams().SubscribeAggTrade("BTCUSDT").Buffer(2).Overflow(core.OverflowDropNewest)
+	messages, _ := stream.Do(ctx)
+	s.r().Eventually(func() bool { return stream.Dropped() == 3 }, 5*time.Second, 10*time.Millisecond)
+	s.r().Equal([]int64{1, 2}, s.receive(messages, 2))
+}
+
+func (s *streamDescriptorsTestSuite) TestOverflowDropOldest() {
+	server := s.sequenceServer(s.aggTrades(5)...)
+	defer server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := s.client.NewWebsocketStreams().SubscribeAggTrade("BTCUSDT").Buffer(2).Overflow(core.OverflowDropOldest)
+	messages, _ := stream.Do(ctx)
+	s.r().Eventually(func() bool { return stream.Dropped() == 3 }, 5*time.Second, 10*time.Millisecond)
+	s.r().Equal([]int64{4, 5}, s.receive(messages, 2))
+}
+
+func (s *streamDescriptorsTestSuite) TestOverflowConflate() {
+	server := s.sequenceServer(
+		`{"u":1,"s":"BTCUSDT"}`, `{"u":2,"s":"ETHUSDT"}`, `{"u":3,"s":"BTCUSDT"}`, `{"u":4,"s":"BTCUSDT"}`, `{"u":5,"s":"ETHUSDT"}`,
+	)
+	defer server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := s.client.NewWebsocketStreams().SubscribeBookTicker("!bookTicker").Buffer(1).
+		Conflate(func(event *BookTickerEvent) string { return event.Symbol })
+	messages, _ := stream.Do(ctx)
+	s.r().Eventually(func() bool { return stream.Conflated() > 0 }, 5*time.Second, 10*time.Millisecond)
+	latest := make(map[string]int)
+	var received int
+	for latest["BTCUSDT"] != 4 || latest["ETHUSDT"] != 5 {
+		select {
+		case event := <-messages:
+			s.r().Greater(event.UpdateId, latest[event.Symbol], "in order")
+			latest[event.Symbol] = event.UpdateId
+			received++
+		case <-time.After(5 * time.Second):
+			s.FailNow("no event received")
+		}
+	}
+	s.r().Equal(uint64(5), uint64(received)+stream.Conflated(), "received + conflated")
+}
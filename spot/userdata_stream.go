@@ -1,14 +1,13 @@
 package spot
 
 import (
-	"context"
 	"encoding/json"
-	"fmt"
+	"github.com/jekaxv/go-binance/core"
 	"github.com/shopspring/decimal"
 )
 
 type UserDataStream struct {
-	*WebsocketStreams
+	*core.Stream[*UserDataEvent]
 }
 
 type UserDataEventType string
@@ -23,6 +22,8 @@ const (
 	externalLockUpdate                        = "externalLockUpdate"
 )
 
+const execTypeReplaced = "REPLACED"
+
 type UserDataEvent struct {
 	Event              UserDataEventType `json:"e"`
 	Time               int64             `json:"E"`
@@ -70,7 +71,7 @@ type OrderUpdate struct {
 	IcebergQuantity         decimal.Decimal `json:"F"` // Iceberg quantity
 	OrderListId             int             `json:"g"` // OrderListId
 	OriginalOrderId         string          `json:"C"` // Original client order ID; This is the ID of the order being canceled
-	CurrentExecType         string          `json:"x"` // Current execution type
+	CurrentExecType         string          `json:"x"` // Current execution type; REPLACED when the order was amended with keepPriority
 	CurrentOrderStatus      string          `json:"X"` // Current order status
 	OrderRejectReason       string          `json:"r"` // Order reject reason; will be an error code.
 	OrderId                 int             `json:"i"` // Order ID
@@ -94,6 +95,12 @@ type OrderUpdate struct {
 	SelfTradePreventionMode string          `json:"V"` // SelfTradePreventionMode
 }
 
+// IsAmendment reports whether the report was sent for an order amended with keepPriority.
+// OrderQuantity then holds the amended quantity and ClientOrderId the new client order ID, the previous one is in OriginalOrderId.
+func (e *OrderUpdate) IsAmendment() bool {
+	return e.CurrentExecType == execTypeReplaced
+}
+
 type ListStatus struct {
 	Symbol           string          `json:"s"` // Symbol
 	OrderListId      int             `json:"g"` // OrderListId
@@ -138,40 +145,10 @@ type ExternalLockUpdate struct {
 }
 
 func (s *WebsocketStreams) SubscribeUserData(listenKey string) *UserDataStream {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s", s.c.getEndpoint(), listenKey))
-	return &UserDataStream{s}
-}
-
-func (e *UserDataStream) Do(ctx context.Context) (<-chan *UserDataEvent, <-chan error) {
-	messageCh := make(chan *UserDataEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				event, err := e.parseUserEvent(message)
-				if err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &UserDataStream{core.NewRawStream(s.c.WsClient, Streams.UserData(listenKey))}
 }
 
-func (e *UserDataStream) parseUserEvent(message []byte) (*UserDataEvent, error) {
+func parseUserEvent(message []byte) (*UserDataEvent, error) {
 	var event *UserDataEvent
 	if err := json.Unmarshal(message, &event); err != nil {
 		return event, err
@@ -0,0 +1,47 @@
+package spot
+
+import (
+	"context"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type userDataStreamTestSuite struct {
+	baseWsTestSuite
+}
+
+func TestUserDataStream(t *testing.T) {
+	suite.Run(t, new(userDataStreamTestSuite))
+}
+
+func (s *userDataStreamTestSuite) subscribe(msg []byte) *UserDataEvent {
+	server := s.setup(msg)
+	defer server.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	onMessage, onError := s.client.NewWebsocketStreams().SubscribeUserData("listenKey").Do(ctx)
+	select {
+	case event := <-onMessage:
+		return event
+	case err := <-onError:
+		s.FailNow(err.Error())
+	}
+	return nil
+}
+
+func (s *userDataStreamTestSuite) TestExecutionReport() {
+	event := s.subscribe([]byte(`{"e":"executionReport","E":1499405658658,"s":"ETHBTC","c":"mUvoqJxFIILMdfAW5iGSOW","S":"BUY","o":"LIMIT","f":"GTC","q":"1.00000000","p":"0.10264410","P":"0.00000000","F":"0.00000000","g":-1,"C":"","x":"NEW","X":"NEW","r":"NONE","i":4293153,"l":"0.00000000","z":"0.00000000","L":"0.00000000","n":"0","N":null,"T":1499405658657,"t":-1,"I":8641984,"w":true,"m":false,"M":false,"O":1499405658657,"Z":"0.00000000","Y":"0.00000000","Q":"0.00000000","V":"NONE"}`))
+	r := s.r()
+	r.Equal(executionReport, string(event.Event), "Event")
+	r.Equal(4293153, event.OrderUpdate.OrderId, "OrderId")
+	r.False(event.OrderUpdate.IsAmendment(), "IsAmendment")
+}
+
+func (s *userDataStreamTestSuite) TestExecutionReportAmendment() {
+	event := s.subscribe([]byte(`{"e":"executionReport","E":1741926410255,"s":"BTCUSDT","c":"PFaq6hIHxqFENGfdtn4J6Q","S":"SELL","o":"LIMIT","f":"GTC","q":"5.00000000","p":"6.00000000","P":"0.00000000","F":"0.00000000","g":-1,"C":"5xrgbMyg6z36NzBn2pbT8H","x":"REPLACED","X":"NEW","r":"NONE","i":33,"l":"0.00000000","z":"0.00000000","L":"0.00000000","n":"0","N":null,"T":1741926410255,"t":-1,"I":75,"w":true,"m":false,"M":false,"O":1741926410242,"Z":"0.00000000","Y":"0.00000000","Q":"0.00000000","W":1741926410242,"V":"NONE"}`))
+	r := s.r()
+	r.True(event.OrderUpdate.IsAmendment(), "IsAmendment")
+	r.Equal("5", event.OrderUpdate.OrderQuantity.String(), "OrderQuantity")
+	r.Equal("5xrgbMyg6z36NzBn2pbT8H", event.OrderUpdate.OriginalOrderId, "OriginalOrderId")
+	r.Equal("NEW", event.OrderUpdate.CurrentOrderStatus, "CurrentOrderStatus")
+}
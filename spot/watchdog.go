@@ -0,0 +1,135 @@
+package spot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Watchdog Client-side dead-man's switch. Spot has no countdown endpoint, so open orders of every
+// symbol are canceled with CancelOpenOrder when Beat has not been called within the timeout.
+// Unlike the futures heartbeat it cannot protect against the process dying, only against it stalling.
+type Watchdog struct {
+	c         *Client
+	symbols   []string
+	timeout   time.Duration
+	onTrigger func(symbol string, err error)
+
+	mu        sync.Mutex
+	lastBeat  time.Time
+	triggered bool
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+// NewWatchdog Cancel open orders of symbols when no Beat arrives within timeout.
+func (c *Client) NewWatchdog(timeout time.Duration, symbols ...string) *Watchdog {
+	return &Watchdog{c: c, symbols: symbols, timeout: timeout}
+}
+
+// OnTrigger Callback for every cancel issued on a stall, err is nil when the cancel succeeded or the symbol had no open orders.
+// A failed cancel is retried with a doubling delay, capped at the timeout, until it succeeds or Beat is called.
+func (w *Watchdog) OnTrigger(fn func(symbol string, err error)) *Watchdog {
+	w.onTrigger = fn
+	return w
+}
+
+// Beat Signal liveness. It also re-arms the watchdog after a stall.
+func (w *Watchdog) Beat() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.lastBeat = time.Now()
+	w.triggered = false
+}
+
+// Start Begin watching; the timeout counts from now.
+func (w *Watchdog) Start(ctx context.Context) error {
+	if w.timeout <= 0 {
+		return errors.New("watchdog: timeout must be positive")
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.cancel != nil {
+		return errors.New("watchdog: already started")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	w.cancel = cancel
+	w.lastBeat = time.Now()
+	w.triggered = false
+	w.done = make(chan struct{})
+	go w.run(ctx, w.done)
+	return nil
+}
+
+func (w *Watchdog) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+	c := &Client{w.c.Clone()}
+	check := w.timeout / 10
+	if check < 10*time.Millisecond {
+		check = 10 * time.Millisecond
+	}
+	ticker := time.NewTicker(check)
+	defer ticker.Stop()
+	// pending The symbols whose cancel is still to be retried after a stall, at retryAt.
+	var pending []string
+	var retryAt time.Time
+	backoff := check
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.mu.Lock()
+			if !w.triggered {
+				pending = nil
+			}
+			if !w.triggered && time.Since(w.lastBeat) > w.timeout {
+				w.triggered = true
+				pending, retryAt, backoff = w.symbols, time.Time{}, check
+			}
+			w.mu.Unlock()
+			if len(pending) == 0 || time.Now().Before(retryAt) {
+				continue
+			}
+			var failed []string
+			for _, symbol := range pending {
+				_, err := c.NewCancelOpenOrder().Symbol(symbol).Do(ctx)
+				if err != nil && noOpenOrders(err) {
+					err = nil
+				}
+				if err != nil {
+					failed = append(failed, symbol)
+					w.c.Opt.Logger.Debug("watchdog cancel failed", "symbol", symbol, "error", err)
+				}
+				if w.onTrigger != nil {
+					w.onTrigger(symbol, err)
+				}
+			}
+			// retry the failed symbols with a doubling delay until every symbol is flat
+			pending = failed
+			retryAt = time.Now().Add(backoff)
+			backoff = min(2*backoff, w.timeout)
+		}
+	}
+}
+
+// noOpenOrders Whether err is the -2011 Binance returns for a symbol without open orders, which leaves it flat.
+func noOpenOrders(err error) bool {
+	var resp ApiError
+	return json.Unmarshal([]byte(err.Error()), &resp) == nil && resp.Code == -2011
+}
+
+// Stop Stop watching without canceling anything.
+func (w *Watchdog) Stop() {
+	w.mu.Lock()
+	cancel, done := w.cancel, w.done
+	w.cancel = nil
+	w.mu.Unlock()
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
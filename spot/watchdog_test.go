@@ -0,0 +1,129 @@
+package spot
+
+import (
+	"context"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type watchdogTestSuite struct {
+	baseHttpTestSuite
+}
+
+func TestWatchdog(t *testing.T) {
+	suite.Run(t, new(watchdogTestSuite))
+}
+
+func (s *watchdogTestSuite) TestTriggerOnStall() {
+	var cancels atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete && r.URL.Path == "/api/v3/openOrders" {
+			cancels.Add(1)
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+	s.client.Opt.Endpoint = server.URL
+	triggered := make(chan string, 4)
+	wd := s.client.NewWatchdog(100*time.Millisecond, "BTCUSDT").OnTrigger(func(symbol string, err error) {
+		s.NoError(err)
+		triggered <- symbol
+	})
+	r := s.r()
+	r.NoError(wd.Start(context.Background()))
+	defer wd.Stop()
+
+	// regular beats keep the orders alive
+	for i := 0; i < 5; i++ {
+		time.Sleep(40 * time.Millisecond)
+		wd.Beat()
+	}
+	r.Equal(int32(0), cancels.Load())
+
+	select {
+	case symbol := <-triggered:
+		r.Equal("BTCUSDT", symbol)
+	case <-time.After(time.Second):
+		r.Fail("watchdog did not trigger")
+	}
+	time.Sleep(150 * time.Millisecond)
+	r.Equal(int32(1), cancels.Load(), "triggers once per stall")
+
+	wd.Beat()
+	select {
+	case <-triggered:
+	case <-time.After(time.Second):
+		r.Fail("watchdog did not re-arm after beat")
+	}
+	r.Equal(int32(2), cancels.Load())
+}
+
+func (s *watchdogTestSuite) TestStop() {
+	var cancels atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cancels.Add(1)
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+	s.client.Opt.Endpoint = server.URL
+	wd := s.client.NewWatchdog(50*time.Millisecond, "BTCUSDT")
+	r := s.r()
+	r.Error(s.client.NewWatchdog(0).Start(context.Background()))
+	r.NoError(wd.Start(context.Background()))
+	wd.Stop()
+	wd.Stop()
+	time.Sleep(100 * time.Millisecond)
+	r.Equal(int32(0), cancels.Load())
+}
+
+func (s *watchdogTestSuite) TestNoOpenOrders() {
+	var cancels atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cancels.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"code":-2011,"msg":"Unknown order sent."}`))
+	}))
+	defer server.Close()
+	s.client.Opt.Endpoint = server.URL
+	triggered := make(chan error, 4)
+	wd := s.client.NewWatchdog(50*time.Millisecond, "BTCUSDT").OnTrigger(func(symbol string, err error) {
+		triggered <- err
+	})
+	r := s.r()
+	r.NoError(wd.Start(context.Background()))
+	defer wd.Stop()
+	select {
+	case err := <-triggered:
+		r.NoError(err, "no open orders counts as canceled")
+	case <-time.After(time.Second):
+		r.Fail("watchdog did not trigger")
+	}
+	time.Sleep(200 * time.Millisecond)
+	r.Equal(int32(1), cancels.Load(), "no retry for a symbol without open orders")
+	r.Empty(triggered)
+}
+
+func (s *watchdogTestSuite) TestRetryBackoff() {
+	var cancels atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cancels.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"code":-1001,"msg":"Internal error; unable to process your request. Please try again."}`))
+	}))
+	defer server.Close()
+	s.client.Opt.Endpoint = server.URL
+	wd := s.client.NewWatchdog(100*time.Millisecond, "BTCUSDT").OnTrigger(func(symbol string, err error) {
+		s.Error(err)
+	})
+	r := s.r()
+	r.NoError(wd.Start(context.Background()))
+	time.Sleep(600 * time.Millisecond)
+	wd.Stop()
+	// the stall fires after ~100ms, then retries follow after 10, 20, 40, 80, 100, 100ms instead of every 10ms tick
+	r.GreaterOrEqual(cancels.Load(), int32(3), "failed cancels are retried")
+	r.LessOrEqual(cancels.Load(), int32(10), "retries back off")
+}
@@ -123,27 +123,12 @@ type WsPingResponse struct {
 }
 
 func (s *WsPing) Do(ctx context.Context) (*WsPingResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsPingResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsPingResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsServerTime Test connectivity to the WebSocket API and get the current server time.
@@ -160,27 +145,12 @@ type WsServerTimeResponse struct {
 }
 
 func (s *WsServerTime) Do(ctx context.Context) (*WsServerTimeResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsServerTimeResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsServerTimeResponse
+	return resp, json.Unmarshal(message, &resp)
 }
 
 // WsExchangeInfo Query current exchange trading rules, rate limits, and symbol information.
@@ -234,25 +204,10 @@ func (s *WsExchangeInfo) SymbolStatus(symbolStatus core.SymbolStatusEnum) *WsExc
 	return s
 }
 func (s *WsExchangeInfo) Do(ctx context.Context) (*WsExchangeInfoResponse, error) {
-	onMessage, onError := s.c.wsApiServe(ctx)
-	if err := s.c.send(s.r); err != nil {
+	message, err := s.c.call(ctx, s.r)
+	if err != nil {
 		return nil, err
 	}
-	defer func(c *WsClient) {
-		err := c.close()
-		if err != nil {
-			s.c.Opt.Logger.Debug("websocket close failed", "error", err)
-		}
-	}(s.c)
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		case message := <-onMessage:
-			var resp *WsExchangeInfoResponse
-			return resp, json.Unmarshal(message, &resp)
-		case err := <-onError:
-			return nil, err
-		}
-	}
+	var resp *WsExchangeInfoResponse
+	return resp, json.Unmarshal(message, &resp)
 }
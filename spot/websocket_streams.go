@@ -1,11 +1,8 @@
 package spot
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
+	"github.com/jekaxv/go-binance/core"
 	"github.com/shopspring/decimal"
-	"strings"
 )
 
 type WebsocketStreams struct {
@@ -14,7 +11,7 @@ type WebsocketStreams struct {
 
 // AggTradeService The Aggregate Trade Streams push trade information that is aggregated for a single taker order.
 type AggTradeService struct {
-	*WebsocketStreams
+	*core.Stream[*AggTradeEvent]
 }
 
 type AggTradeEvent struct {
@@ -33,41 +30,11 @@ type AggTradeEvent struct {
 
 // SubscribeAggTrade Stream Name: <symbol>@aggTrade
 func (s *WebsocketStreams) SubscribeAggTrade(symbol string) *AggTradeService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s@aggTrade", s.c.getEndpoint(), strings.ToLower(symbol)))
-	return &AggTradeService{s}
-}
-
-func (e *AggTradeService) Do(ctx context.Context) (<-chan *AggTradeEvent, <-chan error) {
-	messageCh := make(chan *AggTradeEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *AggTradeEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &AggTradeService{core.NewRawStream(s.c.WsClient, Streams.AggTrade(symbol))}
 }
 
 type CombinedAggTradeService struct {
-	*WebsocketStreams
+	*core.Stream[*CombinedAggTradeEvent]
 }
 
 type CombinedAggTradeEvent struct {
@@ -76,46 +43,16 @@ type CombinedAggTradeEvent struct {
 }
 
 func (s *WebsocketStreams) SubscribeCombinedAggTrade(symbols []string) *CombinedAggTradeService {
-	s.c.combined(true)
-	endpoint := s.c.getEndpoint()
+	streams := make([]core.Descriptor, 0, len(symbols))
 	for _, symbol := range symbols {
-		endpoint += fmt.Sprintf("%s@aggTrade", strings.ToLower(symbol)) + "/"
+		streams = append(streams, Streams.AggTrade(symbol))
 	}
-	s.c.setEndpoint(endpoint[:len(endpoint)-1])
-	return &CombinedAggTradeService{s}
-}
-
-func (e *CombinedAggTradeService) Do(ctx context.Context) (<-chan *CombinedAggTradeEvent, <-chan error) {
-	messageCh := make(chan *CombinedAggTradeEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CombinedAggTradeEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CombinedAggTradeService{core.NewCombinedStream[*CombinedAggTradeEvent](s.c.WsClient, streams...)}
 }
 
 // TradeService The Trade Streams push raw trade information; each trade has a unique buyer and seller.
 type TradeService struct {
-	*WebsocketStreams
+	*core.Stream[*TradeEvent]
 }
 
 type TradeEvent struct {
@@ -132,41 +69,11 @@ type TradeEvent struct {
 
 // SubscribeTrade Stream Name: <symbol>@trade
 func (s *WebsocketStreams) SubscribeTrade(symbol string) *TradeService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s@trade", s.c.getEndpoint(), strings.ToLower(symbol)))
-	return &TradeService{s}
-}
-
-func (e *TradeService) Do(ctx context.Context) (<-chan *TradeEvent, <-chan error) {
-	messageCh := make(chan *TradeEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *TradeEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &TradeService{core.NewRawStream(s.c.WsClient, Streams.Trade(symbol))}
 }
 
 type CombinedTradeService struct {
-	*WebsocketStreams
+	*core.Stream[*CombinedTradeEvent]
 }
 
 type CombinedTradeEvent struct {
@@ -175,46 +82,16 @@ type CombinedTradeEvent struct {
 }
 
 func (s *WebsocketStreams) SubscribeCombinedTrade(symbols []string) *CombinedTradeService {
-	s.c.combined(true)
-	endpoint := s.c.getEndpoint()
+	streams := make([]core.Descriptor, 0, len(symbols))
 	for _, symbol := range symbols {
-		endpoint += fmt.Sprintf("%s@trade", strings.ToLower(symbol)) + "/"
+		streams = append(streams, Streams.Trade(symbol))
 	}
-	s.c.setEndpoint(endpoint[:len(endpoint)-1])
-	return &CombinedTradeService{s}
-}
-
-func (e *CombinedTradeService) Do(ctx context.Context) (<-chan *CombinedTradeEvent, <-chan error) {
-	messageCh := make(chan *CombinedTradeEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CombinedTradeEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CombinedTradeService{core.NewCombinedStream[*CombinedTradeEvent](s.c.WsClient, streams...)}
 }
 
 // KlineService The Kline/Candlestick Stream push updates to the current klines/candlestick every second in UTC+0 timezone
 type KlineService struct {
-	*WebsocketStreams
+	*core.Stream[*KlineEvent]
 }
 
 type KlineEvent struct {
@@ -244,41 +121,11 @@ type KlineEvent struct {
 
 // SubscribeKline Stream Name: <symbol>@kline_<interval>
 func (s *WebsocketStreams) SubscribeKline(symbol, interval string) *KlineService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s@kline_%s", s.c.getEndpoint(), strings.ToLower(symbol), interval))
-	return &KlineService{s}
-}
-
-func (e *KlineService) Do(ctx context.Context) (<-chan *KlineEvent, <-chan error) {
-	messageCh := make(chan *KlineEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *KlineEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &KlineService{core.NewRawStream(s.c.WsClient, Streams.Kline(symbol, core.IntervalEnum(interval)))}
 }
 
 type CombinedKlineService struct {
-	*WebsocketStreams
+	*core.Stream[*CombinedKlineEvent]
 }
 
 type CombinedKlineEvent struct {
@@ -288,46 +135,16 @@ type CombinedKlineEvent struct {
 
 // SubscribeCombinedKline Stream Name: <symbol>@kline_<interval>
 func (s *WebsocketStreams) SubscribeCombinedKline(symbols map[string]string) *CombinedKlineService {
-	s.c.combined(true)
-	endpoint := s.c.getEndpoint()
+	streams := make([]core.Descriptor, 0, len(symbols))
 	for symbol, interval := range symbols {
-		endpoint += fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval) + "/"
+		streams = append(streams, Streams.Kline(symbol, core.IntervalEnum(interval)))
 	}
-	s.c.setEndpoint(endpoint[:len(endpoint)-1])
-	return &CombinedKlineService{s}
-}
-
-func (e *CombinedKlineService) Do(ctx context.Context) (<-chan *CombinedKlineEvent, <-chan error) {
-	messageCh := make(chan *CombinedKlineEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CombinedKlineEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CombinedKlineService{core.NewCombinedStream[*CombinedKlineEvent](s.c.WsClient, streams...)}
 }
 
 // MiniTickerService 24hr rolling window mini-ticker statistics. These are NOT the statistics of the UTC day, but a 24hr rolling window for the previous 24hrs.
 type MiniTickerService struct {
-	*WebsocketStreams
+	*core.Stream[*MiniTickerEvent]
 }
 
 type MiniTickerEvent struct {
@@ -344,41 +161,11 @@ type MiniTickerEvent struct {
 
 // SubscribeMiniTicker Stream Name: <symbol>@miniTicker
 func (s *WebsocketStreams) SubscribeMiniTicker(symbol string) *MiniTickerService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s@miniTicker", s.c.getEndpoint(), strings.ToLower(symbol)))
-	return &MiniTickerService{s}
-}
-
-func (e *MiniTickerService) Do(ctx context.Context) (<-chan *MiniTickerEvent, <-chan error) {
-	messageCh := make(chan *MiniTickerEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *MiniTickerEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &MiniTickerService{core.NewRawStream(s.c.WsClient, Streams.MiniTicker(symbol))}
 }
 
 type CombinedMiniTickerService struct {
-	*WebsocketStreams
+	*core.Stream[*CombinedMiniTickerEvent]
 }
 
 type CombinedMiniTickerEvent struct {
@@ -388,89 +175,29 @@ type CombinedMiniTickerEvent struct {
 
 // SubscribeCombinedMiniTicker Stream Name: <symbol>@miniTicker
 func (s *WebsocketStreams) SubscribeCombinedMiniTicker(symbols []string) *CombinedMiniTickerService {
-	s.c.combined(true)
-	endpoint := s.c.getEndpoint()
+	streams := make([]core.Descriptor, 0, len(symbols))
 	for _, symbol := range symbols {
-		endpoint += fmt.Sprintf("%s@miniTicker", strings.ToLower(symbol)) + "/"
+		streams = append(streams, Streams.MiniTicker(symbol))
 	}
-	s.c.setEndpoint(endpoint[:len(endpoint)-1])
-	return &CombinedMiniTickerService{s}
-}
-
-func (e *CombinedMiniTickerService) Do(ctx context.Context) (<-chan *CombinedMiniTickerEvent, <-chan error) {
-	messageCh := make(chan *CombinedMiniTickerEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CombinedMiniTickerEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CombinedMiniTickerService{core.NewCombinedStream[*CombinedMiniTickerEvent](s.c.WsClient, streams...)}
 }
 
 // MiniTickerArrService 24hr rolling window mini-ticker statistics for all symbols that changed in an array.
 // These are NOT the statistics of the UTC day, but a 24hr rolling window for the previous 24hrs.
 // Note that only tickers that have changed will be present in the array.
 type MiniTickerArrService struct {
-	*WebsocketStreams
+	*core.Stream[[]*MiniTickerEvent]
 }
 
 // SubscribeMiniTickerArr Stream Name: !miniTicker@arr
 func (s *WebsocketStreams) SubscribeMiniTickerArr() *MiniTickerArrService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/!miniTicker@arr", s.c.getEndpoint()))
-	return &MiniTickerArrService{s}
-}
-
-func (e *MiniTickerArrService) Do(ctx context.Context) (<-chan []*MiniTickerEvent, <-chan error) {
-	messageCh := make(chan []*MiniTickerEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event []*MiniTickerEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &MiniTickerArrService{core.NewRawStream(s.c.WsClient, Streams.MiniTickerArr())}
 }
 
 // TickerService 24hr rolling window ticker statistics for a single symbol.
 // These are NOT the statistics of the UTC day, but a 24hr rolling window for the previous 24hrs.
 type TickerService struct {
-	*WebsocketStreams
+	*core.Stream[*TickerEvent]
 }
 
 type TickerEvent struct {
@@ -501,41 +228,11 @@ type TickerEvent struct {
 
 // SubscribeTicker Stream Name: <symbol>@ticker
 func (s *WebsocketStreams) SubscribeTicker(symbol string) *TickerService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s@ticker", s.c.getEndpoint(), strings.ToLower(symbol)))
-	return &TickerService{s}
-}
-
-func (e *TickerService) Do(ctx context.Context) (<-chan *TickerEvent, <-chan error) {
-	messageCh := make(chan *TickerEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *TickerEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &TickerService{core.NewRawStream(s.c.WsClient, Streams.Ticker(symbol))}
 }
 
 type CombinedTickerService struct {
-	*WebsocketStreams
+	*core.Stream[*CombinedTickerEvent]
 }
 
 type CombinedTickerEvent struct {
@@ -545,89 +242,28 @@ type CombinedTickerEvent struct {
 
 // SubscribeCombinedTicker Stream Name: <symbol>@ticker
 func (s *WebsocketStreams) SubscribeCombinedTicker(symbols []string) *CombinedTickerService {
-	s.c.combined(true)
-	endpoint := s.c.getEndpoint()
+	streams := make([]core.Descriptor, 0, len(symbols))
 	for _, symbol := range symbols {
-		endpoint += fmt.Sprintf("%s@ticker", strings.ToLower(symbol)) + "/"
+		streams = append(streams, Streams.Ticker(symbol))
 	}
-	s.c.setEndpoint(endpoint[:len(endpoint)-1])
-
-	return &CombinedTickerService{s}
-}
-
-func (e *CombinedTickerService) Do(ctx context.Context) (<-chan *CombinedTickerEvent, <-chan error) {
-	messageCh := make(chan *CombinedTickerEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CombinedTickerEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CombinedTickerService{core.NewCombinedStream[*CombinedTickerEvent](s.c.WsClient, streams...)}
 }
 
 // TickerArrService 24hr rolling window ticker statistics for all symbols that changed in an array.
 // These are NOT the statistics of the UTC day, but a 24hr rolling window for the previous 24hrs.
 // Note that only tickers that have changed will be present in the array.
 type TickerArrService struct {
-	*WebsocketStreams
+	*core.Stream[[]*TickerEvent]
 }
 
 // SubscribeTickerArr Stream Name: !ticker@arr
 func (s *WebsocketStreams) SubscribeTickerArr() *TickerArrService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/!ticker@arr", s.c.getEndpoint()))
-	return &TickerArrService{s}
-}
-
-func (e *TickerArrService) Do(ctx context.Context) (<-chan []*TickerEvent, <-chan error) {
-	messageCh := make(chan []*TickerEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event []*TickerEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &TickerArrService{core.NewRawStream(s.c.WsClient, Streams.TickerArr())}
 }
 
 // TickerWindowSizeService Rolling window ticker statistics for a single symbol, computed over multiple windows.
 type TickerWindowSizeService struct {
-	*WebsocketStreams
+	*core.Stream[*TickerWindowSizeEvent]
 }
 
 type TickerWindowSizeEvent struct {
@@ -653,41 +289,11 @@ type TickerWindowSizeEvent struct {
 // SubscribeTickerWindowSize Stream Name: <symbol>@ticker_<window_size>
 // windowSize: 1h,4h,1d
 func (s *WebsocketStreams) SubscribeTickerWindowSize(symbol, windowSize string) *TickerWindowSizeService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s@ticker_%s", s.c.getEndpoint(), strings.ToLower(symbol), windowSize))
-	return &TickerWindowSizeService{s}
-}
-
-func (e *TickerWindowSizeService) Do(ctx context.Context) (<-chan *TickerWindowSizeEvent, <-chan error) {
-	messageCh := make(chan *TickerWindowSizeEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *TickerWindowSizeEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &TickerWindowSizeService{core.NewRawStream(s.c.WsClient, Streams.TickerWindowSize(symbol, windowSize))}
 }
 
 type CombinedTickerWindowSizeService struct {
-	*WebsocketStreams
+	*core.Stream[*CombinedTickerWindowSizeEvent]
 }
 
 type CombinedTickerWindowSizeEvent struct {
@@ -698,89 +304,28 @@ type CombinedTickerWindowSizeEvent struct {
 // SubscribeCombinedTickerWindowSize Stream Name: <symbol>@ticker_<window_size>
 // windowSize: 1h,4h,1d
 func (s *WebsocketStreams) SubscribeCombinedTickerWindowSize(symbols map[string]string) *CombinedTickerWindowSizeService {
-	s.c.combined(true)
-	endpoint := s.c.getEndpoint()
+	streams := make([]core.Descriptor, 0, len(symbols))
 	for symbol, windowSize := range symbols {
-		endpoint += fmt.Sprintf("%s@ticker_%s", strings.ToLower(symbol), windowSize) + "/"
+		streams = append(streams, Streams.TickerWindowSize(symbol, windowSize))
 	}
-	s.c.setEndpoint(endpoint[:len(endpoint)-1])
-
-	return &CombinedTickerWindowSizeService{s}
-}
-
-func (e *CombinedTickerWindowSizeService) Do(ctx context.Context) (<-chan *CombinedTickerWindowSizeEvent, <-chan error) {
-	messageCh := make(chan *CombinedTickerWindowSizeEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CombinedTickerWindowSizeEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CombinedTickerWindowSizeService{core.NewCombinedStream[*CombinedTickerWindowSizeEvent](s.c.WsClient, streams...)}
 }
 
 // TickerWindowSizeArrService Rolling window ticker statistics for all market symbols, computed over multiple windows.
 // Note that only tickers that have changed will be present in the array.
 type TickerWindowSizeArrService struct {
-	*WebsocketStreams
+	*core.Stream[[]*TickerWindowSizeEvent]
 }
 
 // SubscribeTickerWindowSizeArr Stream Name: !ticker_<window-size>@arr
 func (s *WebsocketStreams) SubscribeTickerWindowSizeArr(windowSize string) *TickerWindowSizeArrService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/!ticker_%s@arr", s.c.getEndpoint(), windowSize))
-	return &TickerWindowSizeArrService{s}
-}
-
-func (e *TickerWindowSizeArrService) Do(ctx context.Context) (<-chan []*TickerWindowSizeEvent, <-chan error) {
-	messageCh := make(chan []*TickerWindowSizeEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event []*TickerWindowSizeEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &TickerWindowSizeArrService{core.NewRawStream(s.c.WsClient, Streams.TickerWindowSizeArr(windowSize))}
 }
 
 // BookTickerService Pushes any update to the best bid or ask's price or quantity in real-time for a specified symbol.
 // Multiple <symbol>@bookTicker streams can be subscribed to over one connection.
 type BookTickerService struct {
-	*WebsocketStreams
+	*core.Stream[*BookTickerEvent]
 }
 
 type BookTickerEvent struct {
@@ -794,41 +339,11 @@ type BookTickerEvent struct {
 
 // SubscribeBookTicker Stream Name: <symbol>@bookTicker
 func (s *WebsocketStreams) SubscribeBookTicker(symbol string) *BookTickerService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s@bookTicker", s.c.getEndpoint(), strings.ToLower(symbol)))
-	return &BookTickerService{s}
-}
-
-func (e *BookTickerService) Do(ctx context.Context) (<-chan *BookTickerEvent, <-chan error) {
-	messageCh := make(chan *BookTickerEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *BookTickerEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &BookTickerService{core.NewRawStream(s.c.WsClient, Streams.BookTicker(symbol))}
 }
 
 type CombinedBookTickerService struct {
-	*WebsocketStreams
+	*core.Stream[*CombinedBookTickerEvent]
 }
 
 type CombinedBookTickerEvent struct {
@@ -838,46 +353,16 @@ type CombinedBookTickerEvent struct {
 
 // SubscribeCombinedBookTicker Stream Name: <symbol>@bookTicker
 func (s *WebsocketStreams) SubscribeCombinedBookTicker(symbols []string) *CombinedBookTickerService {
-	s.c.combined(true)
-	endpoint := s.c.getEndpoint()
+	streams := make([]core.Descriptor, 0, len(symbols))
 	for _, symbol := range symbols {
-		endpoint += fmt.Sprintf("%s@bookTicker", strings.ToLower(symbol)) + "/"
+		streams = append(streams, Streams.BookTicker(symbol))
 	}
-	s.c.setEndpoint(endpoint[:len(endpoint)-1])
-	return &CombinedBookTickerService{s}
-}
-
-func (e *CombinedBookTickerService) Do(ctx context.Context) (<-chan *CombinedBookTickerEvent, <-chan error) {
-	messageCh := make(chan *CombinedBookTickerEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CombinedBookTickerEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CombinedBookTickerService{core.NewCombinedStream[*CombinedBookTickerEvent](s.c.WsClient, streams...)}
 }
 
 // AvgPriceService Average price streams push changes in the average price over a fixed time interval.
 type AvgPriceService struct {
-	*WebsocketStreams
+	*core.Stream[*AvgPriceEvent]
 }
 
 type AvgPriceEvent struct {
@@ -891,41 +376,11 @@ type AvgPriceEvent struct {
 
 // SubscribeAvgPrice Stream Name: <symbol>@avgPrice
 func (s *WebsocketStreams) SubscribeAvgPrice(symbol string) *AvgPriceService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s@avgPrice", s.c.getEndpoint(), strings.ToLower(symbol)))
-	return &AvgPriceService{s}
-}
-
-func (e *AvgPriceService) Do(ctx context.Context) (<-chan *AvgPriceEvent, <-chan error) {
-	messageCh := make(chan *AvgPriceEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *AvgPriceEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &AvgPriceService{core.NewRawStream(s.c.WsClient, Streams.AvgPrice(symbol))}
 }
 
 type CombinedAvgPriceService struct {
-	*WebsocketStreams
+	*core.Stream[*CombinedAvgPriceEvent]
 }
 
 type CombinedAvgPriceEvent struct {
@@ -935,46 +390,16 @@ type CombinedAvgPriceEvent struct {
 
 // SubscribeCombinedAvgPrice Stream Name: <symbol>@avgPrice
 func (s *WebsocketStreams) SubscribeCombinedAvgPrice(symbols []string) *CombinedAvgPriceService {
-	s.c.combined(true)
-	endpoint := s.c.getEndpoint()
+	streams := make([]core.Descriptor, 0, len(symbols))
 	for _, symbol := range symbols {
-		endpoint += fmt.Sprintf("%s@avgPrice", strings.ToLower(symbol)) + "/"
+		streams = append(streams, Streams.AvgPrice(symbol))
 	}
-	s.c.setEndpoint(endpoint[:len(endpoint)-1])
-	return &CombinedAvgPriceService{s}
-}
-
-func (e *CombinedAvgPriceService) Do(ctx context.Context) (<-chan *CombinedAvgPriceEvent, <-chan error) {
-	messageCh := make(chan *CombinedAvgPriceEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CombinedAvgPriceEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CombinedAvgPriceService{core.NewCombinedStream[*CombinedAvgPriceEvent](s.c.WsClient, streams...)}
 }
 
 // DepthLevelService Top <levels> bids and asks, pushed every second. Valid <levels> are 5, 10, or 20.
 type DepthLevelService struct {
-	*WebsocketStreams
+	*core.Stream[*DepthLevelEvent]
 }
 
 type DepthLevelEvent struct {
@@ -987,44 +412,11 @@ type DepthLevelEvent struct {
 // Top <levels> bids and asks, pushed every second. Valid <levels> are 5, 10, or 20.
 // Update Speed: 1000ms or 100ms
 func (s *WebsocketStreams) SubscribeDepthLevel(symbol string, level int, interval ...string) *DepthLevelService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s@depth%d", s.c.getEndpoint(), strings.ToLower(symbol), level))
-	if len(interval) != 0 {
-		s.c.setEndpoint(fmt.Sprintf("%s@%s", s.c.getEndpoint(), interval[0]))
-	}
-	return &DepthLevelService{s}
-}
-
-func (e *DepthLevelService) Do(ctx context.Context) (<-chan *DepthLevelEvent, <-chan error) {
-	messageCh := make(chan *DepthLevelEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *DepthLevelEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &DepthLevelService{core.NewRawStream(s.c.WsClient, Streams.DepthLevel(symbol, level, core.ParseUpdateSpeed(interval...)...))}
 }
 
 type CombinedDepthLevelService struct {
-	*WebsocketStreams
+	*core.Stream[*CombinedDepthLevelEvent]
 }
 
 type CombinedDepthLevelEvent struct {
@@ -1036,50 +428,16 @@ type CombinedDepthLevelEvent struct {
 // Top <levels> bids and asks, pushed every second. Valid <levels> are 5, 10, or 20.
 // Update Speed: 1000ms or 100ms
 func (s *WebsocketStreams) SubscribeCombinedDepthLevel(symbols map[string]int, interval ...string) *CombinedDepthLevelService {
-	s.c.combined(true)
-	endpoint := s.c.getEndpoint()
+	streams := make([]core.Descriptor, 0, len(symbols))
 	for symbol, level := range symbols {
-		endpoint += fmt.Sprintf("%s@depth%d", strings.ToLower(symbol), level)
-		if len(interval) != 0 {
-			endpoint += "@" + interval[0]
-		}
-		endpoint += "/"
+		streams = append(streams, Streams.DepthLevel(symbol, level, core.ParseUpdateSpeed(interval...)...))
 	}
-	s.c.setEndpoint(endpoint[:len(endpoint)-1])
-	return &CombinedDepthLevelService{s}
-}
-
-func (e *CombinedDepthLevelService) Do(ctx context.Context) (<-chan *CombinedDepthLevelEvent, <-chan error) {
-	messageCh := make(chan *CombinedDepthLevelEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CombinedDepthLevelEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CombinedDepthLevelService{core.NewCombinedStream[*CombinedDepthLevelEvent](s.c.WsClient, streams...)}
 }
 
 // DepthService Order book price and quantity depth updates used to locally manage an order book.
 type DepthService struct {
-	*WebsocketStreams
+	*core.Stream[*DepthEvent]
 }
 
 type DepthEvent struct {
@@ -1095,44 +453,11 @@ type DepthEvent struct {
 // SubscribeDepth Stream Names: <symbol>@depth OR <symbol>@depth@100ms
 // Update Speed: 1000ms or 100ms
 func (s *WebsocketStreams) SubscribeDepth(symbol string, interval ...string) *DepthService {
-	s.c.combined(false)
-	s.c.setEndpoint(fmt.Sprintf("%s/%s@depth", s.c.getEndpoint(), strings.ToLower(symbol)))
-	if len(interval) != 0 {
-		s.c.setEndpoint(fmt.Sprintf("%s@%s", s.c.getEndpoint(), interval[0]))
-	}
-	return &DepthService{s}
-}
-
-func (e *DepthService) Do(ctx context.Context) (<-chan *DepthEvent, <-chan error) {
-	messageCh := make(chan *DepthEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *DepthEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &DepthService{core.NewRawStream(s.c.WsClient, Streams.Depth(symbol, core.ParseUpdateSpeed(interval...)...))}
 }
 
 type CombinedDepthService struct {
-	*WebsocketStreams
+	*core.Stream[*CombinedDepthEvent]
 }
 
 type CombinedDepthEvent struct {
@@ -1143,43 +468,9 @@ type CombinedDepthEvent struct {
 // SubscribeCombinedDepth Stream Names: <symbol>@depth OR <symbol>@depth@100ms
 // Update Speed: 1000ms or 100ms
 func (s *WebsocketStreams) SubscribeCombinedDepth(symbols []string, interval ...string) *CombinedDepthService {
-	s.c.combined(true)
-	endpoint := s.c.getEndpoint()
+	streams := make([]core.Descriptor, 0, len(symbols))
 	for _, symbol := range symbols {
-		endpoint += fmt.Sprintf("%s@depth", strings.ToLower(symbol))
-		if len(interval) != 0 {
-			endpoint += "@" + interval[0]
-		}
-		endpoint += "/"
+		streams = append(streams, Streams.Depth(symbol, core.ParseUpdateSpeed(interval...)...))
 	}
-	s.c.setEndpoint(endpoint[:len(endpoint)-1])
-	return &CombinedDepthService{s}
-}
-
-func (e *CombinedDepthService) Do(ctx context.Context) (<-chan *CombinedDepthEvent, <-chan error) {
-	messageCh := make(chan *CombinedDepthEvent, 8)
-	errorCh := make(chan error)
-
-	go func() {
-		defer close(messageCh)
-		defer close(errorCh)
-		onMessage, onError := e.c.wsServe(ctx)
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case message := <-onMessage:
-				var event *CombinedDepthEvent
-				if err := json.Unmarshal(message, &event); err != nil {
-					errorCh <- err
-					continue
-				}
-				messageCh <- event
-			case err := <-onError:
-				errorCh <- err
-				return
-			}
-		}
-	}()
-	return messageCh, errorCh
+	return &CombinedDepthService{core.NewCombinedStream[*CombinedDepthEvent](s.c.WsClient, streams...)}
 }
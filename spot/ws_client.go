@@ -9,31 +9,8 @@ type WsClient struct {
 	*core.WsClient
 }
 
-func (c *WsClient) wsServe(ctx context.Context) (<-chan []byte, <-chan error) {
-	return c.WsServe(ctx)
-}
-
-func (c *WsClient) combined(combine bool) {
-	c.Combined(combine)
-}
-
-func (c *WsClient) getEndpoint() string {
-	return c.Opt.Endpoint
-}
-
-func (c *WsClient) setEndpoint(endpoint string) {
-	c.Opt.Endpoint = endpoint
-}
-
-func (c *WsClient) close() error {
-	return c.Close()
-}
-func (c *WsClient) send(r *core.WsRequest) error {
-	return c.Send(r)
-}
-
-func (c *WsClient) wsApiServe(ctx context.Context) (<-chan []byte, <-chan error) {
-	return c.WsApiServe(ctx)
+func (c *WsClient) call(ctx context.Context, r *core.WsRequest) ([]byte, error) {
+	return c.Call(ctx, r)
 }
 
 func (c *WsClient) NewWebsocketStreams() *WebsocketStreams {
@@ -140,6 +117,11 @@ func (c *WsClient) NewCancelReplaceOrder() *WsCancelReplaceOrder {
 	return &WsCancelReplaceOrder{c: c, r: c.SetReq("order.cancelReplace", core.AuthSigned)}
 }
 
+// NewOrderAmendKeepPriority Order Amend Keep Priority (TRADE)
+func (c *WsClient) NewOrderAmendKeepPriority() *WsOrderAmendKeepPriority {
+	return &WsOrderAmendKeepPriority{c: c, r: c.SetReq("order.amend.keepPriority", core.AuthSigned)}
+}
+
 // NewOpenOrdersStatus Current open orders (USER_DATA)
 func (c *WsClient) NewOpenOrdersStatus() *WsOpenOrdersStatus {
 	return &WsOpenOrdersStatus{c: c, r: c.SetReq("openOrders.status", core.AuthSigned)}
@@ -0,0 +1,105 @@
+package spot
+
+import (
+	"context"
+	"github.com/gorilla/websocket"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/stretchr/testify/suite"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+type wsDialerTestSuite struct {
+	baseWsTestSuite
+}
+
+func TestWsDialer(t *testing.T) {
+	suite.Run(t, new(wsDialerTestSuite))
+}
+
+// handshakeServer Sends msg on every connection and reports the handshake request.
+func (s *wsDialerTestSuite) handshakeServer(msg string, handshakes chan<- *http.Request) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handshakes <- r
+		upgrader := websocket.Upgrader{CheckOrigin: func(r *http.Request) bool { return true }, EnableCompression: true}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
+			return
+		}
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	s.mockClient("ws" + server.URL[4:])
+	return server
+}
+
+func (s *wsDialerTestSuite) TestDialerConfig() {
+	handshakes := make(chan *http.Request, 1)
+	server := s.handshakeServer(`{"e":"aggTrade","s":"BTCUSDT","a":1}`, handshakes)
+	defer server.Close()
+	var proxied string
+	s.client.Opt.Dialer = &core.DialerConfig{
+		Proxy: func(r *http.Request) (*url.URL, error) {
+			proxied = r.URL.Host
+			return nil, nil
+		},
+		HandshakeTimeout:  time.Second,
+		EnableCompression: true,
+		ReadBufferSize:    1 << 16,
+		Header:            http.Header{"X-Client": []string{"go-binance"}},
+	}
+	stream := s.client.NewWebsocketStreams().SubscribeAggTrade("BTCUSDT")
+	messages, _ := stream.Do(context.Background())
+	defer stream.Close()
+	select {
+	case event := <-messages:
+		s.r().Equal(int64(1), event.AggTradeID)
+	case <-time.After(5 * time.Second):
+		s.FailNow("no event received")
+	}
+	handshake := <-handshakes
+	s.r().Equal("go-binance", handshake.Header.Get("X-Client"))
+	s.r().Contains(handshake.Header.Get("Sec-WebSocket-Extensions"), "permessage-deflate")
+	s.r().Equal(strings.TrimPrefix(server.URL, "http://"), proxied)
+}
+
+func (s *wsDialerTestSuite) TestReadLimit() {
+	server := s.handshakeServer(`{"e":"aggTrade","s":"BTCUSDT","a":1}`, make(chan *http.Request, 1))
+	defer server.Close()
+	s.client.Opt.Dialer = &core.DialerConfig{ReadLimit: 16}
+	stream := s.client.NewWebsocketStreams().SubscribeAggTrade("BTCUSDT")
+	_, errs := stream.Do(context.Background())
+	select {
+	case err := <-errs:
+		s.r().ErrorIs(err, websocket.ErrReadLimit)
+	case <-time.After(5 * time.Second):
+		s.FailNow("no error received")
+	}
+	s.r().ErrorIs(stream.Wait(), websocket.ErrReadLimit)
+}
+
+func (s *wsDialerTestSuite) TestHttpClientProxy() {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer proxy.Close()
+	proxyUrl, _ := url.Parse(proxy.URL)
+	opt := &core.Options{Endpoint: "http://api.binance.invalid", Logger: s.client.Opt.Logger, Dialer: &core.DialerConfig{Proxy: http.ProxyURL(proxyUrl)}}
+	client := &Client{&core.Client{Opt: opt, HttpClient: core.NewHttpClient(opt)}}
+	s.r().NoError(client.NewPing().Do(context.Background()))
+	s.r().Equal("http://api.binance.invalid/api/v3/ping", proxied)
+	s.r().Same(http.DefaultClient, core.NewHttpClient(&core.Options{}))
+}
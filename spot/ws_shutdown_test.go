@@ -0,0 +1,130 @@
+package spot
+
+import (
+	"context"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/goleak"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type wsShutdownTestSuite struct {
+	baseWsTestSuite
+	leaks goleak.Option
+}
+
+func TestWsShutdown(t *testing.T) {
+	suite.Run(t, new(wsShutdownTestSuite))
+}
+
+func (s *wsShutdownTestSuite) SetupTest() {
+	s.baseWsTestSuite.SetupTest()
+	s.leaks = goleak.IgnoreCurrent()
+}
+
+func (s *wsShutdownTestSuite) verifyNoLeaks(server *httptest.Server) {
+	server.Close()
+	goleak.VerifyNone(s.T(), s.leaks)
+}
+
+// silentServer Sends msg once on every connection and then only reads, reporting the close code sent by the client.
+func (s *wsShutdownTestSuite) silentServer(msg string, closeCode chan<- int) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{CheckOrigin: func(r *http.Request) bool { return true }}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
+			return
+		}
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				code := -1
+				if closeErr, ok := err.(*websocket.CloseError); ok {
+					code = closeErr.Code
+				}
+				closeCode <- code
+				return
+			}
+		}
+	}))
+	s.mockClient("ws" + server.URL[4:])
+	return server
+}
+
+func (s *wsShutdownTestSuite) TestCancelWhileIdle() {
+	closeCode := make(chan int, 1)
+	server := s.silentServer(`{"e":"aggTrade","s":"BTCUSDT","a":1}`, closeCode)
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := s.client.NewWebsocketStreams().SubscribeAggTrade("BTCUSDT")
+	messages, errs := stream.Do(ctx)
+	select {
+	case event := <-messages:
+		s.r().Equal(int64(1), event.AggTradeID)
+	case <-time.After(5 * time.Second):
+		s.FailNow("no event received")
+	}
+	start := time.Now()
+	cancel()
+	s.r().ErrorIs(stream.Wait(), context.Canceled)
+	s.r().Less(time.Since(start), time.Second, "cancellation does not wait for the next frame")
+	_, ok := <-messages
+	s.r().False(ok, "messages closed")
+	_, ok = <-errs
+	s.r().False(ok, "errors closed")
+	select {
+	case code := <-closeCode:
+		s.r().Equal(websocket.CloseNormalClosure, code)
+	case <-time.After(5 * time.Second):
+		s.FailNow("no close frame received")
+	}
+	s.verifyNoLeaks(server)
+}
+
+func (s *wsShutdownTestSuite) TestCloseWhileIdle() {
+	closeCode := make(chan int, 1)
+	server := s.silentServer(`{"e":"aggTrade","s":"BTCUSDT","a":1}`, closeCode)
+	stream := s.client.NewWebsocketStreams().SubscribeAggTrade("BTCUSDT").
+		OnMessage(func(event *AggTradeEvent) {})
+	s.r().NoError(stream.Start(context.Background()))
+	time.Sleep(100 * time.Millisecond)
+	s.r().NoError(stream.Close())
+	s.r().NoError(stream.Wait())
+	s.r().Equal(websocket.CloseNormalClosure, <-closeCode)
+	s.verifyNoLeaks(server)
+}
+
+func (s *wsShutdownTestSuite) TestServeConsumerGone() {
+	server := s.setup([]byte(`{"e":"aggTrade"}`))
+	ctx, cancel := context.WithCancel(context.Background())
+	onMessage, onError := s.client.WsClient.WsClient.Serve(ctx, s.client.Opt.Endpoint+"/ws/btcusdt@aggTrade")
+	<-onMessage
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range onMessage {
+		}
+		for range onError {
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		s.FailNow("channels not closed")
+	}
+	s.verifyNoLeaks(server)
+}
+
+func (s *wsShutdownTestSuite) TestWsApiServe() {
+	server := s.setup([]byte(`{"id":"1","status":200,"result":{}}`))
+	_, err := s.client.NewPing().Do(context.Background())
+	s.r().NoError(err)
+	s.verifyNoLeaks(server)
+}
@@ -0,0 +1,69 @@
+package subaccount
+
+import (
+	"context"
+	"github.com/jekaxv/go-binance/core"
+	"net/http"
+)
+
+type Client struct {
+	*core.Client
+}
+
+func (c *Client) invoke(r *core.Request, ctx context.Context) error {
+	return c.Invoke(r, ctx)
+}
+
+func (c *Client) rawBody() []byte {
+	return c.RawBody()
+}
+
+// NewSubAccountList Query Sub-account List (For Master Account) (USER_DATA)
+func (c *Client) NewSubAccountList() *SubAccountList {
+	return &SubAccountList{c: c, r: c.SetReq("/sapi/v1/sub-account/list", http.MethodGet, core.AuthSigned)}
+}
+
+// NewSpotSummary Query Sub-account Spot Assets Summary (For Master Account) (USER_DATA)
+func (c *Client) NewSpotSummary() *SpotSummary {
+	return &SpotSummary{c: c, r: c.SetReq("/sapi/v1/sub-account/spotSummary", http.MethodGet, core.AuthSigned)}
+}
+
+// NewSubAccountAssets Query Sub-account Assets (For Master Account) (USER_DATA)
+func (c *Client) NewSubAccountAssets() *SubAccountAssets {
+	return &SubAccountAssets{c: c, r: c.SetReq("/sapi/v4/sub-account/assets", http.MethodGet, core.AuthSigned)}
+}
+
+// NewFuturesSummary Get Summary of Sub-account's Futures Account V2 (For Master Account) (USER_DATA)
+func (c *Client) NewFuturesSummary() *FuturesSummary {
+	return &FuturesSummary{c: c, r: c.SetReq("/sapi/v2/sub-account/futures/accountSummary", http.MethodGet, core.AuthSigned)}
+}
+
+// NewEnableFutures Enable Futures for Sub-account (For Master Account) (USER_DATA)
+func (c *Client) NewEnableFutures() *EnableFutures {
+	return &EnableFutures{c: c, r: c.SetReq("/sapi/v1/sub-account/futures/enable", http.MethodPost, core.AuthSigned)}
+}
+
+// NewUniversalTransfer Universal Transfer (For Master Account) (USER_DATA)
+func (c *Client) NewUniversalTransfer() *UniversalTransfer {
+	return &UniversalTransfer{c: c, r: c.SetReq("/sapi/v1/sub-account/universalTransfer", http.MethodPost, core.AuthSigned)}
+}
+
+// NewUniversalTransferHistory Query Universal Transfer History (For Master Account) (USER_DATA)
+func (c *Client) NewUniversalTransferHistory() *UniversalTransferHistory {
+	return &UniversalTransferHistory{c: c, r: c.SetReq("/sapi/v1/sub-account/universalTransfer", http.MethodGet, core.AuthSigned)}
+}
+
+// NewIpRestriction Get IP Restriction for a Sub-account API Key (For Master Account) (USER_DATA)
+func (c *Client) NewIpRestriction() *IpRestriction {
+	return &IpRestriction{c: c, r: c.SetReq("/sapi/v1/sub-account/subAccountApi/ipRestriction", http.MethodGet, core.AuthSigned)}
+}
+
+// NewAddIpRestriction Add IP Restriction for Sub-Account API key (For Master Account) (USER_DATA)
+func (c *Client) NewAddIpRestriction() *AddIpRestriction {
+	return &AddIpRestriction{c: c, r: c.SetReq("/sapi/v2/sub-account/subAccountApi/ipRestriction", http.MethodPost, core.AuthSigned)}
+}
+
+// NewDeleteIpRestriction Delete IP List For a Sub-account API Key (For Master Account) (USER_DATA)
+func (c *Client) NewDeleteIpRestriction() *DeleteIpRestriction {
+	return &DeleteIpRestriction{c: c, r: c.SetReq("/sapi/v1/sub-account/subAccountApi/ipRestriction/ipList", http.MethodDelete, core.AuthSigned)}
+}
@@ -0,0 +1,529 @@
+package subaccount
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/shopspring/decimal"
+	"strings"
+)
+
+type SubAccountListResponse struct {
+	SubAccounts []*SubAccount `json:"subAccounts"`
+}
+
+type SubAccount struct {
+	Email                       string `json:"email"`
+	IsFreeze                    bool   `json:"isFreeze"`
+	CreateTime                  int64  `json:"createTime"`
+	IsManagedSubAccount         bool   `json:"isManagedSubAccount"`
+	IsAssetManagementSubAccount bool   `json:"isAssetManagementSubAccount"`
+}
+
+type SpotSummaryResponse struct {
+	TotalCount                int                 `json:"totalCount"`
+	MasterAccountTotalAsset   decimal.Decimal     `json:"masterAccountTotalAsset"`
+	SpotSubUserAssetBtcVoList []*SpotSubUserAsset `json:"spotSubUserAssetBtcVoList"`
+}
+
+type SpotSubUserAsset struct {
+	Email      string          `json:"email"`
+	TotalAsset decimal.Decimal `json:"totalAsset"`
+}
+
+type AssetsResponse struct {
+	Balances []*Balance `json:"balances"`
+}
+
+type Balance struct {
+	Asset       string          `json:"asset"`
+	Free        decimal.Decimal `json:"free"`
+	Locked      decimal.Decimal `json:"locked"`
+	Freeze      decimal.Decimal `json:"freeze"`
+	Withdrawing decimal.Decimal `json:"withdrawing"`
+}
+
+// FuturesSummaryResponse Only FutureAccountSummary is set for futuresType 1 (USDⓈ-M) and only DeliveryAccountSummary for futuresType 2 (COIN-M).
+type FuturesSummaryResponse struct {
+	FutureAccountSummary   *FutureAccountSummary   `json:"futureAccountSummaryResp"`
+	DeliveryAccountSummary *DeliveryAccountSummary `json:"deliveryAccountSummaryResp"`
+}
+
+type FutureAccountSummary struct {
+	TotalInitialMargin          decimal.Decimal         `json:"totalInitialMargin"`
+	TotalMaintenanceMargin      decimal.Decimal         `json:"totalMaintenanceMargin"`
+	TotalMarginBalance          decimal.Decimal         `json:"totalMarginBalance"`
+	TotalOpenOrderInitialMargin decimal.Decimal         `json:"totalOpenOrderInitialMargin"`
+	TotalPositionInitialMargin  decimal.Decimal         `json:"totalPositionInitialMargin"`
+	TotalUnrealizedProfit       decimal.Decimal         `json:"totalUnrealizedProfit"`
+	TotalWalletBalance          decimal.Decimal         `json:"totalWalletBalance"`
+	Asset                       string                  `json:"asset"`
+	SubAccountList              []*FutureSubAccountInfo `json:"subAccountList"`
+}
+
+type FutureSubAccountInfo struct {
+	Email                       string          `json:"email"`
+	TotalInitialMargin          decimal.Decimal `json:"totalInitialMargin"`
+	TotalMaintenanceMargin      decimal.Decimal `json:"totalMaintenanceMargin"`
+	TotalMarginBalance          decimal.Decimal `json:"totalMarginBalance"`
+	TotalOpenOrderInitialMargin decimal.Decimal `json:"totalOpenOrderInitialMargin"`
+	TotalPositionInitialMargin  decimal.Decimal `json:"totalPositionInitialMargin"`
+	TotalUnrealizedProfit       decimal.Decimal `json:"totalUnrealizedProfit"`
+	TotalWalletBalance          decimal.Decimal `json:"totalWalletBalance"`
+	Asset                       string          `json:"asset"`
+}
+
+type DeliveryAccountSummary struct {
+	TotalMarginBalanceOfBTC    decimal.Decimal           `json:"totalMarginBalanceOfBTC"`
+	TotalUnrealizedProfitOfBTC decimal.Decimal           `json:"totalUnrealizedProfitOfBTC"`
+	TotalWalletBalanceOfBTC    decimal.Decimal           `json:"totalWalletBalanceOfBTC"`
+	Asset                      string                    `json:"asset"`
+	SubAccountList             []*DeliverySubAccountInfo `json:"subAccountList"`
+}
+
+type DeliverySubAccountInfo struct {
+	Email                 string          `json:"email"`
+	TotalMarginBalance    decimal.Decimal `json:"totalMarginBalance"`
+	TotalUnrealizedProfit decimal.Decimal `json:"totalUnrealizedProfit"`
+	TotalWalletBalance    decimal.Decimal `json:"totalWalletBalance"`
+	Asset                 string          `json:"asset"`
+}
+
+type EnableFuturesResponse struct {
+	Email            string `json:"email"`
+	IsFuturesEnabled bool   `json:"isFuturesEnabled"`
+}
+
+type TransferResponse struct {
+	TranId       int64  `json:"tranId"`
+	ClientTranId string `json:"clientTranId"`
+}
+
+type TransferHistoryResponse struct {
+	Result     []*TransferRecord `json:"result"`
+	TotalCount int               `json:"totalCount"`
+}
+
+type TransferRecord struct {
+	TranId          int64           `json:"tranId"`
+	FromEmail       string          `json:"fromEmail"`
+	ToEmail         string          `json:"toEmail"`
+	Asset           string          `json:"asset"`
+	Amount          decimal.Decimal `json:"amount"`
+	CreateTimeStamp int64           `json:"createTimeStamp"`
+	FromAccountType string          `json:"fromAccountType"`
+	ToAccountType   string          `json:"toAccountType"`
+	Status          string          `json:"status"`
+	ClientTranId    string          `json:"clientTranId"`
+}
+
+type IpRestrictionResponse struct {
+	IpRestrict bool     `json:"ipRestrict"`
+	Status     string   `json:"status"` // 1: IP restricted, 2: IP unrestricted
+	IpList     []string `json:"ipList"`
+	UpdateTime int64    `json:"updateTime"`
+	ApiKey     string   `json:"apiKey"`
+}
+
+// SubAccountList Query the sub-account list of the master account.
+type SubAccountList struct {
+	c *Client
+	r *core.Request
+}
+
+// Email Sub-account email
+func (s *SubAccountList) Email(email string) *SubAccountList {
+	s.r.Set("email", email)
+	return s
+}
+
+func (s *SubAccountList) IsFreeze(isFreeze bool) *SubAccountList {
+	s.r.Set("isFreeze", isFreeze)
+	return s
+}
+
+// Page Default value: 1
+func (s *SubAccountList) Page(page int) *SubAccountList {
+	s.r.Set("page", page)
+	return s
+}
+
+// Limit Default value: 1, Max value: 200
+func (s *SubAccountList) Limit(limit int) *SubAccountList {
+	s.r.Set("limit", limit)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *SubAccountList) RecvWindow(recvWindow int64) *SubAccountList {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *SubAccountList) Do(ctx context.Context) (*SubAccountListResponse, error) {
+	resp := new(SubAccountListResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// SpotSummary Get BTC valued asset summary of the spot sub-accounts.
+type SpotSummary struct {
+	c *Client
+	r *core.Request
+}
+
+// Email Sub-account email
+func (s *SpotSummary) Email(email string) *SpotSummary {
+	s.r.Set("email", email)
+	return s
+}
+
+// Page Default value: 1
+func (s *SpotSummary) Page(page int64) *SpotSummary {
+	s.r.Set("page", page)
+	return s
+}
+
+// Size Default value:10, Max value:20
+func (s *SpotSummary) Size(size int64) *SpotSummary {
+	s.r.Set("size", size)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *SpotSummary) RecvWindow(recvWindow int64) *SpotSummary {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *SpotSummary) Do(ctx context.Context) (*SpotSummaryResponse, error) {
+	resp := new(SpotSummaryResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// SubAccountAssets Fetch the spot assets of a sub-account.
+type SubAccountAssets struct {
+	c *Client
+	r *core.Request
+}
+
+// Email Sub-account email
+func (s *SubAccountAssets) Email(email string) *SubAccountAssets {
+	s.r.Set("email", email)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *SubAccountAssets) RecvWindow(recvWindow int64) *SubAccountAssets {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *SubAccountAssets) Do(ctx context.Context) (*AssetsResponse, error) {
+	resp := new(AssetsResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// FuturesSummary Get the futures account summary of the sub-accounts.
+type FuturesSummary struct {
+	c *Client
+	r *core.Request
+}
+
+// FuturesType 1: USDⓈ-M Futures, 2: COIN-M Futures
+func (s *FuturesSummary) FuturesType(futuresType int) *FuturesSummary {
+	s.r.Set("futuresType", futuresType)
+	return s
+}
+
+// Page Default value: 1
+func (s *FuturesSummary) Page(page int) *FuturesSummary {
+	s.r.Set("page", page)
+	return s
+}
+
+// Limit Default value: 10, Max value: 20
+func (s *FuturesSummary) Limit(limit int) *FuturesSummary {
+	s.r.Set("limit", limit)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *FuturesSummary) RecvWindow(recvWindow int64) *FuturesSummary {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *FuturesSummary) Do(ctx context.Context) (*FuturesSummaryResponse, error) {
+	resp := new(FuturesSummaryResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// EnableFutures Enable futures for a sub-account.
+type EnableFutures struct {
+	c *Client
+	r *core.Request
+}
+
+// Email Sub-account email
+func (s *EnableFutures) Email(email string) *EnableFutures {
+	s.r.Set("email", email)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *EnableFutures) RecvWindow(recvWindow int64) *EnableFutures {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *EnableFutures) Do(ctx context.Context) (*EnableFuturesResponse, error) {
+	resp := new(EnableFuturesResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// UniversalTransfer Transfer assets master to sub, sub to master or sub to sub with the master key. Transfer to master when toEmail is not sent, from master when fromEmail is not sent.
+type UniversalTransfer struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *UniversalTransfer) FromEmail(fromEmail string) *UniversalTransfer {
+	s.r.Set("fromEmail", fromEmail)
+	return s
+}
+
+func (s *UniversalTransfer) ToEmail(toEmail string) *UniversalTransfer {
+	s.r.Set("toEmail", toEmail)
+	return s
+}
+
+// FromAccountType SPOT, USDT_FUTURE, COIN_FUTURE, MARGIN(Cross), ISOLATED_MARGIN
+func (s *UniversalTransfer) FromAccountType(fromAccountType core.AccountTypeEnum) *UniversalTransfer {
+	s.r.Set("fromAccountType", fromAccountType)
+	return s
+}
+
+// ToAccountType SPOT, USDT_FUTURE, COIN_FUTURE, MARGIN(Cross), ISOLATED_MARGIN
+func (s *UniversalTransfer) ToAccountType(toAccountType core.AccountTypeEnum) *UniversalTransfer {
+	s.r.Set("toAccountType", toAccountType)
+	return s
+}
+
+// ClientTranId Must be unique
+func (s *UniversalTransfer) ClientTranId(clientTranId string) *UniversalTransfer {
+	s.r.Set("clientTranId", clientTranId)
+	return s
+}
+
+// Symbol Only supported under ISOLATED_MARGIN type
+func (s *UniversalTransfer) Symbol(symbol string) *UniversalTransfer {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+func (s *UniversalTransfer) Asset(asset string) *UniversalTransfer {
+	s.r.Set("asset", asset)
+	return s
+}
+
+func (s *UniversalTransfer) Amount(amount string) *UniversalTransfer {
+	s.r.Set("amount", amount)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *UniversalTransfer) RecvWindow(recvWindow int64) *UniversalTransfer {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *UniversalTransfer) Do(ctx context.Context) (*TransferResponse, error) {
+	resp := new(TransferResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// UniversalTransferHistory Query the universal transfer history of the master account. Default to the last 30 days if startTime and endTime are not sent.
+type UniversalTransferHistory struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *UniversalTransferHistory) FromEmail(fromEmail string) *UniversalTransferHistory {
+	s.r.Set("fromEmail", fromEmail)
+	return s
+}
+
+func (s *UniversalTransferHistory) ToEmail(toEmail string) *UniversalTransferHistory {
+	s.r.Set("toEmail", toEmail)
+	return s
+}
+
+func (s *UniversalTransferHistory) ClientTranId(clientTranId string) *UniversalTransferHistory {
+	s.r.Set("clientTranId", clientTranId)
+	return s
+}
+
+func (s *UniversalTransferHistory) StartTime(startTime int64) *UniversalTransferHistory {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *UniversalTransferHistory) EndTime(endTime int64) *UniversalTransferHistory {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Page Default 1
+func (s *UniversalTransferHistory) Page(page int) *UniversalTransferHistory {
+	s.r.Set("page", page)
+	return s
+}
+
+// Limit Default 500, Max 500
+func (s *UniversalTransferHistory) Limit(limit int) *UniversalTransferHistory {
+	s.r.Set("limit", limit)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *UniversalTransferHistory) RecvWindow(recvWindow int64) *UniversalTransferHistory {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *UniversalTransferHistory) Do(ctx context.Context) (*TransferHistoryResponse, error) {
+	resp := new(TransferHistoryResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// IpRestriction Query the IP restriction of a sub-account API key.
+type IpRestriction struct {
+	c *Client
+	r *core.Request
+}
+
+// Email Sub-account email
+func (s *IpRestriction) Email(email string) *IpRestriction {
+	s.r.Set("email", email)
+	return s
+}
+
+func (s *IpRestriction) SubAccountApiKey(subAccountApiKey string) *IpRestriction {
+	s.r.Set("subAccountApiKey", subAccountApiKey)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *IpRestriction) RecvWindow(recvWindow int64) *IpRestriction {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *IpRestriction) Do(ctx context.Context) (*IpRestrictionResponse, error) {
+	resp := new(IpRestrictionResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// AddIpRestriction Add IP restriction for a sub-account API key.
+type AddIpRestriction struct {
+	c *Client
+	r *core.Request
+}
+
+// IpAddress Can be added in batches
+func (s *AddIpRestriction) IpAddress(ipAddress []string) *AddIpRestriction {
+	s.r.Set("ipAddress", strings.Join(ipAddress, ","))
+	return s
+}
+
+// Email Sub-account email
+func (s *AddIpRestriction) Email(email string) *AddIpRestriction {
+	s.r.Set("email", email)
+	return s
+}
+
+func (s *AddIpRestriction) SubAccountApiKey(subAccountApiKey string) *AddIpRestriction {
+	s.r.Set("subAccountApiKey", subAccountApiKey)
+	return s
+}
+
+// Status IP restriction status. 1 = IP Unrestricted. 2 = Restrict access to trusted IPs only.
+func (s *AddIpRestriction) Status(status string) *AddIpRestriction {
+	s.r.Set("status", status)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *AddIpRestriction) RecvWindow(recvWindow int64) *AddIpRestriction {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *AddIpRestriction) Do(ctx context.Context) (*IpRestrictionResponse, error) {
+	resp := new(IpRestrictionResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// DeleteIpRestriction Delete IP list for a sub-account API key.
+type DeleteIpRestriction struct {
+	c *Client
+	r *core.Request
+}
+
+// IpAddress Can be deleted in batches
+func (s *DeleteIpRestriction) IpAddress(ipAddress []string) *DeleteIpRestriction {
+	s.r.Set("ipAddress", strings.Join(ipAddress, ","))
+	return s
+}
+
+// Email Sub-account email
+func (s *DeleteIpRestriction) Email(email string) *DeleteIpRestriction {
+	s.r.Set("email", email)
+	return s
+}
+
+func (s *DeleteIpRestriction) SubAccountApiKey(subAccountApiKey string) *DeleteIpRestriction {
+	s.r.Set("subAccountApiKey", subAccountApiKey)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *DeleteIpRestriction) RecvWindow(recvWindow int64) *DeleteIpRestriction {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *DeleteIpRestriction) Do(ctx context.Context) (*IpRestrictionResponse, error) {
+	resp := new(IpRestrictionResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
@@ -0,0 +1,179 @@
+package subaccount
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type subAccountTestSuite struct {
+	baseHttpTestSuite
+}
+
+func TestSubAccount(t *testing.T) {
+	suite.Run(t, new(subAccountTestSuite))
+}
+
+func (s *subAccountTestSuite) TestSubAccountList() {
+	msg := []byte(`{
+	  "subAccounts": [
+		{
+		  "email": "testsub@gmail.com",
+		  "isFreeze": false,
+		  "createTime": 1544433328000,
+		  "isManagedSubAccount": false,
+		  "isAssetManagementSubAccount": false
+		}
+	  ]
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewSubAccountList().Limit(10).Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *SubAccountListResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(*testResp.SubAccounts[0], *resp.SubAccounts[0])
+}
+
+func (s *subAccountTestSuite) TestSpotSummary() {
+	msg := []byte(`{
+	  "totalCount": 2,
+	  "masterAccountTotalAsset": "0.23231201",
+	  "spotSubUserAssetBtcVoList": [
+		{"email": "sub123@test.com", "totalAsset": "9999.00000000"},
+		{"email": "test456@test.com", "totalAsset": "0.00000000"}
+	  ]
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewSpotSummary().Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Equal(2, resp.TotalCount)
+	r.Equal("0.23231201", resp.MasterAccountTotalAsset.String())
+	r.Equal("9999", resp.SpotSubUserAssetBtcVoList[0].TotalAsset.String())
+}
+
+func (s *subAccountTestSuite) TestSubAccountAssets() {
+	msg := []byte(`{
+	  "balances": [
+		{"freeze": 0, "withdrawing": 0, "asset": "ADA", "free": 10000, "locked": 0},
+		{"freeze": 0, "withdrawing": 0, "asset": "BNB", "free": 10003, "locked": 0}
+	  ]
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewSubAccountAssets().Email("testsub@gmail.com").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Len(resp.Balances, 2)
+	r.Equal("ADA", resp.Balances[0].Asset)
+	r.Equal("10000", resp.Balances[0].Free.String())
+}
+
+func (s *subAccountTestSuite) TestFuturesSummary() {
+	msg := []byte(`{
+	  "futureAccountSummaryResp": {
+		"totalInitialMargin": "9.83137400",
+		"totalMaintenanceMargin": "0.41568700",
+		"totalMarginBalance": "23.03235621",
+		"totalOpenOrderInitialMargin": "9.00000000",
+		"totalPositionInitialMargin": "0.83137400",
+		"totalUnrealizedProfit": "0.03219710",
+		"totalWalletBalance": "22.15879444",
+		"asset": "USD",
+		"subAccountList": [
+		  {
+			"email": "123@test.com",
+			"totalInitialMargin": "9.00000000",
+			"totalMaintenanceMargin": "0.00000000",
+			"totalMarginBalance": "22.12659734",
+			"totalOpenOrderInitialMargin": "9.00000000",
+			"totalPositionInitialMargin": "0.00000000",
+			"totalUnrealizedProfit": "0.00000000",
+			"totalWalletBalance": "22.12659734",
+			"asset": "USD"
+		  }
+		]
+	  }
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewFuturesSummary().FuturesType(1).Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Nil(resp.DeliveryAccountSummary)
+	r.Equal("23.03235621", resp.FutureAccountSummary.TotalMarginBalance.String())
+	r.Equal("123@test.com", resp.FutureAccountSummary.SubAccountList[0].Email)
+}
+
+func (s *subAccountTestSuite) TestEnableFutures() {
+	msg := []byte(`{"email": "123@test.com", "isFuturesEnabled": true}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewEnableFutures().Email("123@test.com").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.True(resp.IsFuturesEnabled)
+}
+
+func (s *subAccountTestSuite) TestUniversalTransfer() {
+	msg := []byte(`{"tranId": 11945860693, "clientTranId": "test"}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewUniversalTransfer().ToEmail("123@test.com").FromAccountType(core.AccountTypeSPOT).
+		ToAccountType(core.AccountTypeUSDT_FUTURE).Asset("USDT").Amount("100").ClientTranId("test").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Equal(int64(11945860693), resp.TranId)
+	r.Equal("test", resp.ClientTranId)
+}
+
+func (s *subAccountTestSuite) TestUniversalTransferHistory() {
+	msg := []byte(`{
+	  "result": [
+		{
+		  "tranId": 92275823339,
+		  "fromEmail": "abctest@gmail.com",
+		  "toEmail": "deftest@gmail.com",
+		  "asset": "BNB",
+		  "amount": "0.01",
+		  "createTimeStamp": 1640317374000,
+		  "fromAccountType": "USDT_FUTURE",
+		  "toAccountType": "SPOT",
+		  "status": "SUCCESS",
+		  "clientTranId": "test"
+		}
+	  ],
+	  "totalCount": 1
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewUniversalTransferHistory().FromEmail("abctest@gmail.com").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *TransferHistoryResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(testResp.TotalCount, resp.TotalCount)
+	r.Equal(*testResp.Result[0], *resp.Result[0])
+}
+
+func (s *subAccountTestSuite) TestAddIpRestriction() {
+	msg := []byte(`{
+	  "status": "2",
+	  "ipList": ["69.210.67.14", "8.34.21.10"],
+	  "updateTime": 1636371437000,
+	  "apiKey": "k5V49ldtn4tszj6W3hystegdfvmGbqDzjmkCtpTvC0G74WhK7yd4rfCTo4lShf"
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewAddIpRestriction().Email("123@test.com").SubAccountApiKey("k5V49ldtn4tszj6W3hystegdfvmGbqDzjmkCtpTvC0G74WhK7yd4rfCTo4lShf").
+		Status("2").IpAddress([]string{"69.210.67.14", "8.34.21.10"}).Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Equal("2", resp.Status)
+	r.Equal([]string{"69.210.67.14", "8.34.21.10"}, resp.IpList)
+}
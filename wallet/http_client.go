@@ -0,0 +1,79 @@
+package wallet
+
+import (
+	"context"
+	"github.com/jekaxv/go-binance/core"
+	"net/http"
+)
+
+type Client struct {
+	*core.Client
+}
+
+func (c *Client) invoke(r *core.Request, ctx context.Context) error {
+	return c.Invoke(r, ctx)
+}
+
+func (c *Client) rawBody() []byte {
+	return c.RawBody()
+}
+
+// NewSystemStatus System Status (System)
+func (c *Client) NewSystemStatus() *SystemStatus {
+	return &SystemStatus{c: c, r: c.SetReq("/sapi/v1/system/status", http.MethodGet)}
+}
+
+// NewAllCoins All Coins' Information (USER_DATA)
+func (c *Client) NewAllCoins() *AllCoins {
+	return &AllCoins{c: c, r: c.SetReq("/sapi/v1/capital/config/getall", http.MethodGet, core.AuthSigned)}
+}
+
+// NewDepositAddress Deposit Address (supporting network) (USER_DATA)
+func (c *Client) NewDepositAddress() *DepositAddress {
+	return &DepositAddress{c: c, r: c.SetReq("/sapi/v1/capital/deposit/address", http.MethodGet, core.AuthSigned)}
+}
+
+// NewDepositHistory Deposit History (supporting network) (USER_DATA)
+func (c *Client) NewDepositHistory() *DepositHistory {
+	return &DepositHistory{c: c, r: c.SetReq("/sapi/v1/capital/deposit/hisrec", http.MethodGet, core.AuthSigned)}
+}
+
+// NewWithdraw Withdraw (USER_DATA)
+func (c *Client) NewWithdraw() *Withdraw {
+	return &Withdraw{c: c, r: c.SetReq("/sapi/v1/capital/withdraw/apply", http.MethodPost, core.AuthSigned)}
+}
+
+// NewWithdrawHistory Withdraw History (supporting network) (USER_DATA)
+func (c *Client) NewWithdrawHistory() *WithdrawHistory {
+	return &WithdrawHistory{c: c, r: c.SetReq("/sapi/v1/capital/withdraw/history", http.MethodGet, core.AuthSigned)}
+}
+
+// NewUniversalTransfer User Universal Transfer (USER_DATA)
+func (c *Client) NewUniversalTransfer() *UniversalTransfer {
+	return &UniversalTransfer{c: c, r: c.SetReq("/sapi/v1/asset/transfer", http.MethodPost, core.AuthSigned)}
+}
+
+// NewUniversalTransferHistory Query User Universal Transfer History (USER_DATA)
+func (c *Client) NewUniversalTransferHistory() *UniversalTransferHistory {
+	return &UniversalTransferHistory{c: c, r: c.SetReq("/sapi/v1/asset/transfer", http.MethodGet, core.AuthSigned)}
+}
+
+// NewDustTransfer Dust Transfer (USER_DATA)
+func (c *Client) NewDustTransfer() *DustTransfer {
+	return &DustTransfer{c: c, r: c.SetReq("/sapi/v1/asset/dust", http.MethodPost, core.AuthSigned)}
+}
+
+// NewAssetDividend Asset Dividend Record (USER_DATA)
+func (c *Client) NewAssetDividend() *AssetDividend {
+	return &AssetDividend{c: c, r: c.SetReq("/sapi/v1/asset/assetDividend", http.MethodGet, core.AuthSigned)}
+}
+
+// NewTradeFee Trade Fee (USER_DATA)
+func (c *Client) NewTradeFee() *TradeFee {
+	return &TradeFee{c: c, r: c.SetReq("/sapi/v1/asset/tradeFee", http.MethodGet, core.AuthSigned)}
+}
+
+// NewApiRestrictions Get API Key Permission (USER_DATA)
+func (c *Client) NewApiRestrictions() *ApiRestrictions {
+	return &ApiRestrictions{c: c, r: c.SetReq("/sapi/v1/account/apiRestrictions", http.MethodGet, core.AuthSigned)}
+}
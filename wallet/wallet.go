@@ -0,0 +1,675 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/shopspring/decimal"
+	"strings"
+)
+
+type SystemStatusResponse struct {
+	Status int    `json:"status"` // 0: normal, 1: system maintenance
+	Msg    string `json:"msg"`
+}
+
+type CoinInfo struct {
+	Coin              string          `json:"coin"`
+	Name              string          `json:"name"`
+	DepositAllEnable  bool            `json:"depositAllEnable"`
+	WithdrawAllEnable bool            `json:"withdrawAllEnable"`
+	Free              decimal.Decimal `json:"free"`
+	Freeze            decimal.Decimal `json:"freeze"`
+	Ipoable           decimal.Decimal `json:"ipoable"`
+	Ipoing            decimal.Decimal `json:"ipoing"`
+	IsLegalMoney      bool            `json:"isLegalMoney"`
+	Locked            decimal.Decimal `json:"locked"`
+	Storage           decimal.Decimal `json:"storage"`
+	Trading           bool            `json:"trading"`
+	Withdrawing       decimal.Decimal `json:"withdrawing"`
+	NetworkList       []*Network      `json:"networkList"`
+}
+
+type Network struct {
+	Network                 string          `json:"network"`
+	Coin                    string          `json:"coin"`
+	Name                    string          `json:"name"`
+	IsDefault               bool            `json:"isDefault"`
+	AddressRegex            string          `json:"addressRegex"`
+	MemoRegex               string          `json:"memoRegex"`
+	DepositEnable           bool            `json:"depositEnable"`
+	DepositDesc             string          `json:"depositDesc"`
+	WithdrawEnable          bool            `json:"withdrawEnable"`
+	WithdrawDesc            string          `json:"withdrawDesc"`
+	WithdrawFee             decimal.Decimal `json:"withdrawFee"`
+	WithdrawIntegerMultiple decimal.Decimal `json:"withdrawIntegerMultiple"`
+	WithdrawMax             decimal.Decimal `json:"withdrawMax"`
+	WithdrawMin             decimal.Decimal `json:"withdrawMin"`
+	MinConfirm              int             `json:"minConfirm"`
+	UnLockConfirm           int             `json:"unLockConfirm"`
+	SameAddress             bool            `json:"sameAddress"`
+	EstimatedArrivalTime    int64           `json:"estimatedArrivalTime"`
+	Busy                    bool            `json:"busy"`
+	SpecialTips             string          `json:"specialTips"`
+	ContractAddressUrl      string          `json:"contractAddressUrl"`
+	ContractAddress         string          `json:"contractAddress"`
+}
+
+type DepositAddressResponse struct {
+	Address string `json:"address"`
+	Coin    string `json:"coin"`
+	Tag     string `json:"tag"`
+	Url     string `json:"url"`
+}
+
+type DepositRecord struct {
+	Id            string          `json:"id"`
+	Amount        decimal.Decimal `json:"amount"`
+	Coin          string          `json:"coin"`
+	Network       string          `json:"network"`
+	Status        int             `json:"status"` // 0: pending, 6: credited but cannot withdraw, 7: wrong deposit, 8: waiting user confirm, 1: success, 2: rejected
+	Address       string          `json:"address"`
+	AddressTag    string          `json:"addressTag"`
+	TxId          string          `json:"txId"`
+	InsertTime    int64           `json:"insertTime"`
+	CompleteTime  int64           `json:"completeTime"`
+	TransferType  int             `json:"transferType"` // 1: internal transfer, 0: external transfer
+	ConfirmTimes  string          `json:"confirmTimes"`
+	UnlockConfirm int             `json:"unlockConfirm"`
+	WalletType    int             `json:"walletType"` // 0: spot wallet, 1: funding wallet
+}
+
+type WithdrawResponse struct {
+	Id string `json:"id"`
+}
+
+type WithdrawRecord struct {
+	Id              string          `json:"id"`
+	Amount          decimal.Decimal `json:"amount"`
+	TransactionFee  decimal.Decimal `json:"transactionFee"`
+	Coin            string          `json:"coin"`
+	Status          int             `json:"status"` // 0: email sent, 2: awaiting approval, 3: rejected, 4: processing, 6: completed
+	Address         string          `json:"address"`
+	TxId            string          `json:"txId"`
+	ApplyTime       string          `json:"applyTime"`
+	Network         string          `json:"network"`
+	TransferType    int             `json:"transferType"` // 1: internal transfer, 0: external transfer
+	WithdrawOrderId string          `json:"withdrawOrderId"`
+	Info            string          `json:"info"`
+	ConfirmNo       int             `json:"confirmNo"`
+	WalletType      int             `json:"walletType"` // 0: spot wallet, 1: funding wallet
+	TxKey           string          `json:"txKey"`
+	CompleteTime    string          `json:"completeTime"`
+}
+
+type TranIdResponse struct {
+	TranId int64 `json:"tranId"`
+}
+
+type UniversalTransferHistoryResponse struct {
+	Total int               `json:"total"`
+	Rows  []*TransferRecord `json:"rows"`
+}
+
+type TransferRecord struct {
+	Asset     string          `json:"asset"`
+	Amount    decimal.Decimal `json:"amount"`
+	Type      string          `json:"type"`
+	Status    string          `json:"status"`
+	TranId    int64           `json:"tranId"`
+	Timestamp int64           `json:"timestamp"`
+}
+
+type DustTransferResponse struct {
+	TotalServiceCharge decimal.Decimal       `json:"totalServiceCharge"`
+	TotalTransfered    decimal.Decimal       `json:"totalTransfered"`
+	TransferResult     []*DustTransferResult `json:"transferResult"`
+}
+
+type DustTransferResult struct {
+	Amount              decimal.Decimal `json:"amount"`
+	FromAsset           string          `json:"fromAsset"`
+	OperateTime         int64           `json:"operateTime"`
+	ServiceChargeAmount decimal.Decimal `json:"serviceChargeAmount"`
+	TranId              int64           `json:"tranId"`
+	TransferedAmount    decimal.Decimal `json:"transferedAmount"`
+}
+
+type AssetDividendResponse struct {
+	Rows  []*Dividend `json:"rows"`
+	Total int         `json:"total"`
+}
+
+type Dividend struct {
+	Id      int64           `json:"id"`
+	Amount  decimal.Decimal `json:"amount"`
+	Asset   string          `json:"asset"`
+	DivTime int64           `json:"divTime"`
+	EnInfo  string          `json:"enInfo"`
+	TranId  int64           `json:"tranId"`
+}
+
+type TradeFeeResponse struct {
+	Symbol          string          `json:"symbol"`
+	MakerCommission decimal.Decimal `json:"makerCommission"`
+	TakerCommission decimal.Decimal `json:"takerCommission"`
+}
+
+type ApiRestrictionsResponse struct {
+	IpRestrict                     bool  `json:"ipRestrict"`
+	CreateTime                     int64 `json:"createTime"`
+	EnableReading                  bool  `json:"enableReading"`
+	EnableSpotAndMarginTrading     bool  `json:"enableSpotAndMarginTrading"`
+	EnableWithdrawals              bool  `json:"enableWithdrawals"`
+	EnableInternalTransfer         bool  `json:"enableInternalTransfer"`
+	EnableMargin                   bool  `json:"enableMargin"`
+	EnableFutures                  bool  `json:"enableFutures"`
+	PermitsUniversalTransfer       bool  `json:"permitsUniversalTransfer"`
+	EnableVanillaOptions           bool  `json:"enableVanillaOptions"`
+	EnableFixApiTrade              bool  `json:"enableFixApiTrade"`
+	EnableFixReadOnly              bool  `json:"enableFixReadOnly"`
+	EnablePortfolioMarginTrading   bool  `json:"enablePortfolioMarginTrading"`
+	TradingAuthorityExpirationTime int64 `json:"tradingAuthorityExpirationTime"`
+}
+
+// CanTrade Reports whether the key may place spot and margin orders at now (ms), honouring tradingAuthorityExpirationTime.
+func (r *ApiRestrictionsResponse) CanTrade(now int64) bool {
+	if !r.EnableSpotAndMarginTrading {
+		return false
+	}
+	return r.TradingAuthorityExpirationTime == 0 || now < r.TradingAuthorityExpirationTime
+}
+
+// CanTradeFutures Reports whether the key may place USDⓈ-M and COIN-M futures orders.
+func (r *ApiRestrictionsResponse) CanTradeFutures() bool {
+	return r.EnableFutures
+}
+
+// SystemStatus Fetch system status.
+type SystemStatus struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *SystemStatus) Do(ctx context.Context) (*SystemStatusResponse, error) {
+	resp := new(SystemStatusResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// AllCoins Get information of coins (available for deposit and withdraw) for user, including the networks of each coin.
+type AllCoins struct {
+	c *Client
+	r *core.Request
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *AllCoins) RecvWindow(recvWindow int64) *AllCoins {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *AllCoins) Do(ctx context.Context) ([]*CoinInfo, error) {
+	resp := make([]*CoinInfo, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// DepositAddress Fetch deposit address with network. If network is not sent, return with default network of the coin.
+type DepositAddress struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *DepositAddress) Coin(coin string) *DepositAddress {
+	s.r.Set("coin", coin)
+	return s
+}
+
+func (s *DepositAddress) Network(network string) *DepositAddress {
+	s.r.Set("network", network)
+	return s
+}
+
+// Amount Only for Lightning Network
+func (s *DepositAddress) Amount(amount string) *DepositAddress {
+	s.r.Set("amount", amount)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *DepositAddress) RecvWindow(recvWindow int64) *DepositAddress {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *DepositAddress) Do(ctx context.Context) (*DepositAddressResponse, error) {
+	resp := new(DepositAddressResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// DepositHistory Fetch deposit history. Default to the last 90 days if startTime and endTime are not sent.
+type DepositHistory struct {
+	c *Client
+	r *core.Request
+}
+
+// IncludeSource Default false, when true the response will include the source address
+func (s *DepositHistory) IncludeSource(includeSource bool) *DepositHistory {
+	s.r.Set("includeSource", includeSource)
+	return s
+}
+
+func (s *DepositHistory) Coin(coin string) *DepositHistory {
+	s.r.Set("coin", coin)
+	return s
+}
+
+// Status 0: pending, 6: credited but cannot withdraw, 7: wrong deposit, 8: waiting user confirm, 1: success, 2: rejected
+func (s *DepositHistory) Status(status int) *DepositHistory {
+	s.r.Set("status", status)
+	return s
+}
+
+func (s *DepositHistory) StartTime(startTime int64) *DepositHistory {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *DepositHistory) EndTime(endTime int64) *DepositHistory {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Offset Default 0
+func (s *DepositHistory) Offset(offset int) *DepositHistory {
+	s.r.Set("offset", offset)
+	return s
+}
+
+// Limit Default 1000, Max 1000
+func (s *DepositHistory) Limit(limit int) *DepositHistory {
+	s.r.Set("limit", limit)
+	return s
+}
+
+func (s *DepositHistory) TxId(txId string) *DepositHistory {
+	s.r.Set("txId", txId)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *DepositHistory) RecvWindow(recvWindow int64) *DepositHistory {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *DepositHistory) Do(ctx context.Context) ([]*DepositRecord, error) {
+	resp := make([]*DepositRecord, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// Withdraw Submit a withdraw request. A withdrawOrderId makes the request idempotent, a retried request with the same id will not withdraw twice.
+type Withdraw struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *Withdraw) Coin(coin string) *Withdraw {
+	s.r.Set("coin", coin)
+	return s
+}
+
+// WithdrawOrderId Client id for withdraw
+func (s *Withdraw) WithdrawOrderId(withdrawOrderId string) *Withdraw {
+	s.r.Set("withdrawOrderId", withdrawOrderId)
+	return s
+}
+
+func (s *Withdraw) Network(network string) *Withdraw {
+	s.r.Set("network", network)
+	return s
+}
+
+func (s *Withdraw) Address(address string) *Withdraw {
+	s.r.Set("address", address)
+	return s
+}
+
+// AddressTag Secondary address identifier for coins like XRP, XMR etc.
+func (s *Withdraw) AddressTag(addressTag string) *Withdraw {
+	s.r.Set("addressTag", addressTag)
+	return s
+}
+
+func (s *Withdraw) Amount(amount string) *Withdraw {
+	s.r.Set("amount", amount)
+	return s
+}
+
+// TransactionFeeFlag When making internal transfer, true for returning the fee to the destination account; false for returning the fee back to the departure account. Default false.
+func (s *Withdraw) TransactionFeeFlag(transactionFeeFlag bool) *Withdraw {
+	s.r.Set("transactionFeeFlag", transactionFeeFlag)
+	return s
+}
+
+// Name Description of the address. Space in name should be encoded into %20.
+func (s *Withdraw) Name(name string) *Withdraw {
+	s.r.Set("name", name)
+	return s
+}
+
+// WalletType The wallet type for withdraw, 0-spot wallet, 1-funding wallet. Default spot wallet
+func (s *Withdraw) WalletType(walletType int) *Withdraw {
+	s.r.Set("walletType", walletType)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *Withdraw) RecvWindow(recvWindow int64) *Withdraw {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *Withdraw) Do(ctx context.Context) (*WithdrawResponse, error) {
+	resp := new(WithdrawResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// WithdrawHistory Fetch withdraw history. Default to the last 90 days if startTime and endTime are not sent.
+type WithdrawHistory struct {
+	c *Client
+	r *core.Request
+}
+
+// IdList Ids of the withdrawals, max 45 ids
+func (s *WithdrawHistory) IdList(idList []string) *WithdrawHistory {
+	s.r.Set("idList", strings.Join(idList, ","))
+	return s
+}
+
+func (s *WithdrawHistory) Coin(coin string) *WithdrawHistory {
+	s.r.Set("coin", coin)
+	return s
+}
+
+func (s *WithdrawHistory) WithdrawOrderId(withdrawOrderId string) *WithdrawHistory {
+	s.r.Set("withdrawOrderId", withdrawOrderId)
+	return s
+}
+
+// Status 0: email sent, 2: awaiting approval, 3: rejected, 4: processing, 6: completed
+func (s *WithdrawHistory) Status(status int) *WithdrawHistory {
+	s.r.Set("status", status)
+	return s
+}
+
+func (s *WithdrawHistory) Offset(offset int) *WithdrawHistory {
+	s.r.Set("offset", offset)
+	return s
+}
+
+// Limit Default: 1000, Max: 1000
+func (s *WithdrawHistory) Limit(limit int) *WithdrawHistory {
+	s.r.Set("limit", limit)
+	return s
+}
+
+func (s *WithdrawHistory) StartTime(startTime int64) *WithdrawHistory {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *WithdrawHistory) EndTime(endTime int64) *WithdrawHistory {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *WithdrawHistory) RecvWindow(recvWindow int64) *WithdrawHistory {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *WithdrawHistory) Do(ctx context.Context) ([]*WithdrawRecord, error) {
+	resp := make([]*WithdrawRecord, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// UniversalTransfer Transfer assets between the wallets of the account, e.g. MAIN_UMFUTURE from spot to USDⓈ-M futures.
+type UniversalTransfer struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *UniversalTransfer) Type(typ core.UniversalTransferTypeEnum) *UniversalTransfer {
+	s.r.Set("type", typ)
+	return s
+}
+
+func (s *UniversalTransfer) Asset(asset string) *UniversalTransfer {
+	s.r.Set("asset", asset)
+	return s
+}
+
+func (s *UniversalTransfer) Amount(amount string) *UniversalTransfer {
+	s.r.Set("amount", amount)
+	return s
+}
+
+// FromSymbol Must be sent when type are ISOLATEDMARGIN_MARGIN and ISOLATEDMARGIN_ISOLATEDMARGIN
+func (s *UniversalTransfer) FromSymbol(fromSymbol string) *UniversalTransfer {
+	s.r.Set("fromSymbol", fromSymbol)
+	return s
+}
+
+// ToSymbol Must be sent when type are MARGIN_ISOLATEDMARGIN and ISOLATEDMARGIN_ISOLATEDMARGIN
+func (s *UniversalTransfer) ToSymbol(toSymbol string) *UniversalTransfer {
+	s.r.Set("toSymbol", toSymbol)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *UniversalTransfer) RecvWindow(recvWindow int64) *UniversalTransfer {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *UniversalTransfer) Do(ctx context.Context) (*TranIdResponse, error) {
+	resp := new(TranIdResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// UniversalTransferHistory Query user universal transfer history. Support query within the last 6 months only.
+type UniversalTransferHistory struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *UniversalTransferHistory) Type(typ core.UniversalTransferTypeEnum) *UniversalTransferHistory {
+	s.r.Set("type", typ)
+	return s
+}
+
+func (s *UniversalTransferHistory) StartTime(startTime int64) *UniversalTransferHistory {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *UniversalTransferHistory) EndTime(endTime int64) *UniversalTransferHistory {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Current Default 1
+func (s *UniversalTransferHistory) Current(current int) *UniversalTransferHistory {
+	s.r.Set("current", current)
+	return s
+}
+
+// Size Default 10, Max 100
+func (s *UniversalTransferHistory) Size(size int) *UniversalTransferHistory {
+	s.r.Set("size", size)
+	return s
+}
+
+func (s *UniversalTransferHistory) FromSymbol(fromSymbol string) *UniversalTransferHistory {
+	s.r.Set("fromSymbol", fromSymbol)
+	return s
+}
+
+func (s *UniversalTransferHistory) ToSymbol(toSymbol string) *UniversalTransferHistory {
+	s.r.Set("toSymbol", toSymbol)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *UniversalTransferHistory) RecvWindow(recvWindow int64) *UniversalTransferHistory {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *UniversalTransferHistory) Do(ctx context.Context) (*UniversalTransferHistoryResponse, error) {
+	resp := new(UniversalTransferHistoryResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// DustTransfer Convert dust assets to BNB. You need to open the Spot and Margin trading permission of the key.
+type DustTransfer struct {
+	c *Client
+	r *core.Request
+}
+
+// Asset The assets being converted
+func (s *DustTransfer) Asset(assets []string) *DustTransfer {
+	s.r.Set("asset", strings.Join(assets, ","))
+	return s
+}
+
+// AccountType SPOT or MARGIN, default SPOT
+func (s *DustTransfer) AccountType(accountType string) *DustTransfer {
+	s.r.Set("accountType", accountType)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *DustTransfer) RecvWindow(recvWindow int64) *DustTransfer {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *DustTransfer) Do(ctx context.Context) (*DustTransferResponse, error) {
+	resp := new(DustTransferResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// AssetDividend Query asset dividend record.
+type AssetDividend struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *AssetDividend) Asset(asset string) *AssetDividend {
+	s.r.Set("asset", asset)
+	return s
+}
+
+func (s *AssetDividend) StartTime(startTime int64) *AssetDividend {
+	s.r.Set("startTime", startTime)
+	return s
+}
+
+func (s *AssetDividend) EndTime(endTime int64) *AssetDividend {
+	s.r.Set("endTime", endTime)
+	return s
+}
+
+// Limit Default 20, max 500
+func (s *AssetDividend) Limit(limit int) *AssetDividend {
+	s.r.Set("limit", limit)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *AssetDividend) RecvWindow(recvWindow int64) *AssetDividend {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *AssetDividend) Do(ctx context.Context) (*AssetDividendResponse, error) {
+	resp := new(AssetDividendResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
+
+// TradeFee Fetch trade fee of the symbols.
+type TradeFee struct {
+	c *Client
+	r *core.Request
+}
+
+func (s *TradeFee) Symbol(symbol string) *TradeFee {
+	s.r.Set("symbol", symbol)
+	return s
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *TradeFee) RecvWindow(recvWindow int64) *TradeFee {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *TradeFee) Do(ctx context.Context) ([]*TradeFeeResponse, error) {
+	resp := make([]*TradeFeeResponse, 0)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), &resp)
+}
+
+// ApiRestrictions Get API key permissions, e.g. to check on startup that the key can trade.
+type ApiRestrictions struct {
+	c *Client
+	r *core.Request
+}
+
+// RecvWindow The value cannot be greater than 60000
+func (s *ApiRestrictions) RecvWindow(recvWindow int64) *ApiRestrictions {
+	s.r.Set("recvWindow", recvWindow)
+	return s
+}
+
+func (s *ApiRestrictions) Do(ctx context.Context) (*ApiRestrictionsResponse, error) {
+	resp := new(ApiRestrictionsResponse)
+	if err := s.c.invoke(s.r, ctx); err != nil {
+		return resp, err
+	}
+	return resp, json.Unmarshal(s.c.rawBody(), resp)
+}
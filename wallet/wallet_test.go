@@ -0,0 +1,263 @@
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jekaxv/go-binance/core"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type walletTestSuite struct {
+	baseHttpTestSuite
+}
+
+func TestWallet(t *testing.T) {
+	suite.Run(t, new(walletTestSuite))
+}
+
+func (s *walletTestSuite) TestSystemStatus() {
+	msg := []byte(`{"status": 0, "msg": "normal"}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewSystemStatus().Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Equal(0, resp.Status)
+	r.Equal("normal", resp.Msg)
+}
+
+func (s *walletTestSuite) TestAllCoins() {
+	msg := []byte(`[
+	  {
+		"coin": "BTC",
+		"depositAllEnable": true,
+		"free": "0.08074558",
+		"freeze": "0.00000000",
+		"ipoable": "0.00000000",
+		"ipoing": "0.00000000",
+		"isLegalMoney": false,
+		"locked": "0.00000000",
+		"name": "Bitcoin",
+		"networkList": [
+		  {
+			"addressRegex": "^(bnb1)[0-9a-z]{38}$",
+			"coin": "BTC",
+			"depositDesc": "Wallet Maintenance, Deposit Suspended",
+			"depositEnable": false,
+			"isDefault": false,
+			"memoRegex": "^[0-9A-Za-z\\-_]{1,120}$",
+			"minConfirm": 1,
+			"name": "BEP2",
+			"network": "BNB",
+			"specialTips": "Both a MEMO and an Address are required to successfully deposit your BEP2-BTCB tokens to Binance.",
+			"unLockConfirm": 0,
+			"withdrawDesc": "Wallet Maintenance, Withdrawal Suspended",
+			"withdrawEnable": false,
+			"withdrawFee": "0.00000220",
+			"withdrawIntegerMultiple": "0.00000001",
+			"withdrawMax": "9999999999.99999999",
+			"withdrawMin": "0.00000440",
+			"sameAddress": true,
+			"estimatedArrivalTime": 25,
+			"busy": false,
+			"contractAddressUrl": "https://bscscan.com/token/",
+			"contractAddress": "0x7130d2a12b9bcbfae4f2634d864a1ee1ce3ead9c"
+		  }
+		],
+		"storage": "0.00000000",
+		"trading": true,
+		"withdrawAllEnable": true,
+		"withdrawing": "0.00000000"
+	  }
+	]`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewAllCoins().Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp []*CoinInfo
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Len(resp, 1)
+	r.Equal(testResp[0].Coin, resp[0].Coin)
+	r.Equal(testResp[0].Free, resp[0].Free)
+	r.Equal(*testResp[0].NetworkList[0], *resp[0].NetworkList[0])
+}
+
+func (s *walletTestSuite) TestDepositHistory() {
+	msg := []byte(`[
+	  {
+		"id": "769800519366885376",
+		"amount": "0.001",
+		"coin": "BNB",
+		"network": "BNB",
+		"status": 1,
+		"address": "bnb136ns6lfw4zs5hg4n85vdthaad7hq5m4gtkgf23",
+		"addressTag": "101764890",
+		"txId": "98A3EA560C6B3336D348B6C83F0F95ECE4F1F5919E94BD006E5BF3BF264FACFC",
+		"insertTime": 1661493146000,
+		"completeTime": 1661493146000,
+		"transferType": 0,
+		"confirmTimes": "1/1",
+		"unlockConfirm": 0,
+		"walletType": 0
+	  }
+	]`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewDepositHistory().Coin("BNB").Status(1).Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp []*DepositRecord
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(*testResp[0], *resp[0])
+}
+
+func (s *walletTestSuite) TestWithdraw() {
+	msg := []byte(`{"id": "7213fea8e94b4a5593d507237e5a555b"}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewWithdraw().Coin("USDT").WithdrawOrderId("payout-1").Network("TRX").
+		Address("TXYZ").Amount("10").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Equal("7213fea8e94b4a5593d507237e5a555b", resp.Id)
+}
+
+func (s *walletTestSuite) TestWithdrawHistory() {
+	msg := []byte(`[
+	  {
+		"id": "b6ae22b3aa844210a7041aee7589627c",
+		"amount": "8.91000000",
+		"transactionFee": "0.004",
+		"coin": "USDT",
+		"status": 6,
+		"address": "0x94df8b352de7f46f64b01d3666bf6e936e44ce60",
+		"txId": "0xb5ef8c13b968a406cc62a93a8bd80f9e9a906ef1b3fcf20a2e48573c17659268",
+		"applyTime": "2019-10-12 11:12:02",
+		"network": "ETH",
+		"transferType": 0,
+		"withdrawOrderId": "WITHDRAWtest123",
+		"info": "The address is not valid. Please confirm with the recipient",
+		"confirmNo": 3,
+		"walletType": 1,
+		"txKey": "",
+		"completeTime": "2023-03-23 16:52:41"
+	  }
+	]`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewWithdrawHistory().WithdrawOrderId("WITHDRAWtest123").
+		IdList([]string{"b6ae22b3aa844210a7041aee7589627c"}).Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp []*WithdrawRecord
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(*testResp[0], *resp[0])
+}
+
+func (s *walletTestSuite) TestUniversalTransfer() {
+	msg := []byte(`{"tranId": 13526853623}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewUniversalTransfer().Type(core.UniversalTransferMAIN_UMFUTURE).Asset("USDT").
+		Amount("100").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	r.Equal(int64(13526853623), resp.TranId)
+}
+
+func (s *walletTestSuite) TestUniversalTransferHistory() {
+	msg := []byte(`{
+	  "total": 1,
+	  "rows": [
+		{
+		  "asset": "USDT",
+		  "amount": "1",
+		  "type": "MAIN_UMFUTURE",
+		  "status": "CONFIRMED",
+		  "tranId": 11415955596,
+		  "timestamp": 1544433328000
+		}
+	  ]
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewUniversalTransferHistory().Type(core.UniversalTransferMAIN_UMFUTURE).Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *UniversalTransferHistoryResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(testResp.Total, resp.Total)
+	r.Equal(*testResp.Rows[0], *resp.Rows[0])
+}
+
+func (s *walletTestSuite) TestDustTransfer() {
+	msg := []byte(`{
+	  "totalServiceCharge": "0.02102542",
+	  "totalTransfered": "1.05127099",
+	  "transferResult": [
+		{
+		  "amount": "0.03000000",
+		  "fromAsset": "ETH",
+		  "operateTime": 1563368549307,
+		  "serviceChargeAmount": "0.00500000",
+		  "tranId": 2970932918,
+		  "transferedAmount": "0.25000000"
+		}
+	  ]
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewDustTransfer().Asset([]string{"ETH", "LTC"}).Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *DustTransferResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(testResp.TotalTransfered, resp.TotalTransfered)
+	r.Equal(*testResp.TransferResult[0], *resp.TransferResult[0])
+}
+
+func (s *walletTestSuite) TestTradeFee() {
+	msg := []byte(`[{"symbol": "ADABNB", "makerCommission": "0.001", "takerCommission": "0.001"}]`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewTradeFee().Symbol("ADABNB").Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp []*TradeFeeResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(*testResp[0], *resp[0])
+}
+
+func (s *walletTestSuite) TestApiRestrictions() {
+	msg := []byte(`{
+	  "ipRestrict": false,
+	  "createTime": 1698645219000,
+	  "enableReading": true,
+	  "enableWithdrawals": false,
+	  "enableInternalTransfer": true,
+	  "enableMargin": false,
+	  "enableFutures": false,
+	  "permitsUniversalTransfer": true,
+	  "enableVanillaOptions": false,
+	  "enableFixApiTrade": false,
+	  "enableFixReadOnly": true,
+	  "enableSpotAndMarginTrading": true,
+	  "enablePortfolioMarginTrading": false,
+	  "tradingAuthorityExpirationTime": 1628985600000
+	}`)
+	server := s.setup(msg)
+	defer server.Close()
+	resp, err := s.client.NewApiRestrictions().Do(context.Background())
+	r := s.r()
+	r.Empty(err)
+	var testResp *ApiRestrictionsResponse
+	r.Empty(json.Unmarshal(msg, &testResp))
+	r.Equal(*testResp, *resp)
+	r.True(resp.CanTrade(1628985500000))
+	r.False(resp.CanTrade(1628985600000))
+	r.False(resp.CanTradeFutures())
+	resp.TradingAuthorityExpirationTime = 0
+	r.True(resp.CanTrade(1628985600000))
+}